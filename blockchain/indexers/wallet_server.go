@@ -375,6 +375,20 @@ func (idx *WalletServerIndex) GetTxoProofs(commitments []types.ID) ([]*blockchai
 	return proofs, idx.acc.Root(), nil
 }
 
+// GetTxoProof returns the txo inclusion proof for the provided commitment along
+// with the merkle root it is valid for and the ID of the block at which the root
+// was computed.
+func (idx *WalletServerIndex) GetTxoProof(commitment types.ID) (*blockchain.InclusionProof, types.ID, types.ID, error) {
+	idx.stateMtx.RLock()
+	defer idx.stateMtx.RUnlock()
+
+	proof, err := idx.acc.GetProof(commitment.Bytes())
+	if err != nil {
+		return nil, types.ID{}, types.ID{}, err
+	}
+	return proof, idx.acc.Root(), idx.bestBlockID, nil
+}
+
 // Close closes the wallet server index
 func (idx *WalletServerIndex) Close(ds repo.Datastore) error {
 	close(idx.quit)
@@ -74,6 +74,61 @@ func (x *GetMempool) Execute(args []string) error {
 	return nil
 }
 
+type GetMempoolEntry struct {
+	Txid string `short:"i" long:"id" description:"Txid to look up"`
+	opts *options
+}
+
+func (x *GetMempoolEntry) Execute(args []string) error {
+	client, err := makeBlockchainClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	txid, err := hex.DecodeString(x.Txid)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetMempoolEntry(makeContext(x.opts.AuthToken), &pb.GetMempoolEntryRequest{
+		Transaction_ID: txid,
+	})
+	if err != nil {
+		return err
+	}
+
+	m := protojson.MarshalOptions{
+		Indent:          "    ",
+		EmitUnpopulated: true,
+	}
+	out, err := m.Marshal(resp.Entry)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type EstimateFee struct {
+	TargetBlocks uint32 `short:"t" long:"target" description:"The number of blocks within which the transaction should be confirmed" default:"6"`
+	opts         *options
+}
+
+func (x *EstimateFee) Execute(args []string) error {
+	client, err := makeBlockchainClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.EstimateFee(makeContext(x.opts.AuthToken), &pb.EstimateFeeRequest{
+		TargetBlocks: x.TargetBlocks,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.FeePerKilobyte)
+	return nil
+}
+
 type GetBlockchainInfo struct {
 	opts *options
 }
@@ -616,3 +671,45 @@ func (x *SubmitTransaction) Execute(args []string) error {
 	fmt.Println(hex.EncodeToString(resp.Transaction_ID))
 	return nil
 }
+
+type TestTransaction struct {
+	opts *options
+	Tx   string `short:"t" long:"tx" description:"The transaction to test. Serialized as hex string or JSON."`
+}
+
+func (x *TestTransaction) Execute(args []string) error {
+	var tx transactions.Transaction
+	txBytes, err := hex.DecodeString(x.Tx)
+	if err == nil {
+		if err := proto.Unmarshal(txBytes, &tx); err != nil {
+			return err
+		}
+	} else {
+		if err := json.Unmarshal([]byte(x.Tx), &tx); err != nil {
+			return err
+		}
+	}
+
+	client, err := makeBlockchainClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TestTransaction(makeContext(x.opts.AuthToken), &pb.TestTransactionRequest{
+		Transaction: &tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	m := protojson.MarshalOptions{
+		Indent:          "    ",
+		EmitUnpopulated: true,
+	}
+	out, err := m.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
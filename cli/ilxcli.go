@@ -71,6 +71,8 @@ func main() {
 	// Blockchain service
 	parser.AddCommand("getmempoolinfo", "Returns the state of the current mempool", "Returns the state of the current mempool", &GetMempoolInfo{&opts})
 	parser.AddCommand("getmempool", "Returns all the transactions in the mempool", "Returns all the transactions in the mempool", &GetMempool{&opts})
+	parser.AddCommand("getmempoolentry", "Returns metadata about a transaction in the mempool", "Returns metadata about a transaction in the mempool including when it entered the mempool and the fee per kilobyte it pays", &GetMempoolEntry{opts: &opts})
+	parser.AddCommand("estimatefee", "Estimate the fee per kilobyte needed to confirm a transaction", "Returns the fee per kilobyte a transaction should pay to be confirmed within the target number of blocks", &EstimateFee{opts: &opts})
 	parser.AddCommand("getblockchaininfo", "Returns data about the blockchain", "Returns data about the blockchain including the most recent block hash and height", &GetBlockchainInfo{&opts})
 	parser.AddCommand("getblockinfo", "Returns a block header plus some extra metadata", "Returns a block header plus some extra metadata", &GetBlockInfo{opts: &opts})
 	parser.AddCommand("getblock", "Returns the detailed data for a block", "Returns the detailed data for a block", &GetBlock{opts: &opts})
@@ -82,14 +84,25 @@ func main() {
 	parser.AddCommand("getvalidatorset", "Returns all the validators in the current validator set", "Returns all the validators in the current validator set.", &GetValidatorSet{opts: &opts})
 	parser.AddCommand("getaccumulatorcheckpoint", "Returns the accumulator at the requested height", "Returns the accumulator at the requested height. If there is no checkpoint at that height, the *prior* checkpoint found in the chain will be returned. If there is no prior checkpoint (as is prior to the first), an error will be returned.", &GetAccumulatorCheckpoint{opts: &opts})
 	parser.AddCommand("submittransaction", "Validates a transaction and submits it to the network", "Validates a transaction and submits it to the network. An error will be returned if it fails validation.", &SubmitTransaction{opts: &opts})
+	parser.AddCommand("testtransaction", "Validates a transaction without submitting it to the network", "Runs the full mempool validation of a transaction, including the proof and signature checks, without adding it to the mempool or broadcasting it. Returns the reject reason and error code if it would be rejected.", &TestTransaction{opts: &opts})
 
 	// Node service
 	parser.AddCommand("gethostinfo", "Returns info about the libp2p host", "Returns info about the libp2p host", &GetHostInfo{opts: &opts})
 	parser.AddCommand("getnetworkkey", "Returns node's network private key", "Returns node's network private key", &GetNetworkKey{opts: &opts})
 	parser.AddCommand("getpeers", "Returns a list of peers that this node is connected to", "Returns a list of peers that this node is connected to", &GetPeers{opts: &opts})
+	parser.AddCommand("getnettotals", "Returns the bandwidth used by the node", "Returns the total bytes sent and received by the node along with the current bandwidth rates, broken down by protocol", &GetNetTotals{opts: &opts})
+	parser.AddCommand("getsyncstatus", "Returns the progress of the node's sync", "Returns the progress of the node's sync to the tip of the chain, including the estimated network height, the peers being synced from, the sync rate, and the estimated time remaining", &GetSyncStatus{opts: &opts})
+	parser.AddCommand("getchaintips", "Returns the known chain tips", "Returns the tip of the node's best chain along with the tips of any competing chains seen in forks during the sync", &GetChainTips{opts: &opts})
+	parser.AddCommand("getforks", "Returns the forks observed while syncing", "Returns the forks the node has observed while syncing, including the fork point, the competing tips, the peers on each side, their chain scores, and which tip was selected", &GetForks{opts: &opts})
 	parser.AddCommand("addpeer", "Attempts to connect to the provided peer", "Attempts to connect to the provided peer", &AddPeer{opts: &opts})
 	parser.AddCommand("blockpeer", "Blocks the given peer for the provided time period", "Blocks the given peer for the provided time period", &BlockPeer{opts: &opts})
 	parser.AddCommand("unblockpeer", "Removes a peer from the block list", "Removes a peer from the block list", &UnblockPeer{opts: &opts})
+	parser.AddCommand("blocksubnet", "Blocks the given subnet for the provided time period", "Blocks all IP addresses in the given subnet for the provided time period", &BlockSubnet{opts: &opts})
+	parser.AddCommand("unblocksubnet", "Removes a subnet from the block list", "Removes a subnet from the block list", &UnblockSubnet{opts: &opts})
+	parser.AddCommand("listbans", "Returns the list of active bans", "Returns the active peer, IP address, and subnet bans along with the reason for and expiration of each ban", &ListBans{opts: &opts})
+	parser.AddCommand("addallowlist", "Adds a peer ID or subnet to the allowlist", "Adds a peer ID or subnet to the allowlist. Allowlisted peers bypass all bans, are exempt from banscore, and are protected from being disconnected when the node is over its connection limits.", &AddAllowlist{opts: &opts})
+	parser.AddCommand("removeallowlist", "Removes a peer ID or subnet from the allowlist", "Removes a peer ID or subnet from the allowlist", &RemoveAllowlist{opts: &opts})
+	parser.AddCommand("listallowlist", "Returns the allowlisted peer IDs and subnets", "Returns the allowlisted peer IDs and subnets", &ListAllowlist{opts: &opts})
 	parser.AddCommand("setloglevel", "Changes the logging level of the node", "Changes the logging level of the node", &SetLogLevel{opts: &opts})
 	parser.AddCommand("getminfeeperkilobyte", "Returns the node's current minimum transaction fee", "Returns the node's current minimum transaction fee needed to relay transactions and admit them into the mempool. Validators will also set their initial preference for blocks containing transactions with fees below this threshold to not-preferred.", &GetMinFeePerKilobyte{opts: &opts})
 	parser.AddCommand("setminfeeperkilobyte", "Sets the node's fee policy", "Sets the node's fee policy", &SetMinFeePerKilobyte{opts: &opts})
@@ -101,6 +114,9 @@ func main() {
 	parser.AddCommand("updatetreasurywhitelist", "Adds or removes a transaction from the treasury whitelist", "Adds or removes a transaction from the treasury whitelist", &UpdateTreasuryWhitelist{opts: &opts})
 	parser.AddCommand("reconsiderblock", "Tries to reprocess the given block", "Tries to reprocess the given block", &ReconsiderBlock{opts: &opts})
 	parser.AddCommand("recomputechainstate", "Rebuilds the entire chain state from genesis", "Deletes the accumulator, validator set, and nullifier set and rebuilds them by loading and re-processing all blocks from genesis.", &RecomputeChainState{opts: &opts})
+	parser.AddCommand("savemempool", "Saves the mempool to the data directory", "Writes the mempool to a file in the node's data directory. The mempool is also saved automatically on shutdown unless disabled. Returns the number of transactions saved.", &SaveMempool{opts: &opts})
+	parser.AddCommand("loadmempool", "Loads the mempool saved in the data directory", "Loads the mempool saved in the node's data directory. Each transaction is revalidated and those that are no longer valid or have expired are discarded. Returns the number of transactions accepted.", &LoadMempool{opts: &opts})
+	parser.AddCommand("prioritisetransaction", "Adds a fee delta to a transaction", "Adds a fee delta to a transaction which is applied to its fee when it is compared against other transactions in the mempool and when selecting transactions for a block. The transaction itself is not changed. Returns the transaction's total fee delta.", &PrioritiseTransaction{opts: &opts})
 	parser.AddCommand("signmessage", "Sign a message with the network key", "Sign a message with the nework key", &SignMessage{opts: &opts})
 	parser.AddCommand("verifymessage", "Verify a signed message", "Verify a signed message", &VerifyMessage{opts: &opts})
 
@@ -132,6 +148,7 @@ func main() {
 	parser.AddCommand("stake", "Stakes the selected wallet UTXOs and turns the node into a validator", "Stakes the selected wallet UTXOs and turns the node into a validator", &Stake{opts: &opts})
 	parser.AddCommand("setautostakerewards", "Automatically stakes validator rewards", "Automatically stakes validator rewards", &SetAutoStakeRewards{opts: &opts})
 	parser.AddCommand("spend", "Sends coins from the wallet", "Sends coins from the wallet according to the provided parameters", &Spend{opts: &opts})
+	parser.AddCommand("bumpfee", "Replace an unconfirmed transaction with one paying a higher fee", "Replace an unconfirmed wallet transaction in the mempool with a new transaction spending the same inputs and paying a higher fee", &BumpFee{opts: &opts})
 	parser.AddCommand("timelockcoins", "Lock coins in a timelocked address", "Send coins into a timelocked address, from which the wallet may spend from after the timelock expires. This is primarily used for adding weight to stake.", &TimelockCoins{opts: &opts})
 
 	if _, err := parser.Parse(); err != nil {
@@ -17,6 +17,7 @@ import (
 	"golang.org/x/crypto/openpgp/armor" // nolint:staticcheck
 	"google.golang.org/protobuf/encoding/protojson"
 	"strings"
+	"time"
 )
 
 type GetHostInfo struct {
@@ -80,6 +81,11 @@ func (x *GetPeers) Execute(args []string) error {
 		PeerID    string   `json:"peerID"`
 		UserAgent string   `json:"userAgent"`
 		Addrs     []string `json:"addrs"`
+		Inbound   bool     `json:"inbound"`
+		BytesIn   uint64   `json:"bytesIn"`
+		BytesOut  uint64   `json:"bytesOut"`
+		RateIn    uint64   `json:"rateIn"`
+		RateOut   uint64   `json:"rateOut"`
 	}
 
 	peers := make([]peer, len(resp.Peers))
@@ -88,6 +94,11 @@ func (x *GetPeers) Execute(args []string) error {
 			PeerID:    resp.Peers[i].Id,
 			UserAgent: resp.Peers[i].UserAgent,
 			Addrs:     resp.Peers[i].Addrs,
+			Inbound:   resp.Peers[i].Inbound,
+			BytesIn:   resp.Peers[i].BytesIn,
+			BytesOut:  resp.Peers[i].BytesOut,
+			RateIn:    resp.Peers[i].RateIn,
+			RateOut:   resp.Peers[i].RateOut,
 		}
 	}
 	out, err := json.MarshalIndent(peers, "", "    ")
@@ -98,6 +109,207 @@ func (x *GetPeers) Execute(args []string) error {
 	return nil
 }
 
+type GetNetTotals struct {
+	opts *options
+}
+
+func (x *GetNetTotals) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetNetTotals(makeContext(x.opts.AuthToken), &pb.GetNetTotalsRequest{})
+	if err != nil {
+		return err
+	}
+
+	type bandwidth struct {
+		BytesIn  uint64 `json:"bytesIn"`
+		BytesOut uint64 `json:"bytesOut"`
+		RateIn   uint64 `json:"rateIn"`
+		RateOut  uint64 `json:"rateOut"`
+	}
+	type totals struct {
+		bandwidth
+		Protocols map[string]bandwidth `json:"protocols"`
+	}
+
+	t := totals{
+		bandwidth: bandwidth{
+			BytesIn:  resp.BytesIn,
+			BytesOut: resp.BytesOut,
+			RateIn:   resp.RateIn,
+			RateOut:  resp.RateOut,
+		},
+		Protocols: make(map[string]bandwidth),
+	}
+	for _, p := range resp.Protocols {
+		t.Protocols[p.Protocol] = bandwidth{
+			BytesIn:  p.BytesIn,
+			BytesOut: p.BytesOut,
+			RateIn:   p.RateIn,
+			RateOut:  p.RateOut,
+		}
+	}
+	out, err := json.MarshalIndent(t, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type GetSyncStatus struct {
+	opts *options
+}
+
+func (x *GetSyncStatus) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetSyncStatus(makeContext(x.opts.AuthToken), &pb.GetSyncStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	type bucket struct {
+		BestBlockID string   `json:"bestBlockID"`
+		Height      uint32   `json:"height"`
+		Peers       []string `json:"peers"`
+	}
+	type syncStatus struct {
+		Current         bool     `json:"current"`
+		Height          uint32   `json:"height"`
+		NetworkHeight   uint32   `json:"networkHeight"`
+		SyncPeers       []string `json:"syncPeers"`
+		Buckets         []bucket `json:"buckets"`
+		BlocksPerSecond float64  `json:"blocksPerSecond"`
+		ETA             string   `json:"eta"`
+	}
+
+	ss := syncStatus{
+		Current:         resp.Status.Current,
+		Height:          resp.Status.Height,
+		NetworkHeight:   resp.Status.NetworkHeight,
+		SyncPeers:       resp.Status.SyncPeers,
+		Buckets:         make([]bucket, 0, len(resp.Status.Buckets)),
+		BlocksPerSecond: resp.Status.BlocksPerSecond,
+		ETA:             (time.Duration(resp.Status.EtaSeconds) * time.Second).String(),
+	}
+	if ss.SyncPeers == nil {
+		ss.SyncPeers = []string{}
+	}
+	for _, b := range resp.Status.Buckets {
+		ss.Buckets = append(ss.Buckets, bucket{
+			BestBlockID: hex.EncodeToString(b.BestBlock_ID),
+			Height:      b.Height,
+			Peers:       b.Peers,
+		})
+	}
+	out, err := json.MarshalIndent(ss, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type GetChainTips struct {
+	opts *options
+}
+
+func (x *GetChainTips) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetChainTips(makeContext(x.opts.AuthToken), &pb.GetChainTipsRequest{})
+	if err != nil {
+		return err
+	}
+
+	type chainTip struct {
+		BlockID      string `json:"blockID"`
+		Height       uint32 `json:"height"`
+		BranchLength uint32 `json:"branchLength"`
+		Status       string `json:"status"`
+	}
+
+	tips := make([]chainTip, 0, len(resp.Tips))
+	for _, tip := range resp.Tips {
+		tips = append(tips, chainTip{
+			BlockID:      hex.EncodeToString(tip.Block_ID),
+			Height:       tip.Height,
+			BranchLength: tip.BranchLength,
+			Status:       strings.ToLower(tip.Status.String()),
+		})
+	}
+	out, err := json.MarshalIndent(tips, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type GetForks struct {
+	opts *options
+}
+
+func (x *GetForks) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.GetForks(makeContext(x.opts.AuthToken), &pb.GetForksRequest{})
+	if err != nil {
+		return err
+	}
+
+	type tip struct {
+		BlockID    string   `json:"blockID"`
+		Height     uint32   `json:"height"`
+		Peers      []string `json:"peers"`
+		ChainScore float64  `json:"chainScore"`
+	}
+	type fork struct {
+		ForkBlockID       string    `json:"forkBlockID"`
+		ForkHeight        uint32    `json:"forkHeight"`
+		Tips              []tip     `json:"tips"`
+		Winner            string    `json:"winner"`
+		ChosenByConsensus bool      `json:"chosenByConsensus"`
+		Timestamp         time.Time `json:"timestamp"`
+	}
+
+	forks := make([]fork, 0, len(resp.Forks))
+	for _, f := range resp.Forks {
+		tips := make([]tip, 0, len(f.Tips))
+		for _, t := range f.Tips {
+			tips = append(tips, tip{
+				BlockID:    hex.EncodeToString(t.Block_ID),
+				Height:     t.Height,
+				Peers:      t.Peers,
+				ChainScore: t.ChainScore,
+			})
+		}
+		forks = append(forks, fork{
+			ForkBlockID:       hex.EncodeToString(f.ForkBlock_ID),
+			ForkHeight:        f.ForkHeight,
+			Tips:              tips,
+			Winner:            hex.EncodeToString(f.Winner),
+			ChosenByConsensus: f.ChosenByConsensus,
+			Timestamp:         time.Unix(f.Timestamp, 0),
+		})
+	}
+	out, err := json.MarshalIndent(forks, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 type AddPeer struct {
 	Peer string `short:"p" long:"peer" description:"The peer ID to connect to. The IP address will be looked up in the DHT if necessary."`
 	opts *options
@@ -161,6 +373,181 @@ func (x *UnblockPeer) Execute(args []string) error {
 	return nil
 }
 
+type BlockSubnet struct {
+	Subnet   string `short:"s" long:"subnet" description:"The subnet to block in CIDR notation. Example: 1.2.3.0/24"`
+	Duration uint32 `short:"d" long:"duration" description:"The number of seconds to block the subnet for. If zero the node's default ban duration is used."`
+	Reason   string `short:"r" long:"reason" description:"An optional reason for the ban"`
+	opts     *options
+}
+
+func (x *BlockSubnet) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	_, err = client.BlockSubnet(makeContext(x.opts.AuthToken), &pb.BlockSubnetRequest{
+		Subnet:   x.Subnet,
+		Duration: x.Duration,
+		Reason:   x.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("success")
+	return nil
+}
+
+type UnblockSubnet struct {
+	Subnet string `short:"s" long:"subnet" description:"The subnet to unblock in CIDR notation"`
+	opts   *options
+}
+
+func (x *UnblockSubnet) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	_, err = client.UnblockSubnet(makeContext(x.opts.AuthToken), &pb.UnblockSubnetRequest{
+		Subnet: x.Subnet,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("success")
+	return nil
+}
+
+type ListBans struct {
+	opts *options
+}
+
+func (x *ListBans) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.ListBans(makeContext(x.opts.AuthToken), &pb.ListBansRequest{})
+	if err != nil {
+		return err
+	}
+
+	type ban struct {
+		PeerID     string    `json:"peerID,omitempty"`
+		Subnet     string    `json:"subnet,omitempty"`
+		Reason     string    `json:"reason"`
+		Expiration time.Time `json:"expiration"`
+	}
+
+	bans := make([]ban, len(resp.Bans))
+	for i := range resp.Bans {
+		bans[i] = ban{
+			PeerID:     resp.Bans[i].Peer_ID,
+			Subnet:     resp.Bans[i].Subnet,
+			Reason:     resp.Bans[i].Reason,
+			Expiration: time.Unix(resp.Bans[i].Expiration, 0),
+		}
+	}
+	out, err := json.MarshalIndent(bans, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+type AddAllowlist struct {
+	Peer   string `short:"p" long:"peer" description:"The peer ID to allowlist"`
+	Subnet string `short:"s" long:"subnet" description:"The subnet to allowlist in CIDR notation"`
+	opts   *options
+}
+
+func (x *AddAllowlist) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	req := &pb.AddAllowlistRequest{}
+	switch {
+	case x.Peer != "" && x.Subnet != "":
+		return errors.New("use only one of peer or subnet")
+	case x.Peer != "":
+		req.Entry = &pb.AddAllowlistRequest_Peer_ID{Peer_ID: x.Peer}
+	case x.Subnet != "":
+		req.Entry = &pb.AddAllowlistRequest_Subnet{Subnet: x.Subnet}
+	default:
+		return errors.New("peer or subnet is required")
+	}
+	_, err = client.AddAllowlist(makeContext(x.opts.AuthToken), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("success")
+	return nil
+}
+
+type RemoveAllowlist struct {
+	Peer   string `short:"p" long:"peer" description:"The peer ID to remove from the allowlist"`
+	Subnet string `short:"s" long:"subnet" description:"The subnet to remove from the allowlist in CIDR notation"`
+	opts   *options
+}
+
+func (x *RemoveAllowlist) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	req := &pb.RemoveAllowlistRequest{}
+	switch {
+	case x.Peer != "" && x.Subnet != "":
+		return errors.New("use only one of peer or subnet")
+	case x.Peer != "":
+		req.Entry = &pb.RemoveAllowlistRequest_Peer_ID{Peer_ID: x.Peer}
+	case x.Subnet != "":
+		req.Entry = &pb.RemoveAllowlistRequest_Subnet{Subnet: x.Subnet}
+	default:
+		return errors.New("peer or subnet is required")
+	}
+	_, err = client.RemoveAllowlist(makeContext(x.opts.AuthToken), req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("success")
+	return nil
+}
+
+type ListAllowlist struct {
+	opts *options
+}
+
+func (x *ListAllowlist) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+	resp, err := client.ListAllowlist(makeContext(x.opts.AuthToken), &pb.ListAllowlistRequest{})
+	if err != nil {
+		return err
+	}
+
+	type allowlist struct {
+		PeerIDs []string `json:"peerIDs"`
+		Subnets []string `json:"subnets"`
+	}
+	out, err := json.MarshalIndent(allowlist{
+		PeerIDs: resp.Peer_IDs,
+		Subnets: resp.Subnets,
+	}, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
 type SetLogLevel struct {
 	Level string `short:"l" long:"level" description:"The log level: [DEBUG, INFO, WARNING, ERROR, CRITICAL, ALERT, EMERGENCY]"`
 	opts  *options
@@ -436,6 +823,73 @@ func (x *RecomputeChainState) Execute(args []string) error {
 	return nil
 }
 
+type SaveMempool struct {
+	opts *options
+}
+
+func (x *SaveMempool) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SaveMempool(makeContext(x.opts.AuthToken), &pb.SaveMempoolRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(resp.NumTransactions)
+	return nil
+}
+
+type LoadMempool struct {
+	opts *options
+}
+
+func (x *LoadMempool) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.LoadMempool(makeContext(x.opts.AuthToken), &pb.LoadMempoolRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(resp.NumTransactions)
+	return nil
+}
+
+type PrioritiseTransaction struct {
+	Txid     string `short:"i" long:"id" description:"The ID of the transaction to prioritise"`
+	FeeDelta int64  `short:"d" long:"delta" description:"The amount to add to the transaction's fee. May be negative."`
+	opts     *options
+}
+
+func (x *PrioritiseTransaction) Execute(args []string) error {
+	client, err := makeNodeClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	txid, err := hex.DecodeString(x.Txid)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PrioritiseTransaction(makeContext(x.opts.AuthToken), &pb.PrioritiseTransactionRequest{
+		Transaction_ID: txid,
+		FeeDelta:       x.FeeDelta,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(resp.FeeDelta)
+	return nil
+}
+
 type SignMessage struct {
 	Message string `short:"m" long:"message" description:"A message to sign"`
 	opts    *options
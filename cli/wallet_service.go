@@ -1159,6 +1159,50 @@ func (x *Spend) Execute(args []string) error {
 	return nil
 }
 
+type BumpFee struct {
+	Txid        string   `short:"t" long:"txid" description:"The ID of the unconfirmed transaction to replace. Serialized as a hex string."`
+	FeePerKB    uint64   `short:"f" long:"feeperkb" description:"The fee per kilobyte to pay for the replacement. If zero the original fee per kilobyte plus the mempool's minimum fee per kilobyte is used."`
+	Commitments []string `short:"c" long:"commitment" description:"Optionally specify the input commitment(s) spent by the original transaction. If this field is omitted the wallet will try to find them itself. Serialized as hex strings. Use this option more than once to add more than one input commitment."`
+	opts        *options
+}
+
+func (x *BumpFee) Execute(args []string) error {
+	client, err := makeWalletClient(x.opts)
+	if err != nil {
+		return err
+	}
+
+	txid, err := hex.DecodeString(x.Txid)
+	if err != nil {
+		return err
+	}
+	commitments := make([][]byte, 0, len(x.Commitments))
+	for _, c := range x.Commitments {
+		cBytes, err := hex.DecodeString(c)
+		if err != nil {
+			return err
+		}
+		commitments = append(commitments, cBytes)
+	}
+
+	spinner, err := pterm.DefaultSpinner.Start(provingPhrases[mrand.Intn(len(provingPhrases))])
+	if err != nil {
+		return err
+	}
+	resp, err := client.BumpFee(makeContext(x.opts.AuthToken), &pb.BumpFeeRequest{
+		Transaction_ID:   txid,
+		FeePerKilobyte:   x.FeePerKB,
+		InputCommitments: commitments,
+	})
+	if err != nil {
+		spinner.Fail(fmt.Sprintf("Error proving transaction: %s", err.Error()))
+		return nil
+	}
+
+	spinner.Success(hex.EncodeToString(resp.Transaction_ID))
+	return nil
+}
+
 type TimelockCoins struct {
 	LockUntil   int64    `short:"l" long:"lockuntil" description:"A unix timestamp to lock the coins until (in seconds)."`
 	Amount      uint64   `short:"t" long:"amount" description:"The amount to lockup"`
@@ -12,6 +12,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-msgio"
 	"github.com/project-illium/ilxd/net"
+	"github.com/project-illium/ilxd/notify"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/params/hash"
 	"github.com/project-illium/ilxd/types"
@@ -46,8 +47,15 @@ const (
 	// ConsensusProtocol is the libp2p network protocol ID
 	ConsensusProtocol = "/consensus/"
 
-	// ConsensusProtocolVersion is the version of the ConsensusProtocol
-	ConsensusProtocolVersion = "1.0.0"
+	// ConsensusProtocolVersion is the version of the ConsensusProtocol.
+	// Version 1.1.0 added transaction votes to the avalanche messages.
+	ConsensusProtocolVersion = "1.1.0"
+
+	// ConsensusLegacyProtocolVersion is the version of the ConsensusProtocol
+	// prior to the addition of transaction votes. It is still served and
+	// dialed so that blocks can be finalized with peers that have not yet
+	// upgraded. Such peers are only polled for blocks.
+	ConsensusLegacyProtocolVersion = "1.0.0"
 
 	// MinConnectedStakeThreshold is the minimum percentage of the weighted stake
 	// set we must be connected to in order to finalize blocks.
@@ -75,6 +83,22 @@ type newBlockMessage struct {
 	callback     chan<- Status
 }
 
+// newTxMessage represents a new conflicting transaction for the engine.
+type newTxMessage struct {
+	txid         types.ID
+	nullifiers   []types.Nullifier
+	isAcceptable bool
+	callback     chan<- Status
+}
+
+// txRecord tracks the conflicting transaction sets that a single
+// transaction is a member of.
+type txRecord struct {
+	nullifiers []types.Nullifier
+	finalized  int
+	callback   chan<- Status
+}
+
 // registerVotesMsg signifies a response to a query from another peer.
 type registerVotesMsg struct {
 	p    peer.ID
@@ -86,6 +110,12 @@ type registerVotesMsg struct {
 // validate it, then pass it into the engine.
 type RequestBlockFunc func(blockID types.ID, remotePeer peer.ID)
 
+// RequestTxFunc is called when a peer votes for an unknown transaction in a
+// conflict set. It should attempt to download the transaction from the remote
+// peer and pass it into the mempool which will pass it into the engine if it
+// is a valid conflicting spend.
+type RequestTxFunc func(txid types.ID, remotePeer peer.ID)
+
 // GetBlockIDFunc returns the blockID at the given height or an error if it's not found.
 type GetBlockIDFunc func(height uint32) (types.ID, error)
 
@@ -93,6 +123,10 @@ type GetBlockIDFunc func(height uint32) (types.ID, error)
 // It primarily consists of an event loop that polls the weighted list of
 // validators for any unfinalized blocks and records the responses. Blocks
 // finalize when the confidence level exceeds the threshold.
+//
+// The engine also polls for conflicting transactions. These are sets of
+// transactions, keyed by nullifier, which spend the same nullifier. Only
+// one transaction from each set can finalize.
 type ConsensusEngine struct {
 	ctx          context.Context
 	network      *net.Network
@@ -103,6 +137,7 @@ type ConsensusEngine struct {
 	self         peer.ID
 	wg           sync.WaitGroup
 	requestBlock RequestBlockFunc
+	requestTx    RequestTxFunc
 	getBlockID   GetBlockIDFunc
 	quit         chan struct{}
 	msgChan      chan interface{}
@@ -111,6 +146,12 @@ type ConsensusEngine struct {
 	blocks    map[uint32]*BlockChoice
 	queries   map[string]RequestRecord
 	callbacks map[types.ID]chan<- Status
+
+	txChoices map[types.Nullifier]*TxChoice
+	txRecords map[types.ID]*txRecord
+
+	notifications     []*notify.Subscriber[*Notification]
+	notificationsLock sync.RWMutex
 }
 
 // NewConsensusEngine returns a new ConsensusEngine
@@ -133,17 +174,21 @@ func NewConsensusEngine(ctx context.Context, opts ...Option) (*ConsensusEngine,
 		chooser:      NewBackoffChooser(cfg.chooser),
 		params:       cfg.params,
 		self:         cfg.self,
-		ms:           net.NewMessageSender(cfg.network.Host(), cfg.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion),
+		ms:           net.NewMessageSender(cfg.network.Host(), cfg.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion, cfg.params.ProtocolPrefix+ConsensusProtocol+ConsensusLegacyProtocolVersion),
 		wg:           sync.WaitGroup{},
 		requestBlock: cfg.requestBlock,
+		requestTx:    cfg.requestTx,
 		getBlockID:   cfg.getBlockIDFunc,
 		quit:         make(chan struct{}),
 		msgChan:      make(chan interface{}),
 		blocks:       make(map[uint32]*BlockChoice),
 		queries:      make(map[string]RequestRecord),
 		callbacks:    make(map[types.ID]chan<- Status),
+		txChoices:    make(map[types.Nullifier]*TxChoice),
+		txRecords:    make(map[types.ID]*txRecord),
 	}
 	eng.network.Host().SetStreamHandler(eng.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion, eng.HandleNewStream)
+	eng.network.Host().SetStreamHandler(eng.params.ProtocolPrefix+ConsensusProtocol+ConsensusLegacyProtocolVersion, eng.HandleNewStream)
 	eng.wg.Add(1)
 	go eng.handler()
 	return eng, nil
@@ -168,6 +213,8 @@ out:
 				eng.handleQuery(msg.request, msg.remotePeer, msg.respChan)
 			case *newBlockMessage:
 				eng.handleNewBlock(msg.header, msg.isAcceptable, msg.callback)
+			case *newTxMessage:
+				eng.handleNewTransaction(msg.txid, msg.nullifiers, msg.isAcceptable, msg.callback)
 			case *registerVotesMsg:
 				eng.handleRegisterVotes(msg.p, msg.resp)
 			}
@@ -185,6 +232,8 @@ out:
 // status (either Finalized or Rejected). Unfinalized but NotPreffered blocks will remain active
 // in the engine until a conflicting block at the same height is finalized. At that point the block
 // will be marked as Rejected.
+//
+// Subscribers will be notified of the block's progress through consensus. See Subscribe.
 func (eng *ConsensusEngine) NewBlock(header *blocks.BlockHeader, isAcceptable bool, callback chan<- Status) {
 	log.WithCaller(true).Trace("Consensus engine new block", log.ArgsFromMap(map[string]any{
 		"id":     header.ID().String(),
@@ -212,6 +261,12 @@ func (eng *ConsensusEngine) handleNewBlock(header *blocks.BlockHeader, isAccepta
 	}
 
 	bc.AddNewBlock(blockID, isAcceptable)
+	eng.sendNotification(NTBlockSeen, &BlockProgress{
+		BlockID:    blockID,
+		Height:     header.Height,
+		Status:     bc.blockVotes[blockID].Status(),
+		Confidence: bc.blockVotes[blockID].getConfidence(),
+	})
 
 	if len(bc.blockVotes) > 1 {
 		log.Debug("Conflicting block received by consensus engine", log.ArgsFromMap(map[string]any{
@@ -224,6 +279,80 @@ func (eng *ConsensusEngine) handleNewBlock(header *blocks.BlockHeader, isAccepta
 	eng.callbacks[blockID] = callback
 }
 
+// NewTransaction is used to pass a conflicting transaction into the engine. The
+// transaction will be added to the conflict set for each of its nullifiers and
+// the engine will poll the validators for their preferred spend of each nullifier.
+//
+// The callback channel will return the final status. The transaction is Finalized
+// once it has finalized in every one of its conflict sets. It is Rejected as soon
+// as a conflicting transaction finalizes in any one of them. If the vote on one of
+// its conflict sets expires after DeleteInventoryAfter the callback returns
+// StatusPreferred if the transaction is the preferred spend, otherwise Rejected.
+//
+// Transactions should be added in the order they were first seen as the first
+// acceptable transaction in each set will be the initial preference.
+func (eng *ConsensusEngine) NewTransaction(txid types.ID, nullifiers []types.Nullifier, isAcceptable bool, callback chan<- Status) {
+	log.WithCaller(true).Trace("Consensus engine new transaction", log.ArgsFromMap(map[string]any{
+		"txid":       txid.String(),
+		"nullifiers": len(nullifiers),
+	}))
+	nullifiersCpy := make([]types.Nullifier, 0, len(nullifiers))
+	for _, n := range nullifiers {
+		nullifiersCpy = append(nullifiersCpy, n.Clone())
+	}
+	eng.msgChan <- &newTxMessage{
+		txid:         txid.Clone(),
+		nullifiers:   nullifiersCpy,
+		isAcceptable: isAcceptable,
+		callback:     callback,
+	}
+}
+
+func (eng *ConsensusEngine) handleNewTransaction(txid types.ID, nullifiers []types.Nullifier, isAcceptable bool, callback chan<- Status) {
+	if _, ok := eng.txRecords[txid]; ok {
+		return
+	}
+
+	// If a conflicting transaction has already finalized then
+	// there is nothing to vote on. This transaction is rejected.
+	for _, n := range nullifiers {
+		tc, ok := eng.txChoices[n]
+		if ok && tc.HasFinalized() && !tc.HasTx(txid) {
+			if callback != nil {
+				go func(cb chan<- Status) {
+					cb <- StatusRejected
+				}(callback)
+			}
+			return
+		}
+	}
+
+	for _, n := range nullifiers {
+		tc, ok := eng.txChoices[n]
+		if !ok {
+			tc = NewTxChoice(n)
+			eng.txChoices[n] = tc
+		}
+		if tc.HasTx(txid) {
+			continue
+		}
+		tc.AddNewTx(txid, isAcceptable)
+
+		if len(tc.blockVotes) > 1 {
+			log.Debug("Conflicting transaction received by consensus engine", log.ArgsFromMap(map[string]any{
+				"txid":      txid.String(),
+				"nullifier": n.String(),
+				"conflicts": len(tc.blockVotes),
+			}))
+		}
+	}
+
+	eng.txRecords[txid] = &txRecord{
+		nullifiers: nullifiers,
+		callback:   callback,
+	}
+}
+
 // HandleNewStream handles incoming streams from peers. We use one stream for
 // incoming and a separate one for outgoing.
 func (eng *ConsensusEngine) HandleNewStream(s inet.Stream) {
@@ -294,7 +423,7 @@ func (eng *ConsensusEngine) handleNewMessage(s inet.Stream) {
 }
 
 func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer.ID, respChan chan *wire.MsgAvaResponse) {
-	if len(req.Heights) == 0 {
+	if len(req.Heights) == 0 && len(req.Nullifiers) == 0 {
 		log.WithCaller(true).Trace("Received empty avalanche request", log.Args("peer", remotePeer))
 		eng.network.IncreaseBanscore(remotePeer, 30, 0)
 		return
@@ -302,6 +431,7 @@ func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer
 	resp := &wire.MsgAvaResponse{
 		Request_ID: req.Request_ID,
 		Votes:      make([][]byte, 0, len(req.Heights)),
+		TxVotes:    make([][]byte, 0, len(req.Nullifiers)),
 	}
 
 	for _, height := range req.Heights {
@@ -319,6 +449,14 @@ func (eng *ConsensusEngine) handleQuery(req *wire.MsgAvaRequest, remotePeer peer
 		resp.Votes = append(resp.Votes, preference.Bytes())
 	}
 
+	for _, n := range req.Nullifiers {
+		preference := types.ID{}
+		if tc, ok := eng.txChoices[types.NewNullifier(n)]; ok {
+			preference = tc.GetPreference()
+		}
+		resp.TxVotes = append(resp.TxVotes, preference.Bytes())
+	}
+
 	respChan <- resp
 }
 
@@ -336,6 +474,12 @@ func (eng *ConsensusEngine) handleRequestExpiration(key string, p peer.ID) {
 			bc.inflightRequests--
 		}
 	}
+	for _, n := range r.GetNullifiers() {
+		tc, ok := eng.txChoices[n]
+		if ok {
+			tc.inflightRequests--
+		}
+	}
 }
 
 func (eng *ConsensusEngine) queueMessageToPeer(req *wire.MsgAvaRequest, peer peer.ID) {
@@ -390,7 +534,8 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 	}
 
 	heights := r.GetHeights()
-	if len(resp.Votes) != len(heights) {
+	nullifiers := r.GetNullifiers()
+	if len(resp.Votes) != len(heights) || len(resp.TxVotes) != len(nullifiers) {
 		log.Debug("Received avalanche response with an incorrect number of votes", log.Args("peer", p))
 		eng.network.IncreaseBanscore(p, 30, 0)
 		return
@@ -423,8 +568,24 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 			voteID = types.ID{}
 		}
 
+		var prior map[types.ID]*BlockProgress
+		if eng.hasSubscribers() {
+			prior = blockProgress(bc)
+		}
+
+		finalizedID, finalized := bc.RecordVote(voteID)
+		if !finalized && prior != nil {
+			eng.notifyProgress(bc, prior)
+		}
+
 		// Block finalized, fire callbacks
-		if finalizedID, ok := bc.RecordVote(voteID); ok {
+		if finalized {
+			eng.sendNotification(NTBlockFinalized, &BlockProgress{
+				BlockID:    finalizedID,
+				Height:     height,
+				Status:     StatusFinalized,
+				Confidence: bc.blockVotes[finalizedID].getConfidence(),
+			})
 			callback, ok := eng.callbacks[finalizedID]
 			if ok && callback != nil {
 				delete(eng.callbacks, finalizedID)
@@ -433,8 +594,14 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 				}(callback)
 			}
 
-			for id := range bc.blockVotes {
+			for id, rec := range bc.blockVotes {
 				if id.Compare(finalizedID) != 0 {
+					eng.sendNotification(NTBlockRejected, &BlockProgress{
+						BlockID:    id,
+						Height:     height,
+						Status:     StatusRejected,
+						Confidence: rec.getConfidence(),
+					})
 					callback, ok := eng.callbacks[id]
 					if ok && callback != nil {
 						delete(eng.callbacks, id)
@@ -446,6 +613,102 @@ func (eng *ConsensusEngine) handleRegisterVotes(p peer.ID, resp *wire.MsgAvaResp
 			}
 		}
 	}
+
+	for i, n := range nullifiers {
+		tc, ok := eng.txChoices[n]
+		if !ok {
+			// We are not voting on this anymore
+			continue
+		}
+		tc.inflightRequests--
+		if tc.HasFinalized() {
+			continue
+		}
+
+		if len(resp.TxVotes[i]) != hash.HashSize {
+			log.Debug("Received avalanche response with an incorrect hash length", log.Args("peer", p))
+			eng.network.IncreaseBanscore(p, 30, 0)
+			continue
+		}
+
+		voteID := types.NewID(resp.TxVotes[i])
+		if !tc.HasTx(voteID) {
+			// If we don't know about this transaction let's request
+			// it and also record it as an unknown vote.
+			if voteID.Compare(types.ID{}) != 0 && eng.requestTx != nil {
+				go eng.requestTx(voteID, p)
+			}
+			voteID = types.ID{}
+		}
+
+		if finalizedID, ok := tc.RecordVote(voteID); ok {
+			eng.handleTxFinalization(tc, finalizedID)
+		}
+	}
+}
+
+// handleTxFinalization fires the callbacks for the transactions in
+// the conflict set after one has been finalized.
+func (eng *ConsensusEngine) handleTxFinalization(tc *TxChoice, finalizedID types.ID) {
+	log.Debug("Conflicting transaction finalized", log.ArgsFromMap(map[string]any{
+		"txid":      finalizedID.String(),
+		"nullifier": tc.nullifier.String(),
+	}))
+
+	// The transaction is only finalized once it has
+	// finalized in every one of its conflict sets.
+	if rec, ok := eng.txRecords[finalizedID]; ok {
+		rec.finalized++
+		if rec.finalized >= len(rec.nullifiers) {
+			delete(eng.txRecords, finalizedID)
+			if rec.callback != nil {
+				go func(cb chan<- Status) {
+					cb <- StatusFinalized
+				}(rec.callback)
+			}
+		}
+	}
+
+	for id := range tc.blockVotes {
+		if id.Compare(finalizedID) == 0 {
+			continue
+		}
+		rec, ok := eng.txRecords[id]
+		if !ok {
+			continue
+		}
+		delete(eng.txRecords, id)
+		if rec.callback != nil {
+			go func(cb chan<- Status) {
+				cb <- StatusRejected
+			}(rec.callback)
+		}
+	}
+}
+
+// handleTxExpiration fires the callbacks for the transactions in the
+// conflict set when it is deleted without having finalized. The preferred
+// spend is reported as StatusPreferred and the others as StatusRejected.
+func (eng *ConsensusEngine) handleTxExpiration(tc *TxChoice) {
+	log.Debug("Conflicting transaction vote expired", log.Args("nullifier", tc.nullifier.String()))
+
+	preference := tc.GetPreference()
+	for id := range tc.blockVotes {
+		rec, ok := eng.txRecords[id]
+		if !ok {
+			continue
+		}
+		delete(eng.txRecords, id)
+		if rec.callback != nil {
+			status := StatusRejected
+			if id.Compare(preference) == 0 {
+				status = StatusPreferred
+			}
+			go func(cb chan<- Status, status Status) {
+				cb <- status
+			}(rec.callback, status)
+		}
+	}
 }
 
 func (eng *ConsensusEngine) pollLoop() {
@@ -475,23 +738,61 @@ func (eng *ConsensusEngine) pollLoop() {
 		record.inflightRequests++
 		heights = append(heights, height)
 	}
-	if len(heights) == 0 {
+
+	var (
+		nullifiers    []types.Nullifier
+		nullifierData [][]byte
+		txVotes       = eng.supportsTxVotes(p)
+	)
+	for n, record := range eng.txChoices {
+		if time.Since(record.timestamp) > DeleteInventoryAfter {
+			eng.handleTxExpiration(record)
+			delete(eng.txChoices, n)
+			continue
+		}
+
+		if record.HasFinalized() || !txVotes {
+			continue
+		}
+
+		if record.inflightRequests+1 > record.VotesNeededToFinalize() {
+			continue
+		}
+
+		record.inflightRequests++
+		nullifiers = append(nullifiers, n)
+		nullifierData = append(nullifierData, n.Bytes())
+	}
+
+	if len(heights) == 0 && len(nullifiers) == 0 {
 		return
 	}
 
 	requestID := rand.Uint32()
 
 	key := queryKey(requestID, p.String())
-	eng.queries[key] = NewRequestRecord(time.Now().Unix(), heights)
+	eng.queries[key] = NewRequestRecord(time.Now().Unix(), heights, nullifiers)
 
 	req := &wire.MsgAvaRequest{
 		Request_ID: requestID,
 		Heights:    heights,
+		Nullifiers: nullifierData,
 	}
 
 	go eng.queueMessageToPeer(req, p)
 }
 
+// supportsTxVotes returns whether the peer supports the version of the
+// consensus protocol with transaction votes. Peers which only support
+// the legacy version would not return votes for the nullifiers.
+func (eng *ConsensusEngine) supportsTxVotes(p peer.ID) bool {
+	if p == eng.self {
+		return true
+	}
+	protocols, err := eng.network.Host().Peerstore().SupportsProtocols(p, eng.params.ProtocolPrefix+ConsensusProtocol+ConsensusProtocolVersion)
+	return err == nil && len(protocols) > 0
+}
+
 func queryKey(requestID uint32, peerID string) string {
 	return fmt.Sprintf("%d|%s", requestID, peerID)
 }
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"github.com/libp2p/go-libp2p/core/peer"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
@@ -17,6 +19,7 @@ import (
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/zk"
 	"github.com/stretchr/testify/assert"
 	rand "math/rand"
 	"testing"
@@ -39,6 +42,16 @@ func (m *MockChooser) WeightedRandomValidator() peer.ID {
 	return peers[i]
 }
 
+// mockSelfChooser is a mock WeightedChooser which always
+// returns our own node.
+type mockSelfChooser struct {
+	self peer.ID
+}
+
+func (m *mockSelfChooser) WeightedRandomValidator() peer.ID {
+	return m.self
+}
+
 type MockValConn struct{}
 
 func (m *MockValConn) ConnectedStakePercentage() float64 {
@@ -138,6 +151,53 @@ func TestConsensusEngine(t *testing.T) {
 		}
 	})
 
+	t.Run("Test block finalization notifications", func(t *testing.T) {
+		nodes, testNode, teardown, err := setup()
+		assert.NoError(t, err)
+		defer teardown()
+
+		ntfChan := make(chan *Notification)
+		testNode.engine.Subscribe(func(n *Notification) {
+			ntfChan <- n
+		})
+
+		blk1 := &blocks.Block{Header: &blocks.BlockHeader{Height: 1}}
+		for _, node := range nodes {
+			node.engine.NewBlock(blk1.Header, true, nil)
+		}
+		testNode.engine.NewBlock(blk1.Header, true, nil)
+
+		// The notifications arrive in order. The block is seen first
+		// and its confidence only increases until it is finalized.
+		seen := false
+		confidence := uint16(0)
+		ticker := time.NewTicker(time.Second * 30)
+	loop:
+		for {
+			select {
+			case n := <-ntfChan:
+				progress, ok := n.Data.(*BlockProgress)
+				assert.True(t, ok)
+				assert.Equal(t, blk1.ID(), progress.BlockID)
+				assert.Equal(t, n.Type == NTBlockSeen, !seen)
+				assert.GreaterOrEqual(t, progress.Confidence, confidence)
+				confidence = progress.Confidence
+				switch n.Type {
+				case NTBlockSeen:
+					seen = true
+				case NTBlockFinalized:
+					assert.Equal(t, StatusFinalized, progress.Status)
+					break loop
+				case NTBlockRejected:
+					t.Fatal("Block 1 should not have been rejected")
+				}
+			case <-ticker.C:
+				t.Fatal("Failed to receive finalization notification for block 1")
+			}
+		}
+		assert.True(t, seen)
+	})
+
 	t.Run(" Test block finalization when all nodes reject", func(t *testing.T) {
 		nodes, testNode, teardown, err := setup()
 		assert.NoError(t, err)
@@ -296,6 +356,149 @@ func TestConsensusEngine(t *testing.T) {
 		}
 	})
 
+	t.Run("Test transaction finalization of conflicting spends", func(t *testing.T) {
+		nodes, testNode, teardown, err := setup()
+		assert.NoError(t, err)
+		defer teardown()
+
+		nullifier := types.NewNullifier(randomBlockID().Bytes())
+		txA, txB := randomBlockID(), randomBlockID()
+		for _, node := range nodes {
+			node.engine.NewTransaction(txA, []types.Nullifier{nullifier}, true, nil)
+			node.engine.NewTransaction(txB, []types.Nullifier{nullifier}, true, nil)
+		}
+
+		cba := make(chan Status)
+		cbb := make(chan Status)
+		testNode.engine.NewTransaction(txB, []types.Nullifier{nullifier}, true, cbb)
+		testNode.engine.NewTransaction(txA, []types.Nullifier{nullifier}, true, cba)
+
+		ticker := time.NewTicker(time.Second * 30)
+		select {
+		case status := <-cba:
+			assert.Equal(t, StatusFinalized, status)
+		case <-ticker.C:
+			t.Errorf("Failed to finalize tx A for test node")
+		}
+		select {
+		case status := <-cbb:
+			assert.Equal(t, StatusRejected, status)
+		case <-ticker.C:
+			t.Errorf("Failed to reject tx B for test node")
+		}
+	})
+
+	t.Run("Test unknown conflicting spends are requested", func(t *testing.T) {
+		nodes, testNode, teardown, err := setup()
+		assert.NoError(t, err)
+		defer teardown()
+
+		requested := make(chan types.ID, 1)
+		testNode.engine.requestTx = func(txid types.ID, p peer.ID) {
+			select {
+			case requested <- txid:
+			default:
+			}
+		}
+
+		nullifier := types.NewNullifier(randomBlockID().Bytes())
+		txA, txB := randomBlockID(), randomBlockID()
+		for _, node := range nodes {
+			node.engine.NewTransaction(txA, []types.Nullifier{nullifier}, true, nil)
+		}
+		testNode.engine.NewTransaction(txB, []types.Nullifier{nullifier}, true, nil)
+
+		select {
+		case txid := <-requested:
+			assert.Equal(t, txA, txid)
+		case <-time.After(time.Second * 30):
+			t.Errorf("Failed to request unknown tx A for test node")
+		}
+	})
+
+	t.Run("Test fee bumping replacements are finalized", func(t *testing.T) {
+		nodes, testNode, teardown, err := setup()
+		assert.NoError(t, err)
+		defer teardown()
+
+		mempools := make([]*mempool.Mempool, 0, len(nodes))
+		for _, node := range nodes {
+			m, err := newMockMempool(node.engine)
+			assert.NoError(t, err)
+			defer m.Close()
+			mempools = append(mempools, m)
+		}
+		testMempool, err := newMockMempool(testNode.engine)
+		assert.NoError(t, err)
+		defer testMempool.Close()
+
+		// Half the nodes see the replacement before the original. Every
+		// node should prefer the replacement regardless.
+		nullifier := randomBlockID()
+		orig, bump := newMockTx(20000, nullifier), newMockTx(100000, nullifier)
+		for i, m := range mempools {
+			txs := []*transactions.Transaction{orig, bump}
+			if i%2 == 0 {
+				txs[0], txs[1] = txs[1], txs[0]
+			}
+			for _, tx := range txs {
+				assert.NoError(t, m.ProcessTransaction(tx))
+			}
+		}
+		assert.NoError(t, testMempool.ProcessTransaction(orig))
+		assert.NoError(t, testMempool.ProcessTransaction(bump))
+
+		assert.Eventually(t, func() bool {
+			_, err := testMempool.GetEntry(orig.ID())
+			return errors.Is(err, mempool.ErrNotFound)
+		}, time.Second*30, time.Millisecond*100)
+
+		entry, err := testMempool.GetEntry(bump.ID())
+		assert.NoError(t, err)
+		assert.Equal(t, mempool.EntryPending, entry.Status)
+	})
+
+	t.Run("Test block finalization with legacy peers", func(t *testing.T) {
+		mn := mocknet.New()
+		nodes := make([]*mockNode, 0, 100)
+		for i := 0; i < 100; i++ {
+			node, err := newMockNode(mn)
+			assert.NoError(t, err)
+			// Make the node look like a peer that has not upgraded.
+			node.engine.network.Host().RemoveStreamHandler(params.RegestParams.ProtocolPrefix + ConsensusProtocol + ConsensusProtocolVersion)
+			nodes = append(nodes, node)
+		}
+		testNode, err := newMockNode(mn)
+		assert.NoError(t, err)
+		assert.NoError(t, mn.LinkAll())
+		assert.NoError(t, mn.ConnectAllButSelf())
+		defer func() {
+			for _, n := range nodes {
+				n.engine.Close()
+			}
+			testNode.engine.Close()
+			mn.Close()
+		}()
+
+		blk1 := &blocks.Block{Header: &blocks.BlockHeader{Height: 1}}
+		nullifier := types.NewNullifier(randomBlockID().Bytes())
+		txA := randomBlockID()
+		for _, node := range nodes {
+			node.engine.NewBlock(blk1.Header, true, nil)
+		}
+
+		// Legacy peers are only polled for blocks.
+		testNode.engine.NewTransaction(txA, []types.Nullifier{nullifier}, true, nil)
+		cb := make(chan Status)
+		testNode.engine.NewBlock(blk1.Header, true, cb)
+		select {
+		case status := <-cb:
+			assert.Equal(t, status, StatusFinalized)
+		case <-time.After(time.Second * 30):
+			t.Errorf("Failed to finalized block 1")
+		}
+	})
+
 	t.Run("Test block finalization of all nodes with conflicting blocks", func(t *testing.T) {
 		nodes, testNode, teardown, err := setup()
 		assert.NoError(t, err)
@@ -481,3 +684,101 @@ func TestConsensusEngine(t *testing.T) {
 		}
 	})
 }
+
+type mockChainView struct{}
+
+func (m *mockChainView) TreasuryBalance() (types.Amount, error) {
+	return 0, nil
+}
+
+func (m *mockChainView) TxoRootExists(txoRoot types.ID) (bool, error) {
+	return true, nil
+}
+
+func (m *mockChainView) NullifierExists(n types.Nullifier) (bool, error) {
+	return false, nil
+}
+
+func (m *mockChainView) GetValidator(validatorID peer.ID) (*blockchain.Validator, error) {
+	return nil, errors.New("not found")
+}
+
+// newMockMempool returns a mempool which passes conflicting transactions
+// to the engine and reports the outcome back the same way the server does.
+func newMockMempool(engine *ConsensusEngine) (*mempool.Mempool, error) {
+	verifier := &zk.MockVerifier{}
+	verifier.SetValid(true)
+
+	var m *mempool.Mempool
+	m, err := mempool.NewMempool(
+		mempool.DefaultOptions(),
+		mempool.BlockchainView(&mockChainView{}),
+		mempool.Verifier(verifier),
+		mempool.ConflictHandler(func(txs []*transactions.Transaction) {
+			for _, tx := range txs {
+				callback := make(chan Status)
+				engine.NewTransaction(tx.ID(), tx.Nullifiers(), true, callback)
+				go func(txid types.ID) {
+					switch <-callback {
+					case StatusFinalized:
+						m.ConflictFinalized(txid)
+					case StatusRejected:
+						m.ConflictRejected(txid)
+					}
+				}(tx.ID())
+			}
+		}),
+	)
+	return m, err
+}
+
+// newMockTx returns a standard transaction spending the nullifier
+// which passes the mempool's checks with a mock verifier.
+func newMockTx(fee uint64, nullifier types.ID) *transactions.Transaction {
+	return transactions.WrapTransaction(&transactions.StandardTransaction{
+		Outputs: []*transactions.Output{
+			{
+				Commitment: make([]byte, types.CommitmentLen),
+				Ciphertext: make([]byte, blockchain.CiphertextLen),
+			},
+		},
+		Nullifiers: [][]byte{nullifier.Bytes()},
+		TxoRoot:    randomBlockID().Bytes(),
+		Fee:        fee,
+		Proof:      make([]byte, 1000),
+	})
+}
+
+func TestTxVoteExpiration(t *testing.T) {
+	self := peer.ID("self")
+	eng := &ConsensusEngine{
+		valConn:   &MockValConn{},
+		chooser:   NewBackoffChooser(&mockSelfChooser{self: self}),
+		self:      self,
+		queries:   make(map[string]RequestRecord),
+		txChoices: make(map[types.Nullifier]*TxChoice),
+		txRecords: make(map[types.ID]*txRecord),
+	}
+
+	nullifier := types.NewNullifier(randomBlockID().Bytes())
+	txA, txB := randomBlockID(), randomBlockID()
+	cba := make(chan Status)
+	cbb := make(chan Status)
+	eng.handleNewTransaction(txA, []types.Nullifier{nullifier}, true, cba)
+	eng.handleNewTransaction(txB, []types.Nullifier{nullifier}, true, cbb)
+
+	eng.txChoices[nullifier].timestamp = time.Now().Add(-DeleteInventoryAfter - time.Second)
+	eng.pollLoop()
+
+	assert.Empty(t, eng.txChoices)
+	assert.Empty(t, eng.txRecords)
+
+	for cb, expected := range map[chan Status]Status{cba: StatusPreferred, cbb: StatusRejected} {
+		select {
+		case status := <-cb:
+			assert.Equal(t, expected, status)
+		case <-time.After(time.Second * 10):
+			t.Errorf("Failed to fire callback for expired vote")
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"fmt"
+	"github.com/project-illium/ilxd/notify"
+	"github.com/project-illium/ilxd/types"
+)
+
+// ConfidenceNotificationInterval is the number of confidence points
+// between NTBlockConfidence notifications for a block.
+const ConfidenceNotificationInterval = AvalancheFinalizationScore / 10
+
+// NotificationType represents the type of a notification message.
+type NotificationType int
+
+// NotificationCallback is used for a caller to provide a callback for
+// notifications about consensus events.
+type NotificationCallback func(*Notification)
+
+// Constants for the type of notification message
+const (
+	// NTBlockSeen indicates the block was passed into the engine
+	// for the first time.
+	NTBlockSeen NotificationType = iota
+
+	// NTBlockConfidence indicates the block's preference or confidence
+	// has changed.
+	NTBlockConfidence
+
+	// NTBlockFinalized indicates the block was finalized.
+	NTBlockFinalized
+
+	// NTBlockRejected indicates the block was rejected because a
+	// conflicting block was finalized.
+	NTBlockRejected
+)
+
+// notificationTypeStrings is a map of notification types back to their constant
+// names for pretty printing.
+var notificationTypeStrings = map[NotificationType]string{
+	NTBlockSeen:       "NTBlockSeen",
+	NTBlockConfidence: "NTBlockConfidence",
+	NTBlockFinalized:  "NTBlockFinalized",
+	NTBlockRejected:   "NTBlockRejected",
+}
+
+// String returns the NotificationType in human-readable form.
+func (n NotificationType) String() string {
+	if s, ok := notificationTypeStrings[n]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown Notification Type (%d)", int(n))
+}
+
+// BlockProgress is the consensus state of a block at the time
+// the notification was sent.
+type BlockProgress struct {
+	BlockID    types.ID
+	Height     uint32
+	Status     Status
+	Confidence uint16
+}
+
+// Notification defines a notification that is sent to the subscribers
+// and consists of a notification type as well as associated data that
+// depends on the type as follows:
+//   - NTBlockSeen:       *BlockProgress
+//   - NTBlockConfidence: *BlockProgress
+//   - NTBlockFinalized:  *BlockProgress
+//   - NTBlockRejected:   *BlockProgress
+type Notification struct {
+	Type NotificationType
+	Data interface{}
+}
+
+// Subscribe to consensus notifications. Registers a callback to be executed
+// when various events take place. See the documentation on Notification and
+// NotificationType for details on the types and contents of notifications.
+//
+// Notifications are delivered to the callback in the order in which the
+// events took place, so the notifications for a block always arrive as
+// seen, then confidence, then finalized or rejected. If the callback falls
+// more than notify.DefaultQueueSize notifications behind the oldest queued
+// notifications are dropped.
+func (eng *ConsensusEngine) Subscribe(callback NotificationCallback) {
+	sub := notify.NewSubscriber[*Notification](callback, notify.DefaultQueueSize)
+	eng.notificationsLock.Lock()
+	eng.notifications = append(eng.notifications, sub)
+	eng.notificationsLock.Unlock()
+
+	go sub.Run(eng.quit)
+}
+
+// sendNotification sends a notification with the passed type and data to
+// each subscriber.
+func (eng *ConsensusEngine) sendNotification(typ NotificationType, data interface{}) {
+	n := Notification{Type: typ, Data: data}
+	eng.notificationsLock.RLock()
+	for _, sub := range eng.notifications {
+		if sub.Send(&n) {
+			log.Warn("Consensus notification subscriber is falling behind, dropping notifications")
+		}
+	}
+	eng.notificationsLock.RUnlock()
+}
+
+// hasSubscribers returns whether anyone has subscribed to notifications.
+func (eng *ConsensusEngine) hasSubscribers() bool {
+	eng.notificationsLock.RLock()
+	defer eng.notificationsLock.RUnlock()
+	return len(eng.notifications) > 0
+}
+
+// blockProgress returns the current progress of each block at
+// the BlockChoice's height.
+func blockProgress(bc *BlockChoice) map[types.ID]*BlockProgress {
+	progress := make(map[types.ID]*BlockProgress, len(bc.blockVotes))
+	for id, rec := range bc.blockVotes {
+		progress[id] = &BlockProgress{
+			BlockID:    id,
+			Height:     bc.height,
+			Status:     rec.Status(),
+			Confidence: rec.getConfidence(),
+		}
+	}
+	return progress
+}
+
+// notifyProgress sends a NTBlockConfidence notification for each block
+// whose status has changed, or whose confidence has crossed a notification
+// interval, since the prior progress was recorded.
+func (eng *ConsensusEngine) notifyProgress(bc *BlockChoice, prior map[types.ID]*BlockProgress) {
+	for id, progress := range blockProgress(bc) {
+		prev, ok := prior[id]
+		if !ok || prev.Status != progress.Status ||
+			prev.Confidence/ConfidenceNotificationInterval != progress.Confidence/ConfidenceNotificationInterval {
+
+			eng.sendNotification(NTBlockConfidence, progress)
+		}
+	}
+}
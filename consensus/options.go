@@ -78,6 +78,16 @@ func RequestBlock(requestBlockFunc RequestBlockFunc) Option {
 	}
 }
 
+// RequestTx is a function which requests to download a transaction
+// from the given peer. If this is not set, votes for unknown transactions
+// are recorded as unknown votes without fetching the transaction.
+func RequestTx(requestTxFunc RequestTxFunc) Option {
+	return func(cfg *config) error {
+		cfg.requestTx = requestTxFunc
+		return nil
+	}
+}
+
 // GetBlockID is a function which returns the blockID at the given height or
 // an error.
 //
@@ -107,6 +117,7 @@ type config struct {
 	chooser        blockchain.WeightedChooser
 	self           peer.ID
 	requestBlock   RequestBlockFunc
+	requestTx      RequestTxFunc
 	getBlockIDFunc GetBlockIDFunc
 }
 
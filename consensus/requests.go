@@ -5,18 +5,20 @@
 package consensus
 
 import (
+	"github.com/project-illium/ilxd/types"
 	"time"
 )
 
 // RequestRecord is a poll request for more votes
 type RequestRecord struct {
-	timestamp int64
-	heights   []uint32
+	timestamp  int64
+	heights    []uint32
+	nullifiers []types.Nullifier
 }
 
 // NewRequestRecord creates a new RequestRecord
-func NewRequestRecord(timestamp int64, heights []uint32) RequestRecord {
-	return RequestRecord{timestamp, heights}
+func NewRequestRecord(timestamp int64, heights []uint32, nullifiers []types.Nullifier) RequestRecord {
+	return RequestRecord{timestamp, heights, nullifiers}
 }
 
 // GetTimestamp returns the timestamp that the request was created
@@ -29,6 +31,12 @@ func (r RequestRecord) GetHeights() []uint32 {
 	return r.heights
 }
 
+// GetNullifiers returns the nullifiers of the conflicting transaction
+// sets that were requested in this request.
+func (r RequestRecord) GetNullifiers() []types.Nullifier {
+	return r.nullifiers
+}
+
 // IsExpired returns true if the request has expired
 func (r RequestRecord) IsExpired() bool {
 	return time.Unix(r.timestamp, 0).Add(AvalancheRequestTimeout).Before(time.Now())
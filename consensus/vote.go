@@ -270,6 +270,37 @@ func (bc *BlockChoice) RecordVote(voteID types.ID) (types.ID, bool) {
 	return types.ID{}, false
 }
 
+// TxChoice represents a choice between conflicting transactions
+// that spend the same nullifier.
+//
+// The voting works exactly like it does for blocks with the txid
+// standing in for the block ID. The transaction with the finalized
+// ID is the only valid spend of the nullifier.
+type TxChoice struct {
+	*BlockChoice
+	nullifier types.Nullifier
+}
+
+// NewTxChoice returns a new TxChoice for this nullifier
+func NewTxChoice(n types.Nullifier) *TxChoice {
+	return &TxChoice{
+		BlockChoice: NewBlockChoice(0),
+		nullifier:   n,
+	}
+}
+
+// HasTx returns whether a transaction is currently saved for this nullifier
+func (tc *TxChoice) HasTx(txid types.ID) bool {
+	return tc.HasBlock(txid)
+}
+
+// AddNewTx adds a new transaction spending this nullifier. If there currently
+// is no preference, and if this transaction is acceptable, it will be selected
+// as the new preference.
+func (tc *TxChoice) AddNewTx(txid types.ID, isAcceptable bool) {
+	tc.AddNewBlock(txid, isAcceptable)
+}
+
 // BitVoteRecord is responsible for tracking and finalizing bits.
 // We start with the most significant bit (MSB) and attempt to finalize
 // a 0 or 1 based on the MSB of the block ID votes. Since we're only
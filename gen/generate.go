@@ -9,9 +9,8 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/mempool"
-	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/policy"
 	"github.com/project-illium/ilxd/types/blocks"
-	"github.com/project-illium/ilxd/types/transactions"
 	"sort"
 	"sync"
 	"time"
@@ -30,6 +29,7 @@ type BlockGenerator struct {
 	lastGenHeight  uint32
 	lastGenTime    time.Time
 	mpool          *mempool.Mempool
+	policy         *policy.Policy
 	tickInterval   time.Duration
 	chain          *blockchain.Blockchain
 	broadcast      func(blk *blocks.XThinnerBlock) error
@@ -70,6 +70,7 @@ func NewBlockGenerator(opts ...Option) (*BlockGenerator, error) {
 		ownPeerIDBytes: ownPeerIDBytes,
 		privKey:        cfg.privKey,
 		mpool:          cfg.mpool,
+		policy:         cfg.policy,
 		tickInterval:   cfg.tickInterval,
 		chain:          cfg.chain,
 		broadcast:      cfg.broadcastFunc,
@@ -188,50 +189,13 @@ func (g *BlockGenerator) generateBlock() error {
 		},
 	}
 
-	// The consensus rules prevent a stake tx and a spend of a staked
-	// nullifier from being in the same block. We'll loop through
-	// and remove any spends of stake if they were in the mempool.
-	txs := g.mpool.GetTransactions()
-	checkNullifiers := make(map[types.Nullifier]bool)
-	for _, tx := range txs {
-		if stake := tx.GetStakeTransaction(); stake != nil {
-			checkNullifiers[types.NewNullifier(stake.Nullifier)] = true
-		}
-	}
-	for txid, tx := range txs {
-		switch t := tx.Tx.(type) {
-		case *transactions.Transaction_StandardTransaction:
-			for _, n := range t.StandardTransaction.Nullifiers {
-				if checkNullifiers[types.NewNullifier(n)] {
-					delete(txs, txid)
-				}
-			}
-			if (t.StandardTransaction.Locktime != nil && t.StandardTransaction.Locktime.Timestamp > 0) &&
-				(t.StandardTransaction.Locktime.Timestamp > blockTime+t.StandardTransaction.Locktime.Precision ||
-					t.StandardTransaction.Locktime.Timestamp < blockTime-t.StandardTransaction.Locktime.Precision) {
-				delete(txs, txid)
-			}
-		case *transactions.Transaction_MintTransaction:
-			for _, n := range t.MintTransaction.Nullifiers {
-				if checkNullifiers[types.NewNullifier(n)] {
-					delete(txs, txid)
-				}
-			}
-
-			if (t.MintTransaction.Locktime != nil && t.MintTransaction.Locktime.Timestamp > 0) &&
-				(t.MintTransaction.Locktime.Timestamp > blockTime+t.MintTransaction.Locktime.Precision ||
-					t.MintTransaction.Locktime.Timestamp < blockTime-t.MintTransaction.Locktime.Precision) {
-				delete(txs, txid)
-			}
-		}
-	}
-	if len(txs) == 0 {
+	// Select the transactions by fee rate without exceeding our own
+	// blocksize soft limit or including transactions below our minimum
+	// fee. Otherwise our own policy would not prefer the block.
+	blk.Transactions = selectTransactions(blk.Header, g.mpool.GetTxDescs(), g.policy.GetBlocksizeSoftLimit(), g.policy.GetMinFeePerKilobyte())
+	if len(blk.Transactions) == 0 {
 		return nil
 	}
-	blk.Transactions = make([]*transactions.Transaction, 0, len(txs))
-	for _, tx := range txs {
-		blk.Transactions = append(blk.Transactions, tx)
-	}
 
 	sort.Sort(mempool.TxSorter(blk.Transactions))
 
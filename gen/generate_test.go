@@ -9,6 +9,7 @@ import (
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/blockchain/harness"
 	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/policy"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -44,6 +45,7 @@ func TestGenerator(t *testing.T) {
 	generator, err := NewBlockGenerator(
 		Blockchain(testHarness.Blockchain()),
 		Mempool(mpool),
+		Policy(policy.NewPolicy(0, 0, 1<<20)),
 		BroadcastFunc(broadcast),
 		PrivateKey(sk),
 		tickInterval(time.Millisecond),
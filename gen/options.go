@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/policy"
 	"github.com/project-illium/ilxd/types/blocks"
 	"time"
 )
@@ -66,6 +67,17 @@ func Mempool(mpool *mempool.Mempool) Option {
 	}
 }
 
+// Policy is the node's policy. The blocksize soft limit is used
+// to limit the size of generated blocks.
+//
+// This cannot be nil.
+func Policy(p *policy.Policy) Option {
+	return func(cfg *config) error {
+		cfg.policy = p
+		return nil
+	}
+}
+
 // PrivateKey is the private key for the validator.
 // It will be used to sign blocks.
 //
@@ -81,6 +93,7 @@ func PrivateKey(key crypto.PrivKey) Option {
 type config struct {
 	privKey       crypto.PrivKey
 	mpool         *mempool.Mempool
+	policy        *policy.Policy
 	tickInterval  time.Duration
 	chain         *blockchain.Blockchain
 	broadcastFunc func(blk *blocks.XThinnerBlock) error
@@ -96,6 +109,9 @@ func (cfg *config) validate() error {
 	if cfg.mpool == nil {
 		return AssertError("NewBlockGenerator: mempool cannot be nil")
 	}
+	if cfg.policy == nil {
+		return AssertError("NewBlockGenerator: policy cannot be nil")
+	}
 	if cfg.chain == nil {
 		return AssertError("NewBlockGenerator: WeightedChooser cannot be nil")
 	}
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"time"
+)
+
+// blockSignatureReserve is the number of bytes reserved for the block
+// signature when computing the size of a block template. The block is
+// not signed until its transactions have been selected.
+const blockSignatureReserve = 128
+
+// selectTransactions selects the transactions from the mempool to include
+// in a block with the given header without the block exceeding maxSize
+// bytes.
+//
+// Coinbase, treasury and stake transactions do not pay a fee and are added
+// first. The remaining space is filled with the fee paying transactions in
+// order of fee per kilobyte, including any delta set with
+// PrioritiseTransaction. Transactions which do not fit are skipped so that
+// smaller transactions with lower fee rates may still be added.
+//
+// The consensus rules prevent a stake tx and a spend of a staked nullifier
+// from being in the same block so spends of nullifiers staked by a
+// transaction in the mempool are excluded. So are transactions whose
+// locktime is not valid at the block's timestamp and transactions paying
+// less than minFee, not counting any delta, as the block would not be
+// acceptable to our own policy.
+func selectTransactions(header *blocks.BlockHeader, descs []*mempool.TxDesc, maxSize uint32, minFee types.Amount) []*transactions.Transaction {
+	stakeNullifiers := make(map[types.Nullifier]bool)
+	for _, desc := range descs {
+		if stake := desc.Tx.GetStakeTransaction(); stake != nil {
+			stakeNullifiers[types.NewNullifier(stake.Nullifier)] = true
+		}
+	}
+	blockTime := time.Unix(header.Timestamp, 0)
+
+	var reserved, feePayers []*mempool.TxDesc
+	for _, desc := range descs {
+		switch t := desc.Tx.Tx.(type) {
+		case *transactions.Transaction_StandardTransaction:
+			if spendsStake(t.StandardTransaction.Nullifiers, stakeNullifiers) ||
+				!blockchain.ValidateLocktime(blockTime, t.StandardTransaction.Locktime) {
+				continue
+			}
+		case *transactions.Transaction_MintTransaction:
+			if spendsStake(t.MintTransaction.Nullifiers, stakeNullifiers) ||
+				!blockchain.ValidateLocktime(blockTime, t.MintTransaction.Locktime) {
+				continue
+			}
+		}
+		if desc.IsFeePayer {
+			if desc.FeePerKilobyte < minFee {
+				continue
+			}
+			feePayers = append(feePayers, desc)
+		} else {
+			reserved = append(reserved, desc)
+		}
+	}
+
+	sort.Slice(reserved, func(i, j int) bool {
+		pi, pj := reservedPriority(reserved[i].Tx), reservedPriority(reserved[j].Tx)
+		if pi != pj {
+			return pi < pj
+		}
+		return lessTxid(reserved[i].Tx, reserved[j].Tx)
+	})
+	sort.Slice(feePayers, func(i, j int) bool {
+		fi, fj := feePayers[i].ModifiedFeePerKilobyte, feePayers[j].ModifiedFeePerKilobyte
+		if fi != fj {
+			return fi > fj
+		}
+		return lessTxid(feePayers[i].Tx, feePayers[j].Tx)
+	})
+
+	// The size of the block without transactions, with the fields that
+	// are not set until the transactions are selected filled in.
+	sizingHeader := proto.Clone(header).(*blocks.BlockHeader)
+	sizingHeader.TxRoot = make([]byte, hash.HashSize)
+	sizingHeader.Signature = make([]byte, blockSignatureReserve)
+	size := proto.Size(&blocks.Block{Header: sizingHeader})
+
+	txs := make([]*transactions.Transaction, 0, len(reserved)+len(feePayers))
+	for _, desc := range append(reserved, feePayers...) {
+		txSize := protowire.SizeTag(2) + protowire.SizeBytes(desc.Size)
+		if size+txSize > int(maxSize) {
+			continue
+		}
+		size += txSize
+		txs = append(txs, desc.Tx)
+	}
+	return txs
+}
+
+// reservedPriority returns the order in which transactions which do not
+// pay a fee are added to a block.
+func reservedPriority(tx *transactions.Transaction) int {
+	switch tx.Tx.(type) {
+	case *transactions.Transaction_CoinbaseTransaction:
+		return 0
+	case *transactions.Transaction_TreasuryTransaction:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func spendsStake(nullifiers [][]byte, stakeNullifiers map[types.Nullifier]bool) bool {
+	for _, n := range nullifiers {
+		if stakeNullifiers[types.NewNullifier(n)] {
+			return true
+		}
+	}
+	return false
+}
+
+func lessTxid(a, b *transactions.Transaction) bool {
+	return a.ID().Compare(b.ID()) < 0
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"crypto/rand"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSelectTransactions(t *testing.T) {
+	randBytes := func() []byte {
+		b := make([]byte, 32)
+		rand.Read(b)
+		return b
+	}
+	makeDesc := func(tx *transactions.Transaction, fpkb types.Amount) *mempool.TxDesc {
+		size, err := tx.SerializedSize()
+		assert.NoError(t, err)
+		return &mempool.TxDesc{
+			Tx:                     tx,
+			Size:                   size,
+			FeePerKilobyte:         fpkb,
+			ModifiedFeePerKilobyte: fpkb,
+			IsFeePayer:             fpkb > 0,
+		}
+	}
+	makeStandard := func(nullifier []byte, locktime *transactions.Locktime) *transactions.Transaction {
+		return transactions.WrapTransaction(&transactions.StandardTransaction{
+			Outputs: []*transactions.Output{
+				{
+					Commitment: randBytes(),
+					Ciphertext: make([]byte, blockchain.CiphertextLen),
+				},
+			},
+			Nullifiers: [][]byte{nullifier},
+			Locktime:   locktime,
+			Proof:      make([]byte, 1000),
+		})
+	}
+
+	now := time.Now()
+	header := &blocks.BlockHeader{
+		Height:    1,
+		Parent:    randBytes(),
+		Timestamp: now.Unix(),
+	}
+
+	stakedNullifier := randBytes()
+	coinbase := makeDesc(transactions.WrapTransaction(&transactions.CoinbaseTransaction{
+		Validator_ID: randBytes(),
+		NewCoins:     1,
+		Proof:        make([]byte, 1000),
+	}), 0)
+	stake := makeDesc(transactions.WrapTransaction(&transactions.StakeTransaction{
+		Nullifier: stakedNullifier,
+		Proof:     make([]byte, 1000),
+	}), 0)
+	high := makeDesc(makeStandard(randBytes(), nil), 30000)
+	medium := makeDesc(makeStandard(randBytes(), nil), 20000)
+	low := makeDesc(makeStandard(randBytes(), nil), 10000)
+	spendsStake := makeDesc(makeStandard(stakedNullifier, nil), 50000)
+	locked := makeDesc(makeStandard(randBytes(), &transactions.Locktime{
+		Timestamp: now.Add(time.Hour).Unix(),
+		Precision: 60,
+	}), 50000)
+
+	descs := []*mempool.TxDesc{low, locked, medium, spendsStake, stake, high, coinbase}
+
+	txids := func(txs []*transactions.Transaction) []types.ID {
+		ids := make([]types.ID, 0, len(txs))
+		for _, tx := range txs {
+			ids = append(ids, tx.ID())
+		}
+		return ids
+	}
+
+	// With no size limit everything but the spend of the staked
+	// nullifier and the locked transaction is selected.
+	txs := selectTransactions(header, descs, 1<<20, 10000)
+	assert.Equal(t, []types.ID{
+		coinbase.Tx.ID(),
+		stake.Tx.ID(),
+		high.Tx.ID(),
+		medium.Tx.ID(),
+		low.Tx.ID(),
+	}, txids(txs))
+
+	// With room for only two more transactions after the coinbase and
+	// stake, the lowest fee rate transaction is left out.
+	sized := &blocks.Block{
+		Header: &blocks.BlockHeader{
+			Height:    header.Height,
+			Parent:    header.Parent,
+			Timestamp: header.Timestamp,
+			TxRoot:    make([]byte, 32),
+			Signature: make([]byte, blockSignatureReserve),
+		},
+		Transactions: txs[:4],
+	}
+	sizedLen, err := sized.SerializedSize()
+	assert.NoError(t, err)
+	limit := uint32(sizedLen + low.Size/2)
+
+	txs = selectTransactions(header, descs, limit, 10000)
+	assert.Equal(t, []types.ID{
+		coinbase.Tx.ID(),
+		stake.Tx.ID(),
+		high.Tx.ID(),
+		medium.Tx.ID(),
+	}, txids(txs))
+
+	// The block with the selected transactions is under the limit.
+	blk := &blocks.Block{Header: header, Transactions: txs}
+	blk.Header.TxRoot = make([]byte, 32)
+	blk.Header.Signature = make([]byte, 64)
+	size, err := blk.SerializedSize()
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, uint32(size), limit)
+
+	// Transactions are ordered by their fee rate including any fee delta
+	// but those paying less than the minimum fee are left out regardless
+	// of the delta.
+	prioritised := makeDesc(makeStandard(randBytes(), nil), 15000)
+	prioritised.ModifiedFeePerKilobyte = 40000
+	belowMin := makeDesc(makeStandard(randBytes(), nil), 5000)
+	belowMin.ModifiedFeePerKilobyte = 100000
+
+	txs = selectTransactions(header, []*mempool.TxDesc{high, belowMin, prioritised}, 1<<20, 10000)
+	assert.Equal(t, []types.ID{
+		prioritised.Tx.ID(),
+		high.Tx.ID(),
+	}, txids(txs))
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"time"
+)
+
+// MaxConflictingSpends is the maximum number of transactions spending the
+// same nullifier that we will hold in the mempool at any one time.
+const MaxConflictingSpends = 4
+
+// ConflictFinalized should be called when the consensus engine finalizes a
+// conflicting transaction. The transaction becomes the only valid spend of
+// its nullifiers and is released into the pool if it is not already there.
+// All other transactions which spend the same nullifiers are removed.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) ConflictFinalized(txid types.ID) {
+	m.msgChan <- &resolveConflictReq{
+		txid:      txid,
+		finalized: true,
+	}
+}
+
+// ConflictRejected should be called when the consensus engine rejects a
+// conflicting transaction. The transaction is removed from the mempool.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) ConflictRejected(txid types.ID) {
+	m.msgChan <- &resolveConflictReq{
+		txid:      txid,
+		finalized: false,
+	}
+}
+
+// addConflict holds a transaction which spends a nullifier that is already
+// spent by another transaction in the pool. The spend in the pool was seen
+// first and is the initial preference of the consensus engine so it stays
+// in the pool while the engine votes. The new transaction is held out of
+// the pool until the engine finalizes it.
+//
+// If the new transaction qualifies as a replacement for the spends in the
+// pool it takes their place instead, see preferReplacement.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) addConflict(ttx *ttlTx) error {
+	if err := m.checkConflict(ttx); err != nil {
+		return err
+	}
+	if replaced, err := m.checkReplacement(ttx); err == nil {
+		m.preferReplacement(ttx, replaced)
+		return nil
+	}
+
+	tx := ttx.tx
+	nullifiers := tx.Nullifiers()
+
+	var (
+		contested = make([]*transactions.Transaction, 0, len(nullifiers)+1)
+		seen      = make(map[types.ID]bool)
+	)
+	for _, n := range nullifiers {
+		spendID, ok := m.nullifiers[n]
+		if !ok {
+			m.nullifiers[n] = tx.ID()
+			continue
+		}
+		if spend, ok := m.pool[spendID]; ok && !seen[spendID] {
+			seen[spendID] = true
+			contested = append(contested, spend.tx)
+		}
+	}
+	ttx.expiration = time.Now().Add(m.cfg.transactionTTL)
+	m.conflicts[tx.ID()] = ttx
+	m.poolBytes += ttx.size
+	contested = append(contested, tx)
+	m.sendNotification(NTTransactionAdded, m.newEntry(ttx, EntryContested))
+
+	log.Debug("New conflicting mempool transaction", log.ArgsFromMap(map[string]any{
+		"txid":      tx.ID().String(),
+		"contested": len(contested),
+	}))
+
+	go m.cfg.conflictHandler(contested)
+	return nil
+}
+
+// preferReplacement moves a transaction which qualifies as a replacement
+// into the pool and holds the spends it replaces out of the pool as
+// conflicts. As the spend in the pool it is passed to the conflict handler
+// first and becomes the initial preference of the consensus engine. Every
+// node applies the same replacement rules so nodes which saw the spends in
+// either order prefer the replacement and it wins the vote.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) preferReplacement(ttx *ttlTx, replaced []*ttlTx) {
+	tx := ttx.tx
+	contested := make([]*transactions.Transaction, 0, len(replaced)+1)
+	contested = append(contested, tx)
+	for _, r := range replaced {
+		txid := r.tx.ID()
+		delete(m.pool, txid)
+		m.conflicts[txid] = r
+		contested = append(contested, r.tx)
+		m.sendNotification(NTTransactionStatusChanged, m.newEntry(r, EntryContested))
+
+		log.Debug("Mempool transaction contested by replacement", log.ArgsFromMap(map[string]any{
+			"txid":        txid.String(),
+			"replacement": tx.ID().String(),
+		}))
+	}
+	for _, n := range tx.Nullifiers() {
+		m.nullifiers[n] = tx.ID()
+	}
+
+	ttx.expiration = time.Now().Add(m.cfg.transactionTTL)
+	m.pool[tx.ID()] = ttx
+	m.poolBytes += ttx.size
+	if m.cfg.feeEstimator != nil && ttx.isFeePayer {
+		m.cfg.feeEstimator.ObserveTransaction(tx.ID(), ttx.fpkb)
+	}
+	m.sendNotification(NTTransactionAdded, m.newEntry(ttx, EntryPending))
+
+	go m.cfg.conflictHandler(contested)
+}
+
+// checkConflict returns an error if adding the transaction as a conflict
+// would exceed the maximum number of conflicting spends of any of its
+// nullifiers.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) checkConflict(ttx *ttlTx) error {
+	for _, n := range ttx.tx.Nullifiers() {
+		if len(m.spendsOf(n)) >= MaxConflictingSpends {
+			return ruleError(blockchain.ErrDoubleSpend, "too many conflicting spends of nullifier in mempool")
+		}
+	}
+	return nil
+}
+
+// resolveConflict is the implementation of ConflictFinalized and ConflictRejected.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) resolveConflict(txid types.ID, finalized bool) {
+	m.mempoolLock.Lock()
+	defer m.mempoolLock.Unlock()
+
+	// The transaction is either held as a conflict or is the
+	// spend which was kept in the pool during the vote.
+	ttx, contested := m.conflicts[txid]
+	if !contested {
+		var ok bool
+		if ttx, ok = m.pool[txid]; !ok {
+			return
+		}
+	}
+	if !finalized {
+		if contested {
+			m.removeConflict(txid, NTTransactionEvicted)
+		} else {
+			m.evict(ttx, NTTransactionEvicted)
+		}
+		return
+	}
+
+	delete(m.conflicts, txid)
+	for _, n := range ttx.tx.Nullifiers() {
+		for _, spendID := range m.spendsOf(n) {
+			if spendID == txid {
+				continue
+			}
+			if spend, ok := m.pool[spendID]; ok {
+				m.evict(spend, NTTransactionEvicted)
+			}
+			m.removeConflict(spendID, NTTransactionEvicted)
+		}
+		m.nullifiers[n] = txid
+	}
+	if contested {
+		m.pool[txid] = ttx
+		m.sendNotification(NTTransactionStatusChanged, m.newEntry(ttx, EntryPending))
+	}
+
+	log.Debug("Conflicting mempool transaction finalized", log.Args("txid", txid.String()))
+}
+
+// expireConflicts removes any conflicting transactions which have
+// been in the mempool longer than the transaction TTL.
+func (m *Mempool) expireConflicts() {
+	m.mempoolLock.Lock()
+	defer m.mempoolLock.Unlock()
+
+	for txid, ttx := range m.conflicts {
+		if time.Now().After(ttx.expiration) {
+			m.removeConflict(txid, NTTransactionExpired)
+		}
+	}
+}
+
+// removeConflictingSpends removes all conflicting transactions which
+// spend the nullifier. Subscribers are notified with the reason. The
+// nullifier has been spent so the fee estimator stops tracking them.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) removeConflictingSpends(n types.Nullifier, reason NotificationType) {
+	for txid, ttx := range m.conflicts {
+		for _, n2 := range ttx.tx.Nullifiers() {
+			if n2 == n {
+				m.removeConflict(txid, reason)
+				m.untrackFee(txid)
+				break
+			}
+		}
+	}
+}
+
+// removeConflict removes a conflicting transaction along with
+// any of its nullifiers that are still mapped to it. Subscribers
+// are notified with the reason. Unless it was removed by a block,
+// the fee estimator stops tracking it.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) removeConflict(txid types.ID, reason NotificationType) {
+	ttx, ok := m.conflicts[txid]
+	if !ok {
+		return
+	}
+	if reason != NTTransactionRemovedByBlock {
+		m.untrackFee(txid)
+	}
+	delete(m.conflicts, txid)
+	m.poolBytes -= ttx.size
+	for _, n := range ttx.tx.Nullifiers() {
+		if spendID, ok := m.nullifiers[n]; ok && spendID == txid {
+			delete(m.nullifiers, n)
+		}
+	}
+	m.sendNotification(reason, m.newEntry(ttx, EntryContested))
+}
+
+// spendsOf returns the IDs of all transactions in the pool or held
+// as conflicts which spend the nullifier.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) spendsOf(n types.Nullifier) []types.ID {
+	var spends []types.ID
+	if spendID, ok := m.nullifiers[n]; ok {
+		if _, ok := m.pool[spendID]; ok {
+			spends = append(spends, spendID)
+		}
+	}
+	for txid, ttx := range m.conflicts {
+		for _, n2 := range ttx.tx.Nullifiers() {
+			if n2 == n {
+				spends = append(spends, txid)
+				break
+			}
+		}
+	}
+	return spends
+}
@@ -32,6 +32,8 @@ const (
 	ErrMinStake
 	ErrDuplicateCoinbase
 	ErrTreasuryWhitelist
+	ErrMempoolFull
+	ErrLocktimeTooFar
 )
 
 var (
@@ -45,6 +47,8 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrMinStake:          "ErrMinStake",
 	ErrDuplicateCoinbase: "ErrDuplicateCoinbase",
 	ErrTreasuryWhitelist: "ErrTreasuryWhitelist",
+	ErrMempoolFull:       "ErrMempoolFull",
+	ErrLocktimeTooFar:    "ErrLocktimeTooFar",
 }
 
 // String returns the ErrorCode as a human-readable name.
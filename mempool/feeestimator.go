@@ -0,0 +1,324 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"context"
+	"errors"
+	"github.com/ipfs/go-datastore"
+	"github.com/project-illium/ilxd/mempool/pb"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"google.golang.org/protobuf/proto"
+	"math"
+	"sync"
+)
+
+const (
+	// MaxConfirmTarget is the largest number of blocks for which
+	// the fee estimator will estimate a fee.
+	MaxConfirmTarget = 25
+
+	// feeBucketMin is the lower bound of the lowest fee per
+	// kilobyte bucket.
+	feeBucketMin = 1000
+
+	// feeBucketMax is the lower bound of the highest fee per
+	// kilobyte bucket. Transactions paying more than this are
+	// all tracked in the highest bucket.
+	feeBucketMax = 1e9
+
+	// feeBucketSpacing is the ratio between the lower bounds
+	// of adjacent buckets.
+	feeBucketSpacing = 1.2
+
+	// feeEstimatorDecay is applied to the counts in every bucket
+	// each time a block is connected so that recent blocks carry
+	// more weight than old ones. The half life is about 350 blocks.
+	feeEstimatorDecay = 0.998
+
+	// minEstimateSamples is the (decayed) number of transactions a
+	// range of buckets must contain before we use it for an estimate.
+	minEstimateSamples = 5
+
+	// estimateSuccessThreshold is the fraction of transactions in a
+	// range of buckets that must have been confirmed within the target
+	// number of blocks for the range to pass.
+	estimateSuccessThreshold = 0.85
+
+	// feeEstimatorSaveInterval is how often, in blocks, the fee
+	// estimator persists its state to the datastore.
+	feeEstimatorSaveInterval = 100
+)
+
+// ErrInvalidTarget is returned when fee estimate is requested for a
+// target outside the range tracked by the fee estimator.
+var ErrInvalidTarget = errors.New("fee estimate target out of range")
+
+type trackedTx struct {
+	bucket int
+	height uint32
+}
+
+// FeeEstimator estimates the fee per kilobyte needed for a transaction
+// to be confirmed within a target number of blocks.
+//
+// Transactions are placed into buckets by their fee per kilobyte when
+// they enter the mempool. When a block is connected the estimator records,
+// for each bucket, how many blocks its transactions waited to be confirmed.
+// Transactions which are not confirmed within MaxConfirmTarget blocks are
+// recorded as failures.
+//
+// The estimate for a target is the lowest fee rate at which a large enough
+// share of transactions were confirmed within the target.
+type FeeEstimator struct {
+	bucketFees []types.Amount
+	confirmed  [][]float64
+	total      []float64
+	tracked    map[types.ID]*trackedTx
+	height     uint32
+	minFeeFunc func() types.Amount
+	ds         repo.Datastore
+	mtx        sync.RWMutex
+}
+
+// NewFeeEstimator returns a new FeeEstimator. If the datastore contains a
+// saved estimator state it will be loaded. The height is the height of the
+// current best block.
+//
+// The minFeeFunc returns the minimum fee per kilobyte that the node will
+// accept. Estimates are never lower than this.
+func NewFeeEstimator(ds repo.Datastore, height uint32, minFeeFunc func() types.Amount) (*FeeEstimator, error) {
+	var bucketFees []types.Amount
+	for fee := float64(feeBucketMin); fee <= feeBucketMax; fee *= feeBucketSpacing {
+		bucketFees = append(bucketFees, types.Amount(fee))
+	}
+	fe := &FeeEstimator{
+		bucketFees: bucketFees,
+		confirmed:  make([][]float64, len(bucketFees)),
+		total:      make([]float64, len(bucketFees)),
+		tracked:    make(map[types.ID]*trackedTx),
+		minFeeFunc: minFeeFunc,
+		ds:         ds,
+		mtx:        sync.RWMutex{},
+	}
+	for i := range fe.confirmed {
+		fe.confirmed[i] = make([]float64, MaxConfirmTarget)
+	}
+	if err := fe.load(); err != nil {
+		return nil, err
+	}
+	fe.height = height
+	return fe, nil
+}
+
+// ObserveTransaction records that a transaction paying the given fee per
+// kilobyte entered the mempool. It is called by the mempool when a fee
+// paying transaction is accepted.
+//
+// This method is safe for concurrent access.
+func (fe *FeeEstimator) ObserveTransaction(txid types.ID, fpkb types.Amount) {
+	fe.mtx.Lock()
+	defer fe.mtx.Unlock()
+
+	if _, ok := fe.tracked[txid]; ok {
+		return
+	}
+	fe.tracked[txid] = &trackedTx{
+		bucket: fe.bucketIndex(fpkb),
+		height: fe.height,
+	}
+}
+
+// RemoveTransaction stops tracking a transaction which left the mempool
+// without being confirmed, for example because it was replaced, evicted,
+// expired or lost a conflict vote. It is not counted as a failure to
+// confirm at its fee rate. It is called by the mempool.
+//
+// This method is safe for concurrent access.
+func (fe *FeeEstimator) RemoveTransaction(txid types.ID) {
+	fe.mtx.Lock()
+	defer fe.mtx.Unlock()
+
+	delete(fe.tracked, txid)
+}
+
+// ProcessBlock updates the estimator with the transactions confirmed in
+// a newly connected block.
+//
+// This method is safe for concurrent access.
+func (fe *FeeEstimator) ProcessBlock(height uint32, txs []*transactions.Transaction) {
+	fe.mtx.Lock()
+	defer fe.mtx.Unlock()
+
+	if height <= fe.height {
+		return
+	}
+	fe.height = height
+
+	for i := range fe.total {
+		fe.total[i] *= feeEstimatorDecay
+		for j := range fe.confirmed[i] {
+			fe.confirmed[i][j] *= feeEstimatorDecay
+		}
+	}
+
+	for _, tx := range txs {
+		txid := tx.ID()
+		t, ok := fe.tracked[txid]
+		if !ok {
+			continue
+		}
+		delete(fe.tracked, txid)
+
+		blocks := int(height - t.height)
+		if blocks < 1 {
+			blocks = 1
+		}
+		if blocks <= MaxConfirmTarget {
+			fe.confirmed[t.bucket][blocks-1]++
+		}
+		fe.total[t.bucket]++
+	}
+
+	for txid, t := range fe.tracked {
+		if height-t.height > MaxConfirmTarget {
+			fe.total[t.bucket]++
+			delete(fe.tracked, txid)
+		}
+	}
+
+	if height%feeEstimatorSaveInterval == 0 {
+		if err := fe.save(); err != nil {
+			log.WithCaller(true).Error("Error saving fee estimator", log.Args("error", err))
+		}
+	}
+}
+
+// EstimateFeePerKilobyte returns the fee per kilobyte needed for a
+// transaction to be confirmed within the target number of blocks. If
+// the estimator does not have enough data, or the estimate is below the
+// node's minimum fee per kilobyte, the minimum is returned.
+//
+// This method is safe for concurrent access.
+func (fe *FeeEstimator) EstimateFeePerKilobyte(targetBlocks uint32) (types.Amount, error) {
+	if targetBlocks < 1 || targetBlocks > MaxConfirmTarget {
+		return 0, ErrInvalidTarget
+	}
+
+	fe.mtx.RLock()
+	estimate := fe.estimate(int(targetBlocks))
+	fe.mtx.RUnlock()
+
+	if minFee := fe.minFeeFunc(); estimate < minFee {
+		return minFee, nil
+	}
+	return estimate, nil
+}
+
+// estimate works down from the highest bucket, grouping buckets until
+// they have enough samples, and returns the lowest fee of the last group
+// in which enough transactions were confirmed within the target. It
+// returns zero if no group passes.
+//
+// This method is NOT safe for concurrent access.
+func (fe *FeeEstimator) estimate(target int) types.Amount {
+	var (
+		estimate  types.Amount
+		confirmed float64
+		total     float64
+	)
+	for i := len(fe.bucketFees) - 1; i >= 0; i-- {
+		for j := 0; j < target; j++ {
+			confirmed += fe.confirmed[i][j]
+		}
+		total += fe.total[i]
+		if total < minEstimateSamples {
+			continue
+		}
+		if confirmed/total < estimateSuccessThreshold {
+			break
+		}
+		estimate = fe.bucketFees[i]
+		confirmed, total = 0, 0
+	}
+	return estimate
+}
+
+// bucketIndex returns the index of the bucket for the fee per kilobyte.
+func (fe *FeeEstimator) bucketIndex(fpkb types.Amount) int {
+	if fpkb < fe.bucketFees[0] {
+		return 0
+	}
+	i := int(math.Log(float64(fpkb)/feeBucketMin) / math.Log(feeBucketSpacing))
+	if i >= len(fe.bucketFees) {
+		return len(fe.bucketFees) - 1
+	}
+	// Correct for floating point rounding at the bucket boundaries.
+	if fpkb < fe.bucketFees[i] {
+		return i - 1
+	}
+	if i+1 < len(fe.bucketFees) && fpkb >= fe.bucketFees[i+1] {
+		return i + 1
+	}
+	return i
+}
+
+// Save persists the state of the fee estimator to the datastore.
+//
+// This method is safe for concurrent access.
+func (fe *FeeEstimator) Save() error {
+	fe.mtx.RLock()
+	defer fe.mtx.RUnlock()
+
+	return fe.save()
+}
+
+func (fe *FeeEstimator) save() error {
+	dbEstimator := &pb.DBFeeEstimator{
+		Height:  fe.height,
+		Buckets: make([]*pb.DBFeeBucket, 0, len(fe.total)),
+	}
+	for i := range fe.total {
+		dbEstimator.Buckets = append(dbEstimator.Buckets, &pb.DBFeeBucket{
+			Total:     fe.total[i],
+			Confirmed: fe.confirmed[i],
+		})
+	}
+	ser, err := proto.Marshal(dbEstimator)
+	if err != nil {
+		return err
+	}
+	return fe.ds.Put(context.Background(), datastore.NewKey(repo.FeeEstimatorDatastoreKey), ser)
+}
+
+// load restores the estimator's state from the datastore. Saved state
+// with a different number of buckets is discarded.
+func (fe *FeeEstimator) load() error {
+	ser, err := fe.ds.Get(context.Background(), datastore.NewKey(repo.FeeEstimatorDatastoreKey))
+	if errors.Is(err, datastore.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var dbEstimator pb.DBFeeEstimator
+	if err := proto.Unmarshal(ser, &dbEstimator); err != nil {
+		return err
+	}
+	if len(dbEstimator.Buckets) != len(fe.total) {
+		return nil
+	}
+	for _, b := range dbEstimator.Buckets {
+		if len(b.Confirmed) != MaxConfirmTarget {
+			return nil
+		}
+	}
+	for i, b := range dbEstimator.Buckets {
+		fe.total[i] = b.Total
+		copy(fe.confirmed[i], b.Confirmed)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFeeEstimator(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	minFee := types.Amount(10000)
+	fe, err := NewFeeEstimator(ds, 0, func() types.Amount { return minFee })
+	assert.NoError(t, err)
+
+	// With no data the estimate is the minimum fee.
+	fpkb, err := fe.EstimateFeePerKilobyte(1)
+	assert.NoError(t, err)
+	assert.Equal(t, minFee, fpkb)
+
+	_, err = fe.EstimateFeePerKilobyte(0)
+	assert.ErrorIs(t, err, ErrInvalidTarget)
+	_, err = fe.EstimateFeePerKilobyte(MaxConfirmTarget + 1)
+	assert.ErrorIs(t, err, ErrInvalidTarget)
+
+	txoRoot := randomID()
+	// High fee transactions are confirmed in the next block and low
+	// fee transactions after five blocks.
+	height := uint32(0)
+	var pending [][]*transactions.Transaction
+	for i := 0; i < 50; i++ {
+		high := newTestTx(txoRoot, 100000, randomID())
+		low := newTestTx(txoRoot, 20000, randomID())
+		fe.ObserveTransaction(high.ID(), 100000)
+		fe.ObserveTransaction(low.ID(), 20000)
+		pending = append(pending, []*transactions.Transaction{low})
+
+		blockTxs := []*transactions.Transaction{high}
+		if len(pending) > 5 {
+			blockTxs = append(blockTxs, pending[0]...)
+			pending = pending[1:]
+		}
+		height++
+		fe.ProcessBlock(height, blockTxs)
+	}
+
+	fast, err := fe.EstimateFeePerKilobyte(1)
+	assert.NoError(t, err)
+	assert.Equal(t, fe.bucketFees[fe.bucketIndex(100000)], fast)
+
+	slow, err := fe.EstimateFeePerKilobyte(10)
+	assert.NoError(t, err)
+	assert.Equal(t, fe.bucketFees[fe.bucketIndex(20000)], slow)
+
+	// Estimates are never below the minimum fee.
+	minFee = 1000000
+	fpkb, err = fe.EstimateFeePerKilobyte(10)
+	assert.NoError(t, err)
+	assert.Equal(t, minFee, fpkb)
+	minFee = 10000
+
+	// The state is restored from the datastore.
+	assert.NoError(t, fe.Save())
+	fe2, err := NewFeeEstimator(ds, height, func() types.Amount { return minFee })
+	assert.NoError(t, err)
+	fpkb, err = fe2.EstimateFeePerKilobyte(1)
+	assert.NoError(t, err)
+	assert.Equal(t, fast, fpkb)
+	fpkb, err = fe2.EstimateFeePerKilobyte(10)
+	assert.NoError(t, err)
+	assert.Equal(t, slow, fpkb)
+}
+
+func TestFeeEstimatorBucketIndex(t *testing.T) {
+	fe, err := NewFeeEstimator(mock.NewMapDatastore(), 0, func() types.Amount { return 0 })
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, fe.bucketIndex(0))
+	assert.Equal(t, len(fe.bucketFees)-1, fe.bucketIndex(types.Amount(1e15)))
+	for i, fee := range fe.bucketFees {
+		assert.Equal(t, i, fe.bucketIndex(fee))
+		if i > 0 {
+			assert.Equal(t, i-1, fe.bucketIndex(fee-1))
+		}
+	}
+}
+
+func TestFeeEstimatorRemoveTransaction(t *testing.T) {
+	fe, err := NewFeeEstimator(mock.NewMapDatastore(), 0, func() types.Amount { return 0 })
+	assert.NoError(t, err)
+
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view, Estimator(fe))
+
+	// A transaction replaced in the mempool is no longer tracked.
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 20000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	tx2 := newTestTx(txoRoot, 40000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx2))
+
+	fe.mtx.RLock()
+	assert.NotContains(t, fe.tracked, tx1.ID())
+	assert.Contains(t, fe.tracked, tx2.ID())
+	fe.mtx.RUnlock()
+
+	// An expired transaction is no longer tracked.
+	m.removeBlockTransactions([]*transactions.Transaction{tx2}, NTTransactionExpired)
+	fe.mtx.RLock()
+	assert.NotContains(t, fe.tracked, tx2.ID())
+	fe.mtx.RUnlock()
+
+	// Neither is counted as a failure once the confirm target passes.
+	for height := uint32(1); height <= MaxConfirmTarget+1; height++ {
+		fe.ProcessBlock(height, nil)
+	}
+	for _, total := range fe.total {
+		assert.Zero(t, total)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/types"
+)
+
+const (
+	// ibltHashCount is the number of cells each txid is inserted into.
+	ibltHashCount = 3
+
+	// ibltCellSize is the serialized size of a single cell.
+	ibltCellSize = 4 + hash.HashSize + 8
+)
+
+var (
+	// ErrIBLTDecode is returned if the IBLT holds more entries than
+	// can be recovered. The caller should retry with a larger table.
+	ErrIBLTDecode = errors.New("iblt decode failed")
+
+	// ErrIBLTMismatch is returned when subtracting two IBLTs with
+	// different sizes or salts.
+	ErrIBLTMismatch = errors.New("iblt size or salt mismatch")
+)
+
+type ibltCell struct {
+	count   int32
+	keySum  types.ID
+	hashSum uint64
+}
+
+func (c *ibltCell) isEmpty() bool {
+	return c.count == 0 && c.hashSum == 0 && c.keySum == types.ID{}
+}
+
+// IBLT is an invertible bloom lookup table over txids. Two peers can
+// each build an IBLT from their mempool and one can subtract the other's
+// table from its own. The result can be decoded to recover the txids
+// that are in one mempool but not the other, as long as the number of
+// differences is small relative to the size of the table. The amount
+// of data exchanged is proportional to the size of the difference
+// rather than the size of the mempools.
+//
+// The table is split into ibltHashCount sub-tables with each txid
+// inserted into one cell in each. Cell positions are derived from a
+// keyed hash of the txid using a per-session salt so that a peer
+// cannot craft transactions which collide in our tables.
+type IBLT struct {
+	cells []ibltCell
+	salt  []byte
+}
+
+// NewIBLT returns a new, empty IBLT. The number of cells is rounded up
+// to a multiple of the hash count. Roughly 1.5 cells are needed for
+// every difference that is to be decoded.
+func NewIBLT(numCells int, salt []byte) *IBLT {
+	if numCells < ibltHashCount {
+		numCells = ibltHashCount
+	}
+	if r := numCells % ibltHashCount; r != 0 {
+		numCells += ibltHashCount - r
+	}
+	return &IBLT{
+		cells: make([]ibltCell, numCells),
+		salt:  salt,
+	}
+}
+
+// NumCells returns the number of cells in the table.
+func (t *IBLT) NumCells() int {
+	return len(t.cells)
+}
+
+// Insert adds the txid to the table.
+func (t *IBLT) Insert(txid types.ID) {
+	t.update(txid, 1)
+}
+
+func (t *IBLT) update(txid types.ID, delta int32) {
+	indexes, checksum := t.positions(txid)
+	for _, i := range indexes {
+		c := &t.cells[i]
+		c.count += delta
+		for j := range c.keySum {
+			c.keySum[j] ^= txid[j]
+		}
+		c.hashSum ^= checksum
+	}
+}
+
+func (t *IBLT) positions(txid types.ID) ([ibltHashCount]int, uint64) {
+	h := hash.HashFunc(append(append(make([]byte, 0, len(t.salt)+hash.HashSize), t.salt...), txid[:]...))
+	sub := uint64(len(t.cells) / ibltHashCount)
+
+	var indexes [ibltHashCount]int
+	for i := 0; i < ibltHashCount; i++ {
+		indexes[i] = int(binary.BigEndian.Uint64(h[i*8:])%sub) + i*int(sub)
+	}
+	return indexes, binary.BigEndian.Uint64(h[24:])
+}
+
+// Subtract returns a new table holding the difference between this
+// table and other. Txids only in this table end up with positive
+// counts and txids only in other end up with negative counts.
+func (t *IBLT) Subtract(other *IBLT) (*IBLT, error) {
+	if len(t.cells) != len(other.cells) || string(t.salt) != string(other.salt) {
+		return nil, ErrIBLTMismatch
+	}
+	diff := NewIBLT(len(t.cells), t.salt)
+	for i := range t.cells {
+		c := &diff.cells[i]
+		c.count = t.cells[i].count - other.cells[i].count
+		for j := range c.keySum {
+			c.keySum[j] = t.cells[i].keySum[j] ^ other.cells[i].keySum[j]
+		}
+		c.hashSum = t.cells[i].hashSum ^ other.cells[i].hashSum
+	}
+	return diff, nil
+}
+
+// Decode peels the entries out of a table returned by Subtract. It
+// returns the txids which were only in the first table (ours) and
+// those which were only in the second table (theirs). The table is
+// emptied in the process.
+//
+// If not all entries can be recovered ErrIBLTDecode is returned.
+func (t *IBLT) Decode() (ours []types.ID, theirs []types.ID, err error) {
+	pure := make([]int, 0, len(t.cells))
+	for i := range t.cells {
+		if t.isPure(i) {
+			pure = append(pure, i)
+		}
+	}
+	for len(pure) > 0 {
+		i := pure[len(pure)-1]
+		pure = pure[:len(pure)-1]
+		if !t.isPure(i) {
+			continue
+		}
+		// A table can't hold more entries than it has cells. If
+		// we've peeled more than that the table is malformed.
+		if len(ours)+len(theirs) >= len(t.cells) {
+			return ours, theirs, ErrIBLTDecode
+		}
+		c := t.cells[i]
+		txid := c.keySum
+		if c.count == 1 {
+			ours = append(ours, txid)
+		} else {
+			theirs = append(theirs, txid)
+		}
+		t.update(txid, -c.count)
+
+		indexes, _ := t.positions(txid)
+		for _, j := range indexes {
+			if t.isPure(j) {
+				pure = append(pure, j)
+			}
+		}
+	}
+	for i := range t.cells {
+		if !t.cells[i].isEmpty() {
+			return ours, theirs, ErrIBLTDecode
+		}
+	}
+	return ours, theirs, nil
+}
+
+func (t *IBLT) isPure(i int) bool {
+	c := &t.cells[i]
+	if c.count != 1 && c.count != -1 {
+		return false
+	}
+	_, checksum := t.positions(c.keySum)
+	return checksum == c.hashSum
+}
+
+// Serialize returns the cells of the table in a compact binary form.
+// The salt is not included.
+func (t *IBLT) Serialize() []byte {
+	ser := make([]byte, len(t.cells)*ibltCellSize)
+	for i, c := range t.cells {
+		b := ser[i*ibltCellSize:]
+		binary.BigEndian.PutUint32(b, uint32(c.count))
+		copy(b[4:], c.keySum[:])
+		binary.BigEndian.PutUint64(b[4+hash.HashSize:], c.hashSum)
+	}
+	return ser
+}
+
+// DeserializeIBLT decodes a table from its serialized cells and the
+// salt it was built with.
+func DeserializeIBLT(ser []byte, salt []byte) (*IBLT, error) {
+	if len(ser) == 0 || len(ser)%(ibltCellSize*ibltHashCount) != 0 {
+		return nil, errors.New("invalid iblt length")
+	}
+	t := &IBLT{
+		cells: make([]ibltCell, len(ser)/ibltCellSize),
+		salt:  salt,
+	}
+	for i := range t.cells {
+		b := ser[i*ibltCellSize:]
+		t.cells[i].count = int32(binary.BigEndian.Uint32(b))
+		copy(t.cells[i].keySum[:], b[4:4+hash.HashSize])
+		t.cells[i].hashSum = binary.BigEndian.Uint64(b[4+hash.HashSize:])
+	}
+	return t, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+// testTxids returns n deterministic txids so that the test doesn't
+// flake on the small chance that a random set fails to decode.
+func testTxids(seed byte, n int) []types.ID {
+	txids := make([]types.ID, n)
+	for i := range txids {
+		txids[i] = types.NewIDFromData([]byte{seed, byte(i >> 8), byte(i)})
+	}
+	return txids
+}
+
+func sortIDs(ids []types.ID) []types.ID {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) < 0
+	})
+	return ids
+}
+
+func TestIBLT(t *testing.T) {
+	salt := []byte{0x01, 0x02, 0x03, 0x04}
+	common := testTxids(0, 1000)
+	onlyA := testTxids(1, 20)
+	onlyB := testTxids(2, 15)
+
+	a := NewIBLT(128, salt)
+	b := NewIBLT(128, salt)
+	for _, txid := range common {
+		a.Insert(txid)
+		b.Insert(txid)
+	}
+	for _, txid := range onlyA {
+		a.Insert(txid)
+	}
+	for _, txid := range onlyB {
+		b.Insert(txid)
+	}
+
+	// Round trip through serialization as would happen on the wire.
+	b2, err := DeserializeIBLT(b.Serialize(), salt)
+	assert.NoError(t, err)
+	assert.Equal(t, b.NumCells(), b2.NumCells())
+
+	diff, err := a.Subtract(b2)
+	assert.NoError(t, err)
+	ours, theirs, err := diff.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, sortIDs(onlyA), sortIDs(ours))
+	assert.Equal(t, sortIDs(onlyB), sortIDs(theirs))
+
+	// Too many differences for the table size.
+	c := NewIBLT(128, salt)
+	for _, txid := range testTxids(3, 200) {
+		c.Insert(txid)
+	}
+	diff, err = a.Subtract(c)
+	assert.NoError(t, err)
+	_, _, err = diff.Decode()
+	assert.ErrorIs(t, err, ErrIBLTDecode)
+
+	// Mismatched tables.
+	_, err = a.Subtract(NewIBLT(256, salt))
+	assert.ErrorIs(t, err, ErrIBLTMismatch)
+	_, err = a.Subtract(NewIBLT(128, []byte{0x05}))
+	assert.ErrorIs(t, err, ErrIBLTMismatch)
+
+	_, err = DeserializeIBLT([]byte{0x00}, salt)
+	assert.Error(t, err)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/types"
+	"math"
+	"sort"
+	"time"
+)
+
+// rollingFeeHalfLife is the half life of the rolling minimum fee. After
+// transactions are evicted to keep the mempool under its maximum size
+// the minimum fee is raised and then decays back toward the policy
+// minimum at this rate. It decays faster if the mempool is mostly empty.
+const rollingFeeHalfLife = time.Hour * 12
+
+// Size returns the total serialized size, in bytes, of the transactions
+// in the mempool, including conflicting transactions which are still being
+// voted on by the consensus engine.
+func (m *Mempool) Size() int {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	return m.poolBytes
+}
+
+// MaxSize returns the maximum size, in bytes, of the mempool. Zero
+// means the mempool is unbounded.
+func (m *Mempool) MaxSize() int {
+	return m.cfg.maxSize
+}
+
+// MinFeePerKilobyte returns the fee per kilobyte a transaction must
+// currently pay to be admitted into the mempool. This is the greater of
+// the policy minimum and the rolling minimum fee, which is raised when
+// the mempool is full and transactions are evicted.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) MinFeePerKilobyte() types.Amount {
+	m.mempoolLock.Lock()
+	defer m.mempoolLock.Unlock()
+
+	return m.minFeePerKilobyte()
+}
+
+// minFeePerKilobyte decays the rolling minimum fee and returns the
+// current minimum fee per kilobyte.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) minFeePerKilobyte() types.Amount {
+	if m.rollingMinFee > 0 {
+		halfLife := rollingFeeHalfLife
+		if m.poolBytes < m.cfg.maxSize/4 {
+			halfLife /= 4
+		} else if m.poolBytes < m.cfg.maxSize/2 {
+			halfLife /= 2
+		}
+		now := time.Now()
+		m.rollingMinFee /= math.Pow(2, now.Sub(m.lastFeeUpdate).Seconds()/halfLife.Seconds())
+		m.lastFeeUpdate = now
+		if m.rollingMinFee < float64(m.cfg.fpkb)/2 {
+			m.rollingMinFee = 0
+		}
+	}
+	if rolling := types.Amount(m.rollingMinFee); rolling > m.cfg.fpkb {
+		return rolling
+	}
+	return m.cfg.fpkb
+}
+
+// trimToSize evicts the transactions with the lowest fee per kilobyte
+// until the mempool is under its maximum size. The rolling minimum fee
+// is raised above the fee rate of the evicted transactions so that they
+// cannot immediately be relayed back into the pool.
+//
+// Only fee paying transactions in the pool or the holding area are
+// evicted. Coinbase, stake and treasury transactions do not pay a fee
+// and would always be evicted first, so they are kept. Conflicting
+// transactions are kept until the consensus engine finishes voting on them.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) trimToSize() {
+	if m.cfg.maxSize <= 0 || m.poolBytes <= m.cfg.maxSize {
+		return
+	}
+
+	evictable := m.evictionCandidates(nil)
+
+	var (
+		evicted int
+		maxFee  types.Amount
+	)
+	for _, ttx := range evictable {
+		if m.poolBytes <= m.cfg.maxSize {
+			break
+		}
+		m.evict(ttx, NTTransactionEvicted)
+		if fpkb := ttx.modifiedFpkb(); fpkb > maxFee {
+			maxFee = fpkb
+		}
+		evicted++
+	}
+	if evicted == 0 {
+		return
+	}
+
+	newMinFee := float64(maxFee + m.cfg.fpkb)
+	if newMinFee > float64(m.minFeePerKilobyte()) {
+		m.rollingMinFee = newMinFee
+		m.lastFeeUpdate = time.Now()
+	}
+	log.Debug("Evicted transactions from full mempool", log.ArgsFromMap(map[string]any{
+		"evicted":      evicted,
+		"min fee":      types.Amount(m.rollingMinFee),
+		"mempool size": m.poolBytes,
+	}))
+}
+
+// checkSize returns an error if adding the transaction would push the
+// mempool over its maximum size and the transaction would be evicted
+// itself. The replaced transactions are those the new transaction
+// would replace, and their size is not counted.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) checkSize(ttx *ttlTx, replaced []*ttlTx) error {
+	poolBytes := m.poolBytes + ttx.size
+	for _, r := range replaced {
+		poolBytes -= r.size
+	}
+	if m.cfg.maxSize <= 0 || poolBytes <= m.cfg.maxSize {
+		return nil
+	}
+	skip := make(map[types.ID]bool, len(replaced))
+	for _, r := range replaced {
+		skip[r.tx.ID()] = true
+	}
+	for _, e := range m.evictionCandidates(ttx) {
+		if e == ttx {
+			return policyError(ErrMempoolFull, "mempool full")
+		}
+		if skip[e.tx.ID()] {
+			continue
+		}
+		poolBytes -= e.size
+		if poolBytes <= m.cfg.maxSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// evictionCandidates returns the fee paying transactions in the pool and
+// the holding area in the order in which they would be evicted. If extra
+// is not nil it is included as if it were the most recently added
+// transaction. Fee rates are compared after applying any deltas set
+// with PrioritiseTransaction.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) evictionCandidates(extra *ttlTx) []*ttlTx {
+	evictable := make([]*ttlTx, 0, len(m.pool)+len(m.held)+1)
+	for _, pool := range []map[types.ID]*ttlTx{m.pool, m.held} {
+		for _, ttx := range pool {
+			if ttx.isFeePayer {
+				evictable = append(evictable, ttx)
+			}
+		}
+	}
+	// Sort by fee rate and, for equal fee rates, evict the
+	// most recently added transactions first.
+	sort.Slice(evictable, func(i, j int) bool {
+		fi, fj := evictable[i].modifiedFpkb(), evictable[j].modifiedFpkb()
+		if fi == fj {
+			return evictable[i].expiration.After(evictable[j].expiration)
+		}
+		return fi < fj
+	})
+	if extra == nil || !extra.isFeePayer {
+		return evictable
+	}
+	// The new transaction is evicted before any transaction with
+	// the same fee rate since it is the most recent.
+	i := sort.Search(len(evictable), func(i int) bool {
+		return evictable[i].modifiedFpkb() >= extra.modifiedFpkb()
+	})
+	evictable = append(evictable, nil)
+	copy(evictable[i+1:], evictable[i:])
+	evictable[i] = extra
+	return evictable
+}
+
+// removeFromPool deletes the transaction from the pool or the holding
+// area, updates the size of the mempool and notifies subscribers with
+// the reason. Unless it was removed by a block, the fee estimator stops
+// tracking it.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) removeFromPool(txid types.ID, reason NotificationType) {
+	if reason != NTTransactionRemovedByBlock {
+		m.untrackFee(txid)
+	}
+	if ttx, ok := m.pool[txid]; ok {
+		delete(m.pool, txid)
+		m.poolBytes -= ttx.size
+		m.sendNotification(reason, m.newEntry(ttx, EntryPending))
+	} else if ttx, ok := m.held[txid]; ok {
+		delete(m.held, txid)
+		m.poolBytes -= ttx.size
+		m.sendNotification(reason, m.newEntry(ttx, EntryHeld))
+	}
+}
+
+// evict removes a transaction from the pool along with any of its
+// nullifiers that are still mapped to it.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) evict(ttx *ttlTx, reason NotificationType) {
+	txid := ttx.tx.ID()
+	m.removeFromPool(txid, reason)
+	for _, n := range ttx.tx.Nullifiers() {
+		if spendID, ok := m.nullifiers[n]; ok && spendID == txid {
+			delete(m.nullifiers, n)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"crypto/rand"
+	"errors"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMempoolEviction(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+
+	tx1 := newTestTx(txoRoot, 20000, randomID())
+	tx2 := newTestTx(txoRoot, 30000, randomID())
+	tx3 := newTestTx(txoRoot, 40000, randomID())
+	tx4 := newTestTx(txoRoot, 50000, randomID())
+	size, err := tx1.SerializedSize()
+	assert.NoError(t, err)
+
+	m := newTestMempool(t, view, MaxSize(size*3))
+
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	assert.NoError(t, m.ProcessTransaction(tx3))
+	assert.Equal(t, size*3, m.Size())
+	assert.Equal(t, m.cfg.fpkb, m.MinFeePerKilobyte())
+
+	// Adding tx4 should evict tx1, the lowest fee rate
+	// transaction, and raise the minimum fee.
+	assert.NoError(t, m.ProcessTransaction(tx4))
+	assert.Equal(t, size*3, m.Size())
+	_, err = m.GetTransaction(tx1.ID())
+	assert.ErrorIs(t, err, ErrNotFound)
+	for _, n := range tx1.Nullifiers() {
+		assert.NotContains(t, m.nullifiers, n)
+	}
+
+	fpkb, _, err := CalcFeePerKilobyte(tx1)
+	assert.NoError(t, err)
+	assert.InDelta(t, float64(fpkb+m.cfg.fpkb), float64(m.MinFeePerKilobyte()), 1)
+
+	// A transaction paying the evicted fee rate is now rejected.
+	err = m.ProcessTransaction(newTestTx(txoRoot, 20000, randomID()))
+	var policyErr PolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrFeeTooLow), policyErr.ErrorCode)
+
+	// The rolling minimum fee decays back to the policy minimum.
+	m.mempoolLock.Lock()
+	m.lastFeeUpdate = m.lastFeeUpdate.Add(-rollingFeeHalfLife * 10)
+	m.mempoolLock.Unlock()
+	assert.Equal(t, m.cfg.fpkb, m.MinFeePerKilobyte())
+
+	// A fee paying transaction with a fee rate lower than everything
+	// in the pool is not admitted.
+	err = m.ProcessTransaction(newTestTx(txoRoot, 25000, randomID()))
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrMempoolFull), policyErr.ErrorCode)
+	assert.Equal(t, size*3, m.Size())
+
+	// Removing transactions updates the size.
+	m.RemoveBlockTransactions([]*transactions.Transaction{tx2})
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, size*2, m.Size())
+}
+
+func TestMempoolEvictionKeepsNonFeePayers(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	view.treasuryBalance = 10000
+
+	sk, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.NoError(t, err)
+	validatorID, err := peer.IDFromPublicKey(pk)
+	assert.NoError(t, err)
+	valBytes, err := validatorID.Marshal()
+	assert.NoError(t, err)
+	view.validators[validatorID] = &blockchain.Validator{
+		UnclaimedCoins: 10000,
+	}
+
+	coinbase := &transactions.CoinbaseTransaction{
+		Validator_ID: valBytes,
+		NewCoins:     10000,
+		Outputs: []*transactions.Output{
+			{
+				Commitment: make([]byte, types.CommitmentLen),
+				Ciphertext: make([]byte, blockchain.CiphertextLen),
+			},
+		},
+		Proof: make([]byte, 1000),
+	}
+	h, err := coinbase.SigHash()
+	assert.NoError(t, err)
+	coinbase.Signature, err = sk.Sign(h)
+	assert.NoError(t, err)
+
+	stake := &transactions.StakeTransaction{
+		Validator_ID: valBytes,
+		Amount:       uint64(repo.DefaultMinimumStake),
+		Nullifier:    randomID().Bytes(),
+		TxoRoot:      txoRoot.Bytes(),
+		Proof:        make([]byte, 1000),
+	}
+	h, err = stake.SigHash()
+	assert.NoError(t, err)
+	stake.Signature, err = sk.Sign(h)
+	assert.NoError(t, err)
+
+	treasury := transactions.WrapTransaction(&transactions.TreasuryTransaction{
+		Amount: 10000,
+		Outputs: []*transactions.Output{
+			{
+				Commitment: make([]byte, types.CommitmentLen),
+				Ciphertext: make([]byte, blockchain.CiphertextLen),
+			},
+		},
+		Proof: make([]byte, 1000),
+	})
+
+	nonFeePayers := []*transactions.Transaction{
+		transactions.WrapTransaction(coinbase),
+		transactions.WrapTransaction(stake),
+		treasury,
+	}
+	feePayers := []*transactions.Transaction{
+		newTestTx(txoRoot, 20000, randomID()),
+		newTestTx(txoRoot, 30000, randomID()),
+		newTestTx(txoRoot, 40000, randomID()),
+	}
+
+	// There is room for the transactions which do not pay a fee
+	// and two of the three fee paying transactions.
+	maxSize := 0
+	for _, tx := range append(nonFeePayers, feePayers[1:]...) {
+		size, err := tx.SerializedSize()
+		assert.NoError(t, err)
+		maxSize += size
+	}
+	m := newTestMempool(t, view, MaxSize(maxSize), TreasuryWhitelist([]types.ID{treasury.ID()}))
+
+	for _, tx := range nonFeePayers {
+		assert.NoError(t, m.ProcessTransaction(tx))
+	}
+	for _, tx := range feePayers {
+		assert.NoError(t, m.ProcessTransaction(tx))
+	}
+
+	txs := m.GetTransactions()
+	assert.Len(t, txs, 5)
+	for _, tx := range nonFeePayers {
+		assert.Contains(t, txs, tx.ID())
+	}
+	assert.NotContains(t, txs, feePayers[0].ID())
+	assert.Equal(t, maxSize, m.Size())
+
+	// Once the pool is full of transactions which do not pay a fee a
+	// fee paying transaction is not admitted and they are kept.
+	m.mempoolLock.Lock()
+	m.cfg.maxSize = maxSize / 2
+	m.mempoolLock.Unlock()
+	err = m.ProcessTransaction(newTestTx(txoRoot, 100000, randomID()))
+	var policyErr PolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrMempoolFull), policyErr.ErrorCode)
+
+	txs = m.GetTransactions()
+	for _, tx := range nonFeePayers {
+		assert.Contains(t, txs, tx.ID())
+	}
+}
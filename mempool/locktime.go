@@ -0,0 +1,181 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"time"
+)
+
+// locktimeCheckInterval is how often the mempool releases held
+// transactions whose locktime window has opened and removes
+// transactions whose window has passed.
+const locktimeCheckInterval = time.Second * 5
+
+// locktimeWindow returns the range of block times in which the
+// transaction's locktime is valid. The times are zero if the
+// transaction does not have a locktime.
+func locktimeWindow(tx *transactions.Transaction) (start, end time.Time) {
+	var locktime *transactions.Locktime
+	switch t := tx.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		locktime = t.StandardTransaction.Locktime
+	case *transactions.Transaction_MintTransaction:
+		locktime = t.MintTransaction.Locktime
+	}
+	if locktime == nil || locktime.Timestamp <= 0 {
+		return time.Time{}, time.Time{}
+	}
+	timestamp := time.Unix(locktime.Timestamp, 0)
+	precision := time.Duration(locktime.Precision) * time.Second
+	return timestamp.Add(-precision), timestamp.Add(precision)
+}
+
+// locktimeOpen returns whether the transaction's locktime window
+// has opened at time t. This matches blockchain.ValidateLocktime.
+func (ttx *ttlTx) locktimeOpen(t time.Time) bool {
+	return ttx.lockStart.IsZero() || t.After(ttx.lockStart)
+}
+
+// locktimePassed returns whether the transaction's locktime window
+// has closed at time t.
+func (ttx *ttlTx) locktimePassed(t time.Time) bool {
+	return !ttx.lockEnd.IsZero() && !t.Before(ttx.lockEnd)
+}
+
+// GetHeldTransactions returns the transactions in the holding area.
+// These are transactions whose locktime window has not yet opened.
+// They are released into the pool, and become eligible for inclusion
+// in a block, once it does.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) GetHeldTransactions() map[types.ID]*transactions.Transaction {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	txs := make(map[types.ID]*transactions.Transaction, len(m.held))
+	for txid, ttx := range m.held {
+		txs[txid] = proto.Clone(ttx.tx).(*transactions.Transaction)
+	}
+	return txs
+}
+
+// HeldCount returns the number of transactions in the holding area.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) HeldCount() int {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	return len(m.held)
+}
+
+// holdTransaction adds a transaction whose locktime window has not yet
+// opened to the holding area. The checks against the chain are done here
+// as they would be for the pool. Neither a spent nullifier nor the txo
+// root will change by the time the window opens, so a transaction which
+// fails them now would fail them on release. Held transactions do not
+// claim their nullifiers. They are validated against the pool again
+// when they are released.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) holdTransaction(ttx *ttlTx, dryRun bool) error {
+	tx := ttx.tx
+	var txoRoot []byte
+	switch t := tx.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		txoRoot = t.StandardTransaction.TxoRoot
+	case *transactions.Transaction_MintTransaction:
+		txoRoot = t.MintTransaction.TxoRoot
+	default:
+		return ruleError(blockchain.ErrInvalidTx, "transaction type does not have a locktime")
+	}
+	for _, n := range tx.Nullifiers() {
+		exists, err := m.cfg.chainView.NullifierExists(n)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ruleError(blockchain.ErrDoubleSpend, "tx contains spent nullifier")
+		}
+	}
+	exists, err := m.cfg.chainView.TxoRootExists(types.NewID(txoRoot))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ruleError(blockchain.ErrInvalidTx, "txo root does not exist in chain")
+	}
+	if dryRun {
+		return m.checkSize(ttx, nil)
+	}
+
+	ttx.expiration = time.Now().Add(m.cfg.transactionTTL)
+	m.held[tx.ID()] = ttx
+	m.poolBytes += ttx.size
+	log.Debug("New held mempool transaction", log.ArgsFromMap(map[string]any{
+		"txid":         tx.ID().String(),
+		"release time": ttx.lockStart,
+	}))
+
+	m.trimToSize()
+	if _, ok := m.held[tx.ID()]; !ok {
+		return policyError(ErrMempoolFull, "mempool full")
+	}
+	m.sendNotification(NTTransactionAdded, m.newEntry(ttx, EntryHeld))
+	return nil
+}
+
+// processLocktimes releases the held transactions whose locktime window
+// has opened at time now into the pool and removes transactions, held or
+// in the pool, whose window has passed.
+//
+// Released transactions are validated in order of fee per kilobyte so
+// that, if two held transactions spend the same nullifier, the one
+// paying the higher fee rate wins.
+func (m *Mempool) processLocktimes(now time.Time) {
+	m.mempoolLock.Lock()
+	var (
+		release []*ttlTx
+		expired []*transactions.Transaction
+	)
+	for txid, ttx := range m.held {
+		if ttx.locktimePassed(now) {
+			m.removeFromPool(txid, NTTransactionExpired)
+		} else if ttx.locktimeOpen(now) {
+			delete(m.held, txid)
+			m.poolBytes -= ttx.size
+			ttx.held = false
+			release = append(release, ttx)
+		}
+	}
+	for _, ttx := range m.pool {
+		if ttx.locktimePassed(now) {
+			expired = append(expired, ttx.tx)
+		}
+	}
+	m.mempoolLock.Unlock()
+
+	if len(expired) > 0 {
+		m.removeBlockTransactions(expired, NTTransactionExpired)
+	}
+
+	sort.Slice(release, func(i, j int) bool {
+		return release[i].modifiedFpkb() > release[j].modifiedFpkb()
+	})
+	for _, ttx := range release {
+		if err := m.validateTransaction(ttx, false); err != nil {
+			log.Debug("Held mempool transaction failed validation on release", log.ArgsFromMap(map[string]any{
+				"txid":  ttx.tx.ID().String(),
+				"error": err,
+			}))
+			m.sendNotification(NTTransactionEvicted, m.newEntry(ttx, EntryHeld))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"errors"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMempoolLocktime(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+
+	makeTxWithRoot := func(root types.ID, fee uint64, locktime time.Time, precision int64) *transactions.Transaction {
+		tx := newTestTx(root, fee, randomID())
+		tx.GetStandardTransaction().Locktime = &transactions.Locktime{
+			Timestamp: locktime.Unix(),
+			Precision: precision,
+		}
+		return tx
+	}
+	makeTx := func(fee uint64, locktime time.Time, precision int64) *transactions.Transaction {
+		return makeTxWithRoot(txoRoot, fee, locktime, precision)
+	}
+
+	now := time.Now()
+
+	// A transaction whose window has already passed is rejected.
+	err := m.ProcessTransaction(makeTx(20000, now.Add(-time.Hour), 60))
+	var ruleErr blockchain.RuleError
+	assert.True(t, errors.As(err, &ruleErr))
+
+	// A transaction whose window opens after the TTL is rejected.
+	err = m.ProcessTransaction(makeTx(20000, now.Add(time.Hour*48), 60))
+	var policyErr PolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrLocktimeTooFar), policyErr.ErrorCode)
+
+	// A transaction whose window has not opened is rejected if
+	// its txo root is unknown.
+	err = m.ProcessTransaction(makeTxWithRoot(randomID(), 20000, now.Add(time.Hour), 60))
+	assert.True(t, errors.As(err, &ruleErr))
+	assert.Equal(t, blockchain.ErrorCode(blockchain.ErrInvalidTx), ruleErr.ErrorCode)
+	assert.Len(t, m.GetHeldTransactions(), 0)
+
+	// Transactions whose window has not opened are held.
+	tx1 := makeTx(20000, now.Add(time.Hour), 60)
+	tx2 := makeTx(20000, now.Add(time.Hour*2), 60)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	assert.ErrorIs(t, m.ProcessTransaction(tx1), ErrDuplicateTx)
+
+	assert.Len(t, m.GetTransactions(), 0)
+	assert.Len(t, m.GetHeldTransactions(), 2)
+	assert.Equal(t, 2, m.HeldCount())
+
+	entry, err := m.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryHeld, entry.Status)
+	assert.Equal(t, now.Add(time.Hour-time.Minute).Unix(), entry.LocktimeStart.Unix())
+	assert.Equal(t, now.Add(time.Hour+time.Minute).Unix(), entry.LocktimeEnd.Unix())
+
+	size1, err := tx1.SerializedSize()
+	assert.NoError(t, err)
+	size2, err := tx2.SerializedSize()
+	assert.NoError(t, err)
+	assert.Equal(t, size1+size2, m.Size())
+
+	// Once tx1's window opens it is released into the pool.
+	m.processLocktimes(now.Add(time.Hour))
+	txs := m.GetTransactions()
+	assert.Len(t, txs, 1)
+	assert.Contains(t, txs, tx1.ID())
+	assert.Len(t, m.GetHeldTransactions(), 1)
+
+	entry, err = m.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryPending, entry.Status)
+
+	// Once tx1's window passes it is removed from the pool and
+	// tx2, whose window also passed while it was held, is removed
+	// from the holding area.
+	m.processLocktimes(now.Add(time.Hour * 3))
+	assert.Len(t, m.GetTransactions(), 0)
+	assert.Len(t, m.GetHeldTransactions(), 0)
+	assert.Equal(t, 0, m.Size())
+
+	_, err = m.GetEntry(tx2.ID())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -7,6 +7,7 @@ package mempool
 import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/notify"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/transactions"
 	"google.golang.org/protobuf/proto"
@@ -15,15 +16,28 @@ import (
 )
 
 type validationReq struct {
-	tx         *transactions.Transaction
+	ttx        *ttlTx
+	dryRun     bool
 	resultChan chan error
 }
 type removeBlockTxsReq struct {
 	txs []*transactions.Transaction
 }
+type resolveConflictReq struct {
+	txid      types.ID
+	finalized bool
+}
 type ttlTx struct {
 	tx         *transactions.Transaction
 	expiration time.Time
+	size       int
+	fee        types.Amount
+	fpkb       types.Amount
+	isFeePayer bool
+	lockStart  time.Time
+	lockEnd    time.Time
+	held       bool
+	feeDelta   int64
 }
 
 // Mempool holds valid transactions that have been relayed around the
@@ -32,13 +46,23 @@ type ttlTx struct {
 // the mempool transactions to generate blocks.
 type Mempool struct {
 	pool           map[types.ID]*ttlTx
+	conflicts      map[types.ID]*ttlTx
+	held           map[types.ID]*ttlTx
+	feeDeltas      map[types.ID]*feeDelta
 	nullifiers     map[types.Nullifier]types.ID
 	treasuryDebits map[types.ID]types.Amount
 	coinbases      map[peer.ID]*transactions.CoinbaseTransaction
+	poolBytes      int
+	rollingMinFee  float64
+	lastFeeUpdate  time.Time
 	cfg            *config
 	msgChan        chan interface{}
 	quit           chan struct{}
 	mempoolLock    sync.RWMutex
+	feeDeltaLock   sync.Mutex
+
+	notifications     []*notify.Subscriber[*Notification]
+	notificationsLock sync.RWMutex
 }
 
 // NewMempool returns a new mempool with the configuration options.
@@ -59,6 +83,9 @@ func NewMempool(opts ...Option) (*Mempool, error) {
 
 	m := &Mempool{
 		pool:           make(map[types.ID]*ttlTx),
+		conflicts:      make(map[types.ID]*ttlTx),
+		held:           make(map[types.ID]*ttlTx),
+		feeDeltas:      make(map[types.ID]*feeDelta),
 		nullifiers:     make(map[types.Nullifier]types.ID),
 		treasuryDebits: make(map[types.ID]types.Amount),
 		coinbases:      make(map[peer.ID]*transactions.CoinbaseTransaction),
@@ -66,6 +93,11 @@ func NewMempool(opts ...Option) (*Mempool, error) {
 		msgChan:        make(chan interface{}),
 		quit:           make(chan struct{}),
 		mempoolLock:    sync.RWMutex{},
+
+		notificationsLock: sync.RWMutex{},
+	}
+	if err := m.loadFeeDeltas(); err != nil {
+		return nil, err
 	}
 	go m.validationHandler()
 	return m, nil
@@ -78,14 +110,17 @@ func (m *Mempool) Close() {
 
 func (m *Mempool) validationHandler() {
 	ticker := time.NewTicker(time.Hour)
+	locktimeTicker := time.NewTicker(locktimeCheckInterval)
 	for {
 		select {
 		case msg := <-m.msgChan:
 			switch req := msg.(type) {
 			case *validationReq:
-				req.resultChan <- m.validateTransaction(req.tx)
+				req.resultChan <- m.validateTransaction(req.ttx, req.dryRun)
 			case *removeBlockTxsReq:
-				m.removeBlockTransactions(req.txs)
+				m.removeBlockTransactions(req.txs, NTTransactionRemovedByBlock)
+			case *resolveConflictReq:
+				m.resolveConflict(req.txid, req.finalized)
 			}
 		case <-ticker.C:
 			m.mempoolLock.RLock()
@@ -97,8 +132,12 @@ func (m *Mempool) validationHandler() {
 			}
 			m.mempoolLock.RUnlock()
 			if len(toDelete) > 0 {
-				m.removeBlockTransactions(toDelete)
+				m.removeBlockTransactions(toDelete, NTTransactionExpired)
 			}
+			m.expireConflicts()
+			m.expireFeeDeltas(time.Now())
+		case <-locktimeTicker.C:
+			m.processLocktimes(time.Now())
 		case <-m.quit:
 			return
 		}
@@ -113,16 +152,69 @@ func (m *Mempool) validationHandler() {
 // The rest of validation, such as nullifier checks, duplicate mempool checks, etc.
 // are done in a single threaded channel.
 func (m *Mempool) ProcessTransaction(tx *transactions.Transaction) error {
-	if err := blockchain.CheckTransactionSanity(tx, time.Now()); err != nil {
+	ttx, err := m.checkTransaction(tx)
+	if err != nil {
 		return err
 	}
 
+	resultChan := make(chan error)
+	m.msgChan <- &validationReq{
+		ttx:        ttx,
+		resultChan: resultChan,
+	}
+	err = <-resultChan
+	return err
+}
+
+// checkTransaction does the static validation of the transaction, including
+// the signature and proof checks, and returns the pool entry for it.
+func (m *Mempool) checkTransaction(tx *transactions.Transaction) (*ttlTx, error) {
+	// A transaction whose locktime window has not yet opened is checked
+	// as of the locktime so that it can be held until the window opens.
+	now := time.Now()
+	sanityTime := now
+	lockStart, lockEnd := locktimeWindow(tx)
+	held := !lockStart.IsZero() && !now.After(lockStart) && lockStart.Before(lockEnd)
+	if held {
+		if lockStart.Sub(now) > m.cfg.transactionTTL {
+			return nil, policyError(ErrLocktimeTooFar, "transaction locktime too far in the future")
+		}
+		sanityTime = lockStart.Add(lockEnd.Sub(lockStart) / 2)
+	}
+	if err := blockchain.CheckTransactionSanity(tx, sanityTime); err != nil {
+		return nil, err
+	}
+
 	fpkb, isFeePayer, err := CalcFeePerKilobyte(tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	size, err := tx.SerializedSize()
+	if err != nil {
+		return nil, err
+	}
+	ttx := &ttlTx{
+		tx:         proto.Clone(tx).(*transactions.Transaction),
+		size:       size,
+		fee:        transactionFee(tx),
+		fpkb:       fpkb,
+		isFeePayer: isFeePayer,
+		lockStart:  lockStart,
+		lockEnd:    lockEnd,
+		held:       held,
+		feeDelta:   m.FeeDelta(tx.ID()),
+	}
+
+	// Blocks with transactions paying less than the policy minimum are
+	// not acceptable to our own policy or to the policy of other nodes,
+	// so a delta set with PrioritiseTransaction does not count here. It
+	// only counts against the rolling minimum which is raised when the
+	// mempool is full.
 	if isFeePayer && fpkb < m.cfg.fpkb {
-		return policyError(ErrFeeTooLow, "transaction fee is below policy minimum")
+		return nil, policyError(ErrFeeTooLow, "transaction fee is below policy minimum")
+	}
+	if isFeePayer && ttx.modifiedFpkb() < m.MinFeePerKilobyte() {
+		return nil, policyError(ErrFeeTooLow, "transaction fee is below mempool minimum")
 	}
 
 	proofChan := blockchain.ValidateTransactionProof(tx, m.cfg.proofCache, m.cfg.verifier)
@@ -130,20 +222,14 @@ func (m *Mempool) ProcessTransaction(tx *transactions.Transaction) error {
 
 	err = <-proofChan
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = <-sigChan
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	resultChan := make(chan error)
-	m.msgChan <- &validationReq{
-		tx:         proto.Clone(tx).(*transactions.Transaction),
-		resultChan: resultChan,
-	}
-	err = <-resultChan
-	return err
+	return ttx, nil
 }
 
 // GetTransaction returns a transaction given the ID if it exists in the pool.
@@ -153,13 +239,126 @@ func (m *Mempool) GetTransaction(txid types.ID) (*transactions.Transaction, erro
 
 	tx, ok := m.pool[txid]
 	if !ok {
-		return nil, ErrNotFound
+		tx, ok = m.conflicts[txid]
+		if !ok {
+			return nil, ErrNotFound
+		}
 	}
 	cpy := proto.Clone(tx.tx)
 	return cpy.(*transactions.Transaction), nil
 }
 
+// EntryStatus describes where a transaction is held in the mempool.
+type EntryStatus int
+
+const (
+	// EntryPending is a transaction in the pool which is eligible
+	// for inclusion in a block.
+	EntryPending EntryStatus = iota
+
+	// EntryContested is a transaction which spends the same nullifier
+	// as another transaction and is being voted on by the consensus
+	// engine.
+	EntryContested
+
+	// EntryHeld is a transaction whose locktime window has not yet
+	// opened. It is released into the pool when it does.
+	EntryHeld
+)
+
+// Entry holds metadata about a transaction in the mempool.
+type Entry struct {
+	ID             types.ID
+	Type           string
+	Size           int
+	Fee            types.Amount
+	FeePerKilobyte types.Amount
+	// FeeDelta is the delta set with PrioritiseTransaction. It
+	// is applied to Fee when the transaction is compared against
+	// other transactions.
+	FeeDelta int64
+	// Added is the time the transaction entered the mempool.
+	Added  time.Time
+	Status EntryStatus
+	// LocktimeStart and LocktimeEnd are the range of block times in
+	// which the transaction may be included in a block. They are zero
+	// if the transaction has no locktime.
+	LocktimeStart time.Time
+	LocktimeEnd   time.Time
+}
+
+// GetEntry returns the metadata for a transaction in the mempool.
+// Conflicting and held transactions are included.
+func (m *Mempool) GetEntry(txid types.ID) (*Entry, error) {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	if ttx, ok := m.pool[txid]; ok {
+		return m.newEntry(ttx, EntryPending), nil
+	}
+	if ttx, ok := m.conflicts[txid]; ok {
+		return m.newEntry(ttx, EntryContested), nil
+	}
+	if ttx, ok := m.held[txid]; ok {
+		return m.newEntry(ttx, EntryHeld), nil
+	}
+	return nil, ErrNotFound
+}
+
+// newEntry returns the Entry for the transaction.
+func (m *Mempool) newEntry(ttx *ttlTx, status EntryStatus) *Entry {
+	return &Entry{
+		ID:             ttx.tx.ID(),
+		Type:           ttx.tx.Type(),
+		Size:           ttx.size,
+		Fee:            ttx.fee,
+		FeePerKilobyte: ttx.fpkb,
+		FeeDelta:       ttx.feeDelta,
+		Added:          ttx.expiration.Add(-m.cfg.transactionTTL),
+		Status:         status,
+		LocktimeStart:  ttx.lockStart,
+		LocktimeEnd:    ttx.lockEnd,
+	}
+}
+
+// TxDesc describes a transaction in the pool for block assembly.
+type TxDesc struct {
+	Tx   *transactions.Transaction
+	Size int
+	// FeePerKilobyte is the fee per kilobyte paid by the transaction.
+	// It is zero for transactions which do not pay a fee.
+	FeePerKilobyte types.Amount
+	// ModifiedFeePerKilobyte is FeePerKilobyte adjusted by any delta
+	// set with PrioritiseTransaction.
+	ModifiedFeePerKilobyte types.Amount
+	IsFeePayer             bool
+}
+
+// GetTxDescs returns a TxDesc for each transaction in the pool.
+//
+// Conflicting transactions which are still being voted on by the consensus
+// engine and held transactions are not included.
+func (m *Mempool) GetTxDescs() []*TxDesc {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	descs := make([]*TxDesc, 0, len(m.pool))
+	for _, ttx := range m.pool {
+		descs = append(descs, &TxDesc{
+			Tx:                     proto.Clone(ttx.tx).(*transactions.Transaction),
+			Size:                   ttx.size,
+			FeePerKilobyte:         ttx.fpkb,
+			ModifiedFeePerKilobyte: ttx.modifiedFpkb(),
+			IsFeePayer:             ttx.isFeePayer,
+		})
+	}
+	return descs
+}
+
 // GetTransactions returns the full list of transactions from the pool.
+//
+// Conflicting transactions which are still being voted on by the consensus
+// engine are not included.
 func (m *Mempool) GetTransactions() map[types.ID]*transactions.Transaction {
 	m.mempoolLock.RLock()
 	defer m.mempoolLock.RUnlock()
@@ -173,6 +372,21 @@ func (m *Mempool) GetTransactions() map[types.ID]*transactions.Transaction {
 	return pool
 }
 
+// GetTxids returns the IDs of all the transactions in the pool.
+//
+// Conflicting transactions which are still being voted on by the
+// consensus engine are not included.
+func (m *Mempool) GetTxids() []types.ID {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	txids := make([]types.ID, 0, len(m.pool))
+	for txid := range m.pool {
+		txids = append(txids, txid)
+	}
+	return txids
+}
+
 // RemoveBlockTransactions should be called when a block is connected. It will remove
 // the block's transactions from the mempool and update the rest of the mempool state.
 //
@@ -184,14 +398,32 @@ func (m *Mempool) RemoveBlockTransactions(txs []*transactions.Transaction) {
 }
 
 // removeBlockTransactions is the implementation of RemoveBlockTransactions.
+// It is also used to remove expired transactions. Subscribers are notified
+// of each removed transaction with the reason.
 //
 // This method is NOT safe for concurrent access.
-func (m *Mempool) removeBlockTransactions(txs []*transactions.Transaction) {
+func (m *Mempool) removeBlockTransactions(txs []*transactions.Transaction, reason NotificationType) {
+	// The removed fee deltas are deleted from the datastore after
+	// the mempoolLock is released by the deferred unlock below.
+	var removedDeltas []types.ID
+	defer func() {
+		if len(removedDeltas) > 0 {
+			if err := m.saveFeeDeltas(removedDeltas...); err != nil {
+				log.WithCaller(true).Error("Error deleting mempool fee delta", log.Args("error", err))
+			}
+		}
+	}()
+
 	m.mempoolLock.Lock()
 	defer m.mempoolLock.Unlock()
 
 	for _, tx := range txs {
-		delete(m.pool, tx.ID())
+		m.removeFromPool(tx.ID(), reason)
+		m.removeConflict(tx.ID(), reason)
+		if _, ok := m.feeDeltas[tx.ID()]; ok && reason == NTTransactionRemovedByBlock {
+			delete(m.feeDeltas, tx.ID())
+			removedDeltas = append(removedDeltas, tx.ID())
+		}
 
 		switch t := tx.GetTx().(type) {
 		case *transactions.Transaction_CoinbaseTransaction:
@@ -210,16 +442,24 @@ func (m *Mempool) removeBlockTransactions(txs []*transactions.Transaction) {
 				poolID, ok := m.nullifiers[types.NewNullifier(n)]
 				if ok {
 					delete(m.nullifiers, types.NewNullifier(n))
-					delete(m.pool, poolID)
+					m.removeFromPool(poolID, reason)
+					if poolID != tx.ID() {
+						m.untrackFee(poolID)
+					}
 				}
+				m.removeConflictingSpends(types.NewNullifier(n), reason)
 			}
 		case *transactions.Transaction_MintTransaction:
 			for _, n := range t.MintTransaction.Nullifiers {
 				poolID, ok := m.nullifiers[types.NewNullifier(n)]
 				if ok {
 					delete(m.nullifiers, types.NewNullifier(n))
-					delete(m.pool, poolID)
+					m.removeFromPool(poolID, reason)
+					if poolID != tx.ID() {
+						m.untrackFee(poolID)
+					}
 				}
+				m.removeConflictingSpends(types.NewNullifier(n), reason)
 			}
 		case *transactions.Transaction_TreasuryTransaction:
 			delete(m.treasuryDebits, t.TreasuryTransaction.ID())
@@ -227,13 +467,26 @@ func (m *Mempool) removeBlockTransactions(txs []*transactions.Transaction) {
 	}
 }
 
-func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
+// validateTransaction validates the transaction against the state of the
+// mempool and the chain and, if valid, adds it to the pool. If dryRun is
+// true the transaction is validated but the mempool is left unchanged.
+func (m *Mempool) validateTransaction(ttx *ttlTx, dryRun bool) error {
 	m.mempoolLock.Lock()
 	defer m.mempoolLock.Unlock()
 
+	tx := ttx.tx
 	if _, ok := m.pool[tx.ID()]; ok {
 		return ErrDuplicateTx
 	}
+	if _, ok := m.conflicts[tx.ID()]; ok {
+		return ErrDuplicateTx
+	}
+	if _, ok := m.held[tx.ID()]; ok {
+		return ErrDuplicateTx
+	}
+	if ttx.held {
+		return m.holdTransaction(ttx, dryRun)
+	}
 
 	switch t := tx.GetTx().(type) {
 	case *transactions.Transaction_CoinbaseTransaction:
@@ -254,21 +507,22 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 		// coinbases from the same validator in the mempool so we will evict the
 		// older one *only* if the new coinbase is redeeming more unclaimed coins.
 		prevCoinbase, ok := m.coinbases[validatorID]
+		if ok && t.CoinbaseTransaction.NewCoins <= prevCoinbase.NewCoins {
+			return ruleError(ErrDuplicateCoinbase, "coinbase from validator already in pool")
+		}
+		if dryRun {
+			return nil
+		}
 		if ok {
-			if t.CoinbaseTransaction.NewCoins > prevCoinbase.NewCoins {
-				delete(m.pool, prevCoinbase.ID())
-				m.coinbases[validatorID] = t.CoinbaseTransaction
-			} else {
-				return ruleError(ErrDuplicateCoinbase, "coinbase from validator already in pool")
-			}
-		} else {
-			m.coinbases[validatorID] = t.CoinbaseTransaction
+			m.removeFromPool(prevCoinbase.ID(), NTTransactionEvicted)
 		}
+		m.coinbases[validatorID] = t.CoinbaseTransaction
 
 	case *transactions.Transaction_StandardTransaction:
+		conflicting := false
 		for _, n := range t.StandardTransaction.Nullifiers {
 			if _, ok := m.nullifiers[types.NewNullifier(n)]; ok {
-				return ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool")
+				conflicting = true
 			}
 			exists, err := m.cfg.chainView.NullifierExists(types.NewNullifier(n))
 			if err != nil {
@@ -285,13 +539,33 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 		if !exists {
 			return ruleError(blockchain.ErrInvalidTx, "txo root does not exist in chain")
 		}
+		if conflicting {
+			if m.cfg.conflictHandler != nil {
+				if dryRun {
+					return m.checkConflict(ttx)
+				}
+				return m.addConflict(ttx)
+			}
+			replaced, err := m.checkReplacement(ttx)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				return m.checkSize(ttx, replaced)
+			}
+			m.removeReplaced(replaced, tx.ID())
+		}
+		if dryRun {
+			return m.checkSize(ttx, nil)
+		}
 		for _, n := range t.StandardTransaction.Nullifiers {
 			m.nullifiers[types.NewNullifier(n)] = t.StandardTransaction.ID()
 		}
 	case *transactions.Transaction_MintTransaction:
+		conflicting := false
 		for _, n := range t.MintTransaction.Nullifiers {
 			if _, ok := m.nullifiers[types.NewNullifier(n)]; ok {
-				return ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool")
+				conflicting = true
 			}
 			exists, err := m.cfg.chainView.NullifierExists(types.NewNullifier(n))
 			if err != nil {
@@ -308,6 +582,25 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 		if !exists {
 			return ruleError(blockchain.ErrInvalidTx, "txo root does not exist in chain")
 		}
+		if conflicting {
+			if m.cfg.conflictHandler != nil {
+				if dryRun {
+					return m.checkConflict(ttx)
+				}
+				return m.addConflict(ttx)
+			}
+			replaced, err := m.checkReplacement(ttx)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				return m.checkSize(ttx, replaced)
+			}
+			m.removeReplaced(replaced, tx.ID())
+		}
+		if dryRun {
+			return m.checkSize(ttx, nil)
+		}
 		for _, n := range t.MintTransaction.Nullifiers {
 			m.nullifiers[types.NewNullifier(n)] = t.MintTransaction.ID()
 		}
@@ -363,33 +656,51 @@ func (m *Mempool) validateTransaction(tx *transactions.Transaction) error {
 			return ruleError(blockchain.ErrInvalidTx, "treasury tx amount exceeds treasury balance")
 		}
 
+		if dryRun {
+			return nil
+		}
 		m.treasuryDebits[t.TreasuryTransaction.ID()] = types.Amount(t.TreasuryTransaction.Amount)
 	default:
 		return ruleError(blockchain.ErrInvalidTx, "unknown transaction type")
 	}
-	m.pool[tx.ID()] = &ttlTx{
-		tx:         tx,
-		expiration: time.Now().Add(m.cfg.transactionTTL),
+	if dryRun {
+		return nil
 	}
+	ttx.expiration = time.Now().Add(m.cfg.transactionTTL)
+	m.pool[tx.ID()] = ttx
+	m.poolBytes += ttx.size
 	log.Debug("New mempool transaction", log.ArgsFromMap(map[string]any{
 		"txid": tx.ID().String(),
 		"type": tx.Type(),
 	}))
+
+	m.trimToSize()
+	if _, ok := m.pool[tx.ID()]; !ok {
+		return policyError(ErrMempoolFull, "mempool full")
+	}
+	if m.cfg.feeEstimator != nil && ttx.isFeePayer {
+		m.cfg.feeEstimator.ObserveTransaction(tx.ID(), ttx.fpkb)
+	}
+	m.sendNotification(NTTransactionAdded, m.newEntry(ttx, EntryPending))
 	return nil
 }
 
+// untrackFee tells the fee estimator, if there is one, to stop tracking
+// a transaction which left the mempool without being confirmed.
+func (m *Mempool) untrackFee(txid types.ID) {
+	if m.cfg.feeEstimator != nil {
+		m.cfg.feeEstimator.RemoveTransaction(txid)
+	}
+}
+
 func CalcFeePerKilobyte(tx *transactions.Transaction) (types.Amount, bool, error) {
-	var fee uint64
-	switch t := tx.GetTx().(type) {
+	switch tx.GetTx().(type) {
 	case *transactions.Transaction_CoinbaseTransaction,
 		*transactions.Transaction_TreasuryTransaction,
 		*transactions.Transaction_StakeTransaction:
 		return 0, false, nil
-	case *transactions.Transaction_StandardTransaction:
-		fee = t.StandardTransaction.Fee
-	case *transactions.Transaction_MintTransaction:
-		fee = t.MintTransaction.Fee
 	}
+	fee := transactionFee(tx)
 
 	size, err := tx.SerializedSize()
 	if err != nil {
@@ -399,3 +710,15 @@ func CalcFeePerKilobyte(tx *transactions.Transaction) (types.Amount, bool, error
 
 	return types.Amount(float64(fee) / kbs), true, nil
 }
+
+// transactionFee returns the fee paid by the transaction. Only
+// standard and mint transactions pay a fee.
+func transactionFee(tx *transactions.Transaction) types.Amount {
+	switch t := tx.GetTx().(type) {
+	case *transactions.Transaction_StandardTransaction:
+		return types.Amount(t.StandardTransaction.Fee)
+	case *transactions.Transaction_MintTransaction:
+		return types.Amount(t.MintTransaction.Fee)
+	}
+	return 0
+}
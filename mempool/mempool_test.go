@@ -743,6 +743,108 @@ func TestMempool(t *testing.T) {
 	}
 }
 
+func TestMempoolConflicts(t *testing.T) {
+	view := newMockBlockchainView()
+	contestedChan := make(chan []*transactions.Transaction)
+	m := newTestMempool(t, view, ConflictHandler(func(txs []*transactions.Transaction) {
+		contestedChan <- txs
+	}))
+
+	txoRoot := view.addTxoRoot()
+	nullifier := randomID()
+
+	tx1 := newTestTx(txoRoot, 20000, nullifier)
+	tx2 := newTestTx(txoRoot, 30000, nullifier)
+	tx3 := newTestTx(txoRoot, 40000, nullifier)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.Len(t, m.GetTransactions(), 1)
+
+	// The second spend contests both transactions.
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	contested := <-contestedChan
+	assert.Len(t, contested, 2)
+	assert.Equal(t, tx1.ID(), contested[0].ID())
+	assert.Equal(t, tx2.ID(), contested[1].ID())
+
+	// The first spend is the initial preference and stays in the pool.
+	assert.Len(t, m.GetTransactions(), 1)
+	entry, err := m.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryPending, entry.Status)
+	entry, err = m.GetEntry(tx2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryContested, entry.Status)
+
+	// The third spend contests itself and the spend in the pool.
+	assert.NoError(t, m.ProcessTransaction(tx3))
+	contested = <-contestedChan
+	assert.Len(t, contested, 2)
+	assert.Equal(t, tx1.ID(), contested[0].ID())
+	assert.Equal(t, tx3.ID(), contested[1].ID())
+
+	_, err = m.GetTransaction(tx3.ID())
+	assert.NoError(t, err)
+
+	m.ConflictRejected(tx1.ID())
+	assert.Eventually(t, func() bool {
+		return len(m.GetTransactions()) == 0
+	}, time.Second, time.Millisecond*10)
+	m.ConflictFinalized(tx2.ID())
+
+	assert.Eventually(t, func() bool {
+		_, ok := m.GetTransactions()[tx2.ID()]
+		return ok
+	}, time.Second, time.Millisecond*10)
+	assert.Len(t, m.GetTransactions(), 1)
+	_, err = m.GetTransaction(tx3.ID())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMempoolConflictsEvictLoser(t *testing.T) {
+	view := newMockBlockchainView()
+	contestedChan := make(chan []*transactions.Transaction, 1)
+	m := newTestMempool(t, view, ConflictHandler(func(txs []*transactions.Transaction) {
+		contestedChan <- txs
+	}))
+
+	txoRoot := view.addTxoRoot()
+	nullifier1, nullifier2 := randomID(), randomID()
+
+	// The losing spend in the pool spends a nullifier
+	// which the finalized spend does not.
+	tx1 := newTestTx(txoRoot, 20000, nullifier1, nullifier2)
+	tx2 := newTestTx(txoRoot, 15000, nullifier1)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	<-contestedChan
+
+	m.ConflictFinalized(tx2.ID())
+	assert.Eventually(t, func() bool {
+		_, err := m.GetEntry(tx1.ID())
+		return errors.Is(err, ErrNotFound)
+	}, time.Second, time.Millisecond*10)
+
+	// The other nullifier is no longer spent in the
+	// mempool so a new spend of it is not a conflict.
+	m.mempoolLock.RLock()
+	_, ok := m.nullifiers[types.NewNullifier(nullifier2.Bytes())]
+	m.mempoolLock.RUnlock()
+	assert.False(t, ok)
+
+	tx3 := newTestTx(txoRoot, 20000, nullifier2)
+	assert.NoError(t, m.ProcessTransaction(tx3))
+	entry, err := m.GetEntry(tx3.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryPending, entry.Status)
+	assert.Len(t, m.GetTransactions(), 2)
+
+	select {
+	case <-contestedChan:
+		t.Errorf("Spend of evicted nullifier passed to conflict handler")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
 func newMockBlockchainView() *mockBlockchainView {
 	return &mockBlockchainView{
 		treasuryBalance: 0,
@@ -752,6 +854,44 @@ func newMockBlockchainView() *mockBlockchainView {
 	}
 }
 
+// newTestMempool returns a mempool backed by the view with a verifier
+// which accepts all proofs. The options are applied after the defaults.
+// The mempool is closed when the test finishes.
+func newTestMempool(t *testing.T, view *mockBlockchainView, opts ...Option) *Mempool {
+	verifier := &zk.MockVerifier{}
+	verifier.SetValid(true)
+
+	opts = append([]Option{DefaultOptions(), BlockchainView(view), Verifier(verifier)}, opts...)
+	m, err := NewMempool(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(m.Close)
+	return m
+}
+
+// newTestTx returns a standard transaction with a 1000 byte proof which
+// spends the nullifiers from the txo root and pays the fee. Fields may be
+// changed before the transaction ID is first computed.
+func newTestTx(txoRoot types.ID, fee uint64, nullifiers ...types.ID) *transactions.Transaction {
+	ns := make([][]byte, 0, len(nullifiers))
+	for _, n := range nullifiers {
+		ns = append(ns, n.Bytes())
+	}
+	return transactions.WrapTransaction(&transactions.StandardTransaction{
+		Outputs: []*transactions.Output{
+			{
+				Commitment: make([]byte, types.CommitmentLen),
+				Ciphertext: make([]byte, blockchain.CiphertextLen),
+			},
+		},
+		Nullifiers: ns,
+		TxoRoot:    txoRoot.Bytes(),
+		Fee:        fee,
+		Proof:      make([]byte, 1000),
+	})
+}
+
 func TestFeePerKilobyte(t *testing.T) {
 	tx := transactions.WrapTransaction(&transactions.StandardTransaction{
 		Outputs: []*transactions.Output{
@@ -788,6 +928,13 @@ type mockBlockchainView struct {
 	validators      map[peer.ID]*blockchain.Validator
 }
 
+// addTxoRoot adds a random txo root to the view and returns it.
+func (m *mockBlockchainView) addTxoRoot() types.ID {
+	txoRoot := randomID()
+	m.txoRoots[txoRoot] = true
+	return txoRoot
+}
+
 func (m *mockBlockchainView) TreasuryBalance() (types.Amount, error) {
 	return m.treasuryBalance, nil
 }
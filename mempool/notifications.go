@@ -0,0 +1,111 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"fmt"
+	"github.com/project-illium/ilxd/notify"
+)
+
+// NotificationType represents the type of a notification message.
+type NotificationType int
+
+// NotificationCallback is used for a caller to provide a callback for
+// notifications about mempool events.
+type NotificationCallback func(*Notification)
+
+// Constants for the type of notification message
+const (
+	// NTTransactionAdded indicates a transaction was accepted into
+	// the mempool, either into the pool, as a conflicting spend to be
+	// voted on by the consensus engine, or into the holding area until
+	// its locktime window opens. A held transaction is announced again
+	// when it is released into the pool.
+	NTTransactionAdded NotificationType = iota
+
+	// NTTransactionRemovedByBlock indicates a transaction was removed
+	// because it, or a transaction spending the same nullifiers, was
+	// included in a connected block.
+	NTTransactionRemovedByBlock
+
+	// NTTransactionExpired indicates a transaction was removed because
+	// it was in the mempool longer than the transaction TTL or its
+	// locktime window passed.
+	NTTransactionExpired
+
+	// NTTransactionEvicted indicates a transaction was removed to make
+	// room in a full mempool, was replaced by a transaction paying a
+	// higher fee, lost a conflict vote in the consensus engine, or was
+	// no longer valid when released from the holding area.
+	NTTransactionEvicted
+
+	// NTTransactionStatusChanged indicates a conflicting spend which was
+	// held out of the pool while the consensus engine voted on it was
+	// finalized and moved into the pool, or a spend in the pool was held
+	// out of it by a replacement paying a higher fee. The entry has the
+	// new status.
+	NTTransactionStatusChanged
+)
+
+// notificationTypeStrings is a map of notification types back to their constant
+// names for pretty printing.
+var notificationTypeStrings = map[NotificationType]string{
+	NTTransactionAdded:          "NTTransactionAdded",
+	NTTransactionRemovedByBlock: "NTTransactionRemovedByBlock",
+	NTTransactionExpired:        "NTTransactionExpired",
+	NTTransactionEvicted:        "NTTransactionEvicted",
+	NTTransactionStatusChanged:  "NTTransactionStatusChanged",
+}
+
+// String returns the NotificationType in human-readable form.
+func (n NotificationType) String() string {
+	if s, ok := notificationTypeStrings[n]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown Notification Type (%d)", int(n))
+}
+
+// Notification defines a notification that is sent to the subscribers
+// and consists of a notification type as well as associated data that
+// depends on the type as follows:
+//   - NTTransactionAdded:          *Entry
+//   - NTTransactionRemovedByBlock: *Entry
+//   - NTTransactionExpired:        *Entry
+//   - NTTransactionEvicted:        *Entry
+//   - NTTransactionStatusChanged:  *Entry
+type Notification struct {
+	Type NotificationType
+	Data interface{}
+}
+
+// Subscribe to mempool notifications. Registers a callback to be executed
+// when various events take place. See the documentation on Notification and
+// NotificationType for details on the types and contents of notifications.
+//
+// Notifications are delivered to the callback in the order in which the
+// events took place. The callback is not called again until it returns.
+// If the callback falls more than notify.DefaultQueueSize notifications
+// behind the oldest queued notifications are dropped.
+func (m *Mempool) Subscribe(callback NotificationCallback) {
+	sub := notify.NewSubscriber[*Notification](callback, notify.DefaultQueueSize)
+	m.notificationsLock.Lock()
+	m.notifications = append(m.notifications, sub)
+	m.notificationsLock.Unlock()
+
+	go sub.Run(m.quit)
+}
+
+// sendNotification sends a notification with the passed type and data to
+// each subscriber.
+func (m *Mempool) sendNotification(typ NotificationType, data interface{}) {
+	n := Notification{Type: typ, Data: data}
+	m.notificationsLock.RLock()
+	for _, sub := range m.notifications {
+		if sub.Send(&n) {
+			log.Warn("Mempool notification subscriber is falling behind, dropping notifications")
+		}
+	}
+	m.notificationsLock.RUnlock()
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMempoolNotifications(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+
+	notifs := make(chan *Notification, 10)
+	m.Subscribe(func(n *Notification) {
+		notifs <- n
+	})
+	next := func() (NotificationType, *Entry) {
+		select {
+		case n := <-notifs:
+			return n.Type, n.Data.(*Entry)
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for notification")
+		}
+		return 0, nil
+	}
+
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 20000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+
+	typ, entry := next()
+	assert.Equal(t, NTTransactionAdded, typ)
+	assert.Equal(t, tx1.ID(), entry.ID)
+	assert.Equal(t, types.Amount(20000), entry.Fee)
+	assert.Equal(t, EntryPending, entry.Status)
+
+	stored, err := m.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, entry, stored)
+
+	// Replacing the transaction evicts the original before the
+	// replacement is added.
+	tx2 := newTestTx(txoRoot, 40000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx2))
+
+	typ, entry = next()
+	assert.Equal(t, NTTransactionEvicted, typ)
+	assert.Equal(t, tx1.ID(), entry.ID)
+	typ, entry = next()
+	assert.Equal(t, NTTransactionAdded, typ)
+	assert.Equal(t, tx2.ID(), entry.ID)
+
+	_, err = m.GetEntry(tx1.ID())
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	m.RemoveBlockTransactions([]*transactions.Transaction{tx2})
+	typ, entry = next()
+	assert.Equal(t, NTTransactionRemovedByBlock, typ)
+	assert.Equal(t, tx2.ID(), entry.ID)
+}
+
+func TestMempoolConflictNotifications(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view, ConflictHandler(func(txs []*transactions.Transaction) {}))
+
+	notifs := make(chan *Notification, 10)
+	m.Subscribe(func(n *Notification) {
+		notifs <- n
+	})
+	next := func() (NotificationType, *Entry) {
+		select {
+		case n := <-notifs:
+			return n.Type, n.Data.(*Entry)
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for notification")
+		}
+		return 0, nil
+	}
+
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 30000, n1)
+	tx2 := newTestTx(txoRoot, 20000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.NoError(t, m.ProcessTransaction(tx2))
+
+	// The first spend stays in the pool while the second is held
+	// out of it.
+	typ, entry := next()
+	assert.Equal(t, NTTransactionAdded, typ)
+	assert.Equal(t, tx1.ID(), entry.ID)
+	assert.Equal(t, EntryPending, entry.Status)
+	typ, entry = next()
+	assert.Equal(t, NTTransactionAdded, typ)
+	assert.Equal(t, tx2.ID(), entry.ID)
+	assert.Equal(t, EntryContested, entry.Status)
+
+	// Finalizing the held spend evicts the spend in the pool and
+	// moves the winner into the pool.
+	m.ConflictFinalized(tx2.ID())
+
+	typ, entry = next()
+	assert.Equal(t, NTTransactionEvicted, typ)
+	assert.Equal(t, tx1.ID(), entry.ID)
+	typ, entry = next()
+	assert.Equal(t, NTTransactionStatusChanged, typ)
+	assert.Equal(t, tx2.ID(), entry.ID)
+	assert.Equal(t, EntryPending, entry.Status)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
 	"time"
 )
@@ -17,6 +18,7 @@ const (
 	defaultSigCacheSize   = 100000
 	defaultProofCacheSize = 100000
 	defaultTransactionTTL = time.Hour * 24
+	defaultMaxSize        = 300 << 20
 )
 
 // DefaultOptions returns a blockchain configure option that fills in
@@ -31,6 +33,7 @@ func DefaultOptions() Option {
 		cfg.proofCache = blockchain.NewProofCache(defaultProofCacheSize)
 		cfg.treasuryWhitelist = make(map[types.ID]bool)
 		cfg.transactionTTL = defaultTransactionTTL
+		cfg.maxSize = defaultMaxSize
 		return nil
 	}
 }
@@ -113,6 +116,38 @@ func TransactionTTL(ttl time.Duration) Option {
 	}
 }
 
+// MaxSize is the maximum size, in bytes, of the transactions in the
+// mempool. When it is exceeded the transactions with the lowest fee per
+// kilobyte are evicted and the minimum fee is raised. Zero means the
+// mempool is unbounded.
+func MaxSize(size int) Option {
+	return func(cfg *config) error {
+		cfg.maxSize = size
+		return nil
+	}
+}
+
+// Estimator is a FeeEstimator that is notified of the fee paying
+// transactions accepted into the mempool so that it can track how
+// long they take to confirm.
+//
+// This option is optional.
+func Estimator(fe *FeeEstimator) Option {
+	return func(cfg *config) error {
+		cfg.feeEstimator = fe
+		return nil
+	}
+}
+
+// Datastore is used to persist the fee deltas set with
+// PrioritiseTransaction. If nil the deltas are kept in memory only.
+func Datastore(ds repo.Datastore) Option {
+	return func(cfg *config) error {
+		cfg.ds = ds
+		return nil
+	}
+}
+
 // SignatureCache caches signature validation so we don't need to expend
 // extra CPU to validate signatures more than once.
 //
@@ -135,6 +170,29 @@ func ProofCache(proofCache *blockchain.ProofCache) Option {
 	}
 }
 
+// ConflictHandler is called when a transaction spends a nullifier that
+// is already spent by another transaction in the pool. The handler is
+// passed every transaction which has just become contested and should pass
+// them, in order, into the consensus engine to vote on. The spend in the
+// pool comes first as it is our initial preference. The outcome of the vote
+// is reported back to the mempool with ConflictFinalized and ConflictRejected.
+//
+// If this is set, every conflicting transaction is voted on, including those
+// which pay enough to replace the spend in the pool. Removing the replaced
+// spend locally would let nodes that saw the spends in a different order
+// end up with different spends in their pools. Instead a replacement takes
+// the place of the spends it replaces in the pool, and so is preferred, on
+// every node regardless of the order in which they saw the spends.
+//
+// If this is nil, conflicting transactions are rejected as double spends
+// unless they qualify as a replacement.
+func ConflictHandler(f func(txs []*transactions.Transaction)) Option {
+	return func(cfg *config) error {
+		cfg.conflictHandler = f
+		return nil
+	}
+}
+
 // Config specifies the blockchain configuration.
 type config struct {
 	params            *params.NetworkParams
@@ -146,6 +204,10 @@ type config struct {
 	verifier          zk.Verifier
 	treasuryWhitelist map[types.ID]bool
 	transactionTTL    time.Duration
+	maxSize           int
+	feeEstimator      *FeeEstimator
+	ds                repo.Datastore
+	conflictHandler   func(txs []*transactions.Transaction)
 }
 
 func (cfg *config) validate() error {
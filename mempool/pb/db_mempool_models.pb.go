@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: db_mempool_models.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DBFeeEstimator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  uint32         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Buckets []*DBFeeBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *DBFeeEstimator) Reset() {
+	*x = DBFeeEstimator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_mempool_models_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBFeeEstimator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBFeeEstimator) ProtoMessage() {}
+
+func (x *DBFeeEstimator) ProtoReflect() protoreflect.Message {
+	mi := &file_db_mempool_models_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBFeeEstimator.ProtoReflect.Descriptor instead.
+func (*DBFeeEstimator) Descriptor() ([]byte, []int) {
+	return file_db_mempool_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DBFeeEstimator) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DBFeeEstimator) GetBuckets() []*DBFeeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type DBFeeDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delta      int64                  `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *DBFeeDelta) Reset() {
+	*x = DBFeeDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_mempool_models_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBFeeDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBFeeDelta) ProtoMessage() {}
+
+func (x *DBFeeDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_db_mempool_models_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBFeeDelta.ProtoReflect.Descriptor instead.
+func (*DBFeeDelta) Descriptor() ([]byte, []int) {
+	return file_db_mempool_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DBFeeDelta) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *DBFeeDelta) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type DBFeeBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     float64   `protobuf:"fixed64,1,opt,name=total,proto3" json:"total,omitempty"`
+	Confirmed []float64 `protobuf:"fixed64,2,rep,packed,name=confirmed,proto3" json:"confirmed,omitempty"`
+}
+
+func (x *DBFeeBucket) Reset() {
+	*x = DBFeeBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_mempool_models_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBFeeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBFeeBucket) ProtoMessage() {}
+
+func (x *DBFeeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_db_mempool_models_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBFeeBucket.ProtoReflect.Descriptor instead.
+func (*DBFeeBucket) Descriptor() ([]byte, []int) {
+	return file_db_mempool_models_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DBFeeBucket) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DBFeeBucket) GetConfirmed() []float64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return nil
+}
+
+var File_db_mempool_models_proto protoreflect.FileDescriptor
+
+var file_db_mempool_models_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x64, 0x62, 0x5f, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x0e, 0x44, 0x42,
+	0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x44, 0x42, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x0a,
+	0x44, 0x42, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0b,
+	0x44, 0x42, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42,
+	0x07, 0x5a, 0x05, 0x2e, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_db_mempool_models_proto_rawDescOnce sync.Once
+	file_db_mempool_models_proto_rawDescData = file_db_mempool_models_proto_rawDesc
+)
+
+func file_db_mempool_models_proto_rawDescGZIP() []byte {
+	file_db_mempool_models_proto_rawDescOnce.Do(func() {
+		file_db_mempool_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_db_mempool_models_proto_rawDescData)
+	})
+	return file_db_mempool_models_proto_rawDescData
+}
+
+var file_db_mempool_models_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_db_mempool_models_proto_goTypes = []interface{}{
+	(*DBFeeEstimator)(nil),        // 0: DBFeeEstimator
+	(*DBFeeDelta)(nil),            // 1: DBFeeDelta
+	(*DBFeeBucket)(nil),           // 2: DBFeeBucket
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_db_mempool_models_proto_depIdxs = []int32{
+	2, // 0: DBFeeEstimator.buckets:type_name -> DBFeeBucket
+	3, // 1: DBFeeDelta.expiration:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_db_mempool_models_proto_init() }
+func file_db_mempool_models_proto_init() {
+	if File_db_mempool_models_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_db_mempool_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBFeeEstimator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_mempool_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBFeeDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_mempool_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBFeeBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_db_mempool_models_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_db_mempool_models_proto_goTypes,
+		DependencyIndexes: file_db_mempool_models_proto_depIdxs,
+		MessageInfos:      file_db_mempool_models_proto_msgTypes,
+	}.Build()
+	File_db_mempool_models_proto = out.File
+	file_db_mempool_models_proto_rawDesc = nil
+	file_db_mempool_models_proto_goTypes = nil
+	file_db_mempool_models_proto_depIdxs = nil
+}
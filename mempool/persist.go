@@ -0,0 +1,233 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// mempoolFileVersion is the version of the file format written by Save.
+const mempoolFileVersion = 1
+
+// maxPersistedTxSize is the largest serialized transaction we will
+// read from a mempool file. This guards against allocating huge
+// buffers if the file is corrupt.
+const maxPersistedTxSize = 1 << 23
+
+var ErrUnknownFileVersion = errors.New("unknown mempool file version")
+
+type persistedTx struct {
+	tx    *transactions.Transaction
+	added time.Time
+}
+
+// Save writes the transactions in the mempool, including conflicting
+// transactions still being voted on and held transactions, to the file
+// at path. It returns the number of transactions written.
+//
+// The file is written atomically so a crash during the save will not
+// corrupt a previously saved file.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) Save(path string) (int, error) {
+	m.mempoolLock.RLock()
+	entries := make([]*persistedTx, 0, len(m.pool)+len(m.conflicts)+len(m.held))
+	for _, pool := range []map[types.ID]*ttlTx{m.pool, m.conflicts, m.held} {
+		for _, ttx := range pool {
+			entries = append(entries, &persistedTx{
+				tx:    ttx.tx,
+				added: ttx.expiration.Add(-m.cfg.transactionTTL),
+			})
+		}
+	}
+	m.mempoolLock.RUnlock()
+
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+	if err := writeMempoolFile(f, entries); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return 0, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return 0, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// Load reads the transactions from a file written by Save and submits
+// them to the mempool. Each transaction is fully revalidated through
+// ProcessTransaction so transactions that are no longer valid, such as
+// those which were included in a block while the node was offline, are
+// discarded. Transactions which have been in the pool longer than the
+// configured TransactionTTL are also discarded. The remaining transactions
+// keep their original expiration.
+//
+// If the context is cancelled no more transactions are submitted and,
+// once those already submitted have been processed, the context's error
+// is returned along with the number accepted so far.
+//
+// It returns the number of transactions accepted into the mempool.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) Load(ctx context.Context, path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := readMempoolFile(f)
+	f.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		accepted int32
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, runtime.NumCPU())
+		now      = time.Now()
+	)
+loop:
+	for _, entry := range entries {
+		expiration := entry.added.Add(m.cfg.transactionTTL)
+		if now.After(expiration) {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		wg.Add(1)
+		go func(tx *transactions.Transaction, expiration time.Time) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := m.ProcessTransaction(tx); err != nil {
+				log.Debug("Discarding saved mempool transaction", log.ArgsFromMap(map[string]any{
+					"txid":  tx.ID().String(),
+					"error": err,
+				}))
+				return
+			}
+			m.setExpiration(tx.ID(), expiration)
+			atomic.AddInt32(&accepted, 1)
+		}(entry.tx, expiration)
+	}
+	wg.Wait()
+	return int(accepted), ctx.Err()
+}
+
+// setExpiration overrides the expiration of a transaction in the pool.
+func (m *Mempool) setExpiration(txid types.ID, expiration time.Time) {
+	m.mempoolLock.Lock()
+	defer m.mempoolLock.Unlock()
+
+	if ttx, ok := m.pool[txid]; ok {
+		ttx.expiration = expiration
+	} else if ttx, ok := m.conflicts[txid]; ok {
+		ttx.expiration = expiration
+	} else if ttx, ok := m.held[txid]; ok {
+		ttx.expiration = expiration
+	}
+}
+
+// writeMempoolFile serializes the entries to w. The format is the
+// file version followed by the number of entries, then for each entry
+// the unix time (in nanoseconds) it was added to the pool, the length
+// of the serialized transaction and the transaction itself. All integers
+// are big endian.
+func writeMempoolFile(w io.Writer, entries []*persistedTx) error {
+	bw := bufio.NewWriter(w)
+	if err := binary.Write(bw, binary.BigEndian, uint32(mempoolFileVersion)); err != nil {
+		return err
+	}
+	if err := binary.Write(bw, binary.BigEndian, uint32(len(entries))); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		ser, err := proto.Marshal(entry.tx)
+		if err != nil {
+			return err
+		}
+		if err := binary.Write(bw, binary.BigEndian, entry.added.UnixNano()); err != nil {
+			return err
+		}
+		if err := binary.Write(bw, binary.BigEndian, uint32(len(ser))); err != nil {
+			return err
+		}
+		if _, err := bw.Write(ser); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func readMempoolFile(r io.Reader) ([]*persistedTx, error) {
+	br := bufio.NewReader(r)
+	var version, count uint32
+	if err := binary.Read(br, binary.BigEndian, &version); err != nil {
+		return nil, err
+	}
+	if version != mempoolFileVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownFileVersion, version)
+	}
+	if err := binary.Read(br, binary.BigEndian, &count); err != nil {
+		return nil, err
+	}
+	var entries []*persistedTx
+	for i := uint32(0); i < count; i++ {
+		var (
+			added int64
+			size  uint32
+		)
+		if err := binary.Read(br, binary.BigEndian, &added); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(br, binary.BigEndian, &size); err != nil {
+			return nil, err
+		}
+		if size > maxPersistedTxSize {
+			return nil, fmt.Errorf("saved transaction size %d exceeds maximum", size)
+		}
+		ser := make([]byte, size)
+		if _, err := io.ReadFull(br, ser); err != nil {
+			return nil, err
+		}
+		tx := new(transactions.Transaction)
+		if err := proto.Unmarshal(ser, tx); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &persistedTx{
+			tx:    tx,
+			added: time.Unix(0, added),
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestMempoolPersistence(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+
+	tx1 := newTestTx(txoRoot, 20000, randomID())
+	tx2 := newTestTx(txoRoot, 20000, randomID())
+	tx3 := newTestTx(txoRoot, 20000, randomID())
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	assert.NoError(t, m.ProcessTransaction(tx3))
+
+	// tx2 has been in the pool longer than the TTL by the
+	// time it's loaded.
+	m.setExpiration(tx2.ID(), time.Now().Add(-time.Minute))
+	expiration := m.pool[tx1.ID()].expiration
+
+	filePath := path.Join(t.TempDir(), "mempool.dat")
+	n, err := m.Save(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// tx3 was spent while the node was offline.
+	for _, n := range tx3.Nullifiers() {
+		view.nullifiers[n] = true
+	}
+
+	m2 := newTestMempool(t, view)
+	n, err = m2.Load(context.Background(), filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	txs := m2.GetTransactions()
+	assert.Len(t, txs, 1)
+	assert.Contains(t, txs, tx1.ID())
+	assert.True(t, expiration.Equal(m2.pool[tx1.ID()].expiration))
+}
+
+func TestMempoolLoadCancelled(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+	assert.NoError(t, m.ProcessTransaction(newTestTx(txoRoot, 20000, randomID())))
+
+	filePath := path.Join(t.TempDir(), "mempool.dat")
+	_, err := m.Save(filePath)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	m2 := newTestMempool(t, view)
+	n, err := m2.Load(ctx, filePath)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, n)
+	assert.Empty(t, m2.GetTransactions())
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"context"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/project-illium/ilxd/mempool/pb"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// feeDelta is a delta set with PrioritiseTransaction and the time after
+// which it is removed if its transaction is not in the mempool.
+type feeDelta struct {
+	delta      int64
+	expiration time.Time
+}
+
+// PrioritiseTransaction adds delta to the fee of the transaction when it
+// is compared against other transactions. The modified fee is used for
+// the rolling minimum fee of a full mempool, for eviction, for replacements
+// and for ordering transactions for a block. The transaction itself is not
+// changed and the delta is not relayed to other nodes.
+//
+// The policy minimum fee is always checked against the unmodified fee.
+// A block containing a transaction paying less would not be acceptable
+// to the policy of other nodes.
+//
+// A positive delta can be used to move a stuck transaction ahead of others
+// and a negative delta to deprioritize one. Deltas accumulate across calls
+// and may be set for a transaction which is not yet in the mempool. The
+// delta is removed once the transaction is included in a block. Otherwise
+// it is removed once the transaction TTL has passed since it was last set
+// and the transaction is not in the mempool. It returns the transaction's
+// new total delta.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) PrioritiseTransaction(txid types.ID, delta int64) (int64, error) {
+	m.mempoolLock.Lock()
+	total := delta
+	if fd, ok := m.feeDeltas[txid]; ok {
+		total += fd.delta
+	}
+	if total == 0 {
+		delete(m.feeDeltas, txid)
+	} else {
+		m.feeDeltas[txid] = &feeDelta{
+			delta:      total,
+			expiration: time.Now().Add(m.cfg.transactionTTL),
+		}
+	}
+	for _, pool := range []map[types.ID]*ttlTx{m.pool, m.conflicts, m.held} {
+		if ttx, ok := pool[txid]; ok {
+			ttx.feeDelta = total
+		}
+	}
+	m.mempoolLock.Unlock()
+
+	log.Debug("Mempool transaction prioritised", log.ArgsFromMap(map[string]any{
+		"txid":      txid.String(),
+		"fee delta": total,
+	}))
+
+	if err := m.saveFeeDeltas(txid); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// FeeDelta returns the fee delta set for the transaction with
+// PrioritiseTransaction.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) FeeDelta(txid types.ID) int64 {
+	m.mempoolLock.RLock()
+	defer m.mempoolLock.RUnlock()
+
+	if fd, ok := m.feeDeltas[txid]; ok {
+		return fd.delta
+	}
+	return 0
+}
+
+// expireFeeDeltas removes the fee deltas which expired before now and
+// whose transactions are not in the mempool. This covers deltas set for
+// transactions which were never seen and those whose transactions left
+// the mempool without being included in a block.
+func (m *Mempool) expireFeeDeltas(now time.Time) {
+	m.mempoolLock.Lock()
+	var expired []types.ID
+	for txid, fd := range m.feeDeltas {
+		if !now.After(fd.expiration) {
+			continue
+		}
+		_, inPool := m.pool[txid]
+		_, inConflicts := m.conflicts[txid]
+		_, inHeld := m.held[txid]
+		if inPool || inConflicts || inHeld {
+			continue
+		}
+		delete(m.feeDeltas, txid)
+		expired = append(expired, txid)
+	}
+	m.mempoolLock.Unlock()
+
+	if len(expired) == 0 {
+		return
+	}
+	log.Debug("Expired mempool fee deltas", log.Args("count", len(expired)))
+	if err := m.saveFeeDeltas(expired...); err != nil {
+		log.WithCaller(true).Error("Error deleting mempool fee delta", log.Args("error", err))
+	}
+}
+
+// modifiedFee returns the fee of the transaction adjusted by its
+// fee delta.
+func (ttx *ttlTx) modifiedFee() types.Amount {
+	fee := int64(ttx.fee) + ttx.feeDelta
+	if fee < 0 {
+		return 0
+	}
+	return types.Amount(fee)
+}
+
+// modifiedFpkb returns the fee per kilobyte of the transaction
+// adjusted by its fee delta.
+func (ttx *ttlTx) modifiedFpkb() types.Amount {
+	if ttx.feeDelta == 0 || ttx.size == 0 {
+		return ttx.fpkb
+	}
+	return types.Amount(float64(ttx.modifiedFee()) / (float64(ttx.size) / 1000))
+}
+
+// saveFeeDeltas writes the current fee deltas of the transactions to
+// the datastore, deleting those which have been removed. The deltas are
+// read under the feeDeltaLock so the datastore is left matching the
+// mempool even if they are changed by concurrent callers.
+//
+// This method is safe for concurrent access but must not be called while
+// holding the mempoolLock.
+func (m *Mempool) saveFeeDeltas(txids ...types.ID) error {
+	if m.cfg.ds == nil {
+		return nil
+	}
+	m.feeDeltaLock.Lock()
+	defer m.feeDeltaLock.Unlock()
+
+	deltas := make(map[types.ID]feeDelta, len(txids))
+	m.mempoolLock.RLock()
+	for _, txid := range txids {
+		if fd, ok := m.feeDeltas[txid]; ok {
+			deltas[txid] = *fd
+		}
+	}
+	m.mempoolLock.RUnlock()
+
+	for _, txid := range txids {
+		key := datastore.NewKey(repo.FeeDeltaDatastoreKeyPrefix + txid.String())
+		fd, ok := deltas[txid]
+		if !ok {
+			if err := m.cfg.ds.Delete(context.Background(), key); err != nil {
+				return err
+			}
+			continue
+		}
+		ser, err := proto.Marshal(&pb.DBFeeDelta{
+			Delta:      fd.delta,
+			Expiration: timestamppb.New(fd.expiration),
+		})
+		if err != nil {
+			return err
+		}
+		if err := m.cfg.ds.Put(context.Background(), key, ser); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadFeeDeltas loads the persisted fee deltas from the datastore.
+func (m *Mempool) loadFeeDeltas() error {
+	if m.cfg.ds == nil {
+		return nil
+	}
+	results, err := m.cfg.ds.Query(context.Background(), query.Query{
+		Prefix: repo.FeeDeltaDatastoreKeyPrefix,
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		txid, err := types.NewIDFromString(strings.TrimPrefix(r.Key, repo.FeeDeltaDatastoreKeyPrefix))
+		if err != nil {
+			return err
+		}
+		var dbDelta pb.DBFeeDelta
+		if err := proto.Unmarshal(r.Value, &dbDelta); err != nil {
+			return err
+		}
+		// Deltas saved before they had an expiration are given
+		// a full TTL from now.
+		expiration := time.Now().Add(m.cfg.transactionTTL)
+		if dbDelta.Expiration != nil {
+			expiration = dbDelta.Expiration.AsTime()
+		}
+		m.feeDeltas[txid] = &feeDelta{
+			delta:      dbDelta.Delta,
+			expiration: expiration,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"errors"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMempoolPrioritiseTransaction(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	ds := mock.NewMapDatastore()
+	m := newTestMempool(t, view, Datastore(ds))
+
+	// A transaction paying less than the policy minimum is rejected
+	// even if it is prioritised. A block containing it would not be
+	// acceptable to the policy of other nodes.
+	tx0 := newTestTx(txoRoot, 1, randomID())
+	total, err := m.PrioritiseTransaction(tx0.ID(), 100000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100000), total)
+	err = m.ProcessTransaction(tx0)
+	var policyErr PolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrFeeTooLow), policyErr.ErrorCode)
+
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 20000, n1)
+	total, err = m.PrioritiseTransaction(tx1.ID(), 10000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10000), total)
+	total, err = m.PrioritiseTransaction(tx1.ID(), 20000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30000), total)
+
+	assert.NoError(t, m.ProcessTransaction(tx1))
+	entry, err := m.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(20000), entry.Fee)
+	assert.Equal(t, int64(30000), entry.FeeDelta)
+
+	descs := m.GetTxDescs()
+	assert.Len(t, descs, 1)
+	assert.Equal(t, entry.FeePerKilobyte, descs[0].FeePerKilobyte)
+	assert.Greater(t, descs[0].ModifiedFeePerKilobyte, descs[0].FeePerKilobyte)
+
+	// A replacement must beat the modified fee.
+	var ruleErr blockchain.RuleError
+	err = m.ProcessTransaction(newTestTx(txoRoot, 40000, n1))
+	assert.True(t, errors.As(err, &ruleErr))
+	assert.Equal(t, blockchain.ErrorCode(blockchain.ErrDoubleSpend), ruleErr.ErrorCode)
+
+	// The delta is reloaded from the datastore.
+	m2 := newTestMempool(t, view, Datastore(ds))
+	assert.Equal(t, int64(30000), m2.FeeDelta(tx1.ID()))
+
+	// The delta is removed once the transaction is in a block.
+	m.removeBlockTransactions([]*transactions.Transaction{tx1}, NTTransactionRemovedByBlock)
+	assert.Equal(t, int64(0), m.FeeDelta(tx1.ID()))
+
+	m3 := newTestMempool(t, view, Datastore(ds))
+	assert.Equal(t, int64(0), m3.FeeDelta(tx1.ID()))
+}
+
+func TestMempoolFeeDeltaExpiration(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	ds := mock.NewMapDatastore()
+	m := newTestMempool(t, view, Datastore(ds))
+
+	// One delta is set for a transaction that is never seen
+	// and one for a transaction in the pool.
+	unseen := randomID()
+	_, err := m.PrioritiseTransaction(unseen, 10000)
+	assert.NoError(t, err)
+
+	tx1 := newTestTx(txoRoot, 20000, randomID())
+	_, err = m.PrioritiseTransaction(tx1.ID(), 10000)
+	assert.NoError(t, err)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+
+	// Neither has expired yet.
+	m.expireFeeDeltas(time.Now())
+	assert.Equal(t, int64(10000), m.FeeDelta(unseen))
+	assert.Equal(t, int64(10000), m.FeeDelta(tx1.ID()))
+
+	// After the TTL the delta for the unseen transaction is removed
+	// while the one for the transaction in the pool is kept.
+	expired := time.Now().Add(m.cfg.transactionTTL + time.Minute)
+	m.expireFeeDeltas(expired)
+	assert.Equal(t, int64(0), m.FeeDelta(unseen))
+	assert.Equal(t, int64(10000), m.FeeDelta(tx1.ID()))
+
+	m2 := newTestMempool(t, view, Datastore(ds))
+	assert.Equal(t, int64(0), m2.FeeDelta(unseen))
+	assert.Equal(t, int64(10000), m2.FeeDelta(tx1.ID()))
+
+	// Once the transaction leaves the pool without being included
+	// in a block its delta is removed too.
+	m.removeBlockTransactions([]*transactions.Transaction{tx1}, NTTransactionExpired)
+	assert.Equal(t, int64(10000), m.FeeDelta(tx1.ID()))
+	m.expireFeeDeltas(expired)
+	assert.Equal(t, int64(0), m.FeeDelta(tx1.ID()))
+
+	m3 := newTestMempool(t, view, Datastore(ds))
+	assert.Equal(t, int64(0), m3.FeeDelta(tx1.ID()))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+)
+
+// maxReplacements is the maximum number of transactions a single
+// replacement transaction may evict from the pool.
+const maxReplacements = 100
+
+// checkReplacement checks whether a transaction which spends nullifiers
+// already spent by transactions in the pool may replace them. It returns
+// the transactions that would be replaced. If the mempool has a
+// ConflictHandler the replaced transactions are voted on against the
+// replacement rather than removed, with the replacement preferred.
+//
+// A replacement is allowed if:
+//   - It does not replace more than maxReplacements transactions.
+//   - It pays a higher fee per kilobyte than each transaction it replaces.
+//   - It pays a strictly higher absolute fee than the transactions it
+//     replaces combined.
+//   - The additional fee pays for the replacement's own bandwidth at the
+//     policy minimum fee per kilobyte. Without this rule an attacker could
+//     relay an endless series of replacements, each paying only one unit
+//     more than the last, for almost no cost.
+//
+// Fees are compared after applying any deltas set with PrioritiseTransaction.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) checkReplacement(ttx *ttlTx) ([]*ttlTx, error) {
+	if !ttx.isFeePayer {
+		return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool")
+	}
+
+	var (
+		replaced    []*ttlTx
+		seen        = make(map[types.ID]bool)
+		replacedFee types.Amount
+	)
+	for _, n := range ttx.tx.Nullifiers() {
+		for _, spendID := range m.spendsOf(n) {
+			if seen[spendID] {
+				continue
+			}
+			seen[spendID] = true
+
+			spend, ok := m.pool[spendID]
+			if !ok {
+				return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool and is contested")
+			}
+			if ttx.modifiedFpkb() <= spend.modifiedFpkb() {
+				return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool and replacement fee per kilobyte is not higher")
+			}
+			replaced = append(replaced, spend)
+			replacedFee += spend.modifiedFee()
+		}
+	}
+	if len(replaced) > maxReplacements {
+		return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool and replacement evicts too many transactions")
+	}
+	if ttx.modifiedFee() <= replacedFee {
+		return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool and replacement fee is not higher")
+	}
+	bandwidthFee := types.Amount(float64(m.cfg.fpkb) * float64(ttx.size) / 1000)
+	if ttx.modifiedFee()-replacedFee < bandwidthFee {
+		return nil, ruleError(blockchain.ErrDoubleSpend, "nullifier already in mempool and replacement fee does not pay for its bandwidth")
+	}
+	return replaced, nil
+}
+
+// removeReplaced removes the transactions replaced by txid from the pool
+// along with their nullifiers.
+//
+// This method is NOT safe for concurrent access.
+func (m *Mempool) removeReplaced(replaced []*ttlTx, txid types.ID) {
+	for _, ttx := range replaced {
+		m.evict(ttx, NTTransactionEvicted)
+		log.Debug("Mempool transaction replaced", log.ArgsFromMap(map[string]any{
+			"txid":        ttx.tx.ID().String(),
+			"replacement": txid.String(),
+		}))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"errors"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMempoolReplacement(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+
+	makeTx := func(fee uint64, proofLen int, nullifiers ...types.ID) *transactions.Transaction {
+		tx := newTestTx(txoRoot, fee, nullifiers...)
+		tx.GetStandardTransaction().Proof = make([]byte, proofLen)
+		return tx
+	}
+	assertDoubleSpend := func(err error) {
+		var ruleErr blockchain.RuleError
+		assert.True(t, errors.As(err, &ruleErr))
+		assert.Equal(t, blockchain.ErrorCode(blockchain.ErrDoubleSpend), ruleErr.ErrorCode)
+	}
+
+	n1, n2, n3 := randomID(), randomID(), randomID()
+
+	tx1 := makeTx(20000, 1000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx1))
+
+	// A higher fee which doesn't pay for the replacement's
+	// bandwidth is rejected.
+	assertDoubleSpend(m.ProcessTransaction(makeTx(25000, 1000, n1)))
+
+	// A higher absolute fee with a lower fee rate is rejected.
+	assertDoubleSpend(m.ProcessTransaction(makeTx(60000, 5000, n1)))
+
+	// A sufficient fee replaces the transaction.
+	tx2 := makeTx(40000, 1000, n1)
+	assert.NoError(t, m.ProcessTransaction(tx2))
+	_, err := m.GetTransaction(tx1.ID())
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = m.GetTransaction(tx2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, tx2.ID(), m.nullifiers[types.NewNullifier(n1.Bytes())])
+
+	size, err := tx2.SerializedSize()
+	assert.NoError(t, err)
+	assert.Equal(t, size, m.Size())
+
+	// A transaction spending a superset of the nullifiers
+	// replaces every transaction it conflicts with.
+	tx3 := makeTx(20000, 1000, n2)
+	assert.NoError(t, m.ProcessTransaction(tx3))
+	tx4 := makeTx(100000, 1000, n1, n2, n3)
+	assert.NoError(t, m.ProcessTransaction(tx4))
+
+	txs := m.GetTransactions()
+	assert.Len(t, txs, 1)
+	assert.Contains(t, txs, tx4.ID())
+	for _, n := range []types.ID{n1, n2, n3} {
+		assert.Equal(t, tx4.ID(), m.nullifiers[types.NewNullifier(n.Bytes())])
+	}
+}
+
+func TestMempoolReplacementWithConflictHandler(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	newMempool := func() (*Mempool, chan []*transactions.Transaction) {
+		contestedChan := make(chan []*transactions.Transaction, 1)
+		m := newTestMempool(t, view, ConflictHandler(func(txs []*transactions.Transaction) {
+			contestedChan <- txs
+		}))
+		return m, contestedChan
+	}
+
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 20000, n1)
+	tx2 := newTestTx(txoRoot, 100000, n1)
+
+	// A spend which pays enough to replace tx1 takes its place in the
+	// pool and is passed first as the preferred spend. tx1 is held out
+	// of the pool while they are voted on.
+	m1, contestedChan := newMempool()
+	assert.NoError(t, m1.ProcessTransaction(tx1))
+	assert.NoError(t, m1.ProcessTransaction(tx2))
+
+	contested := <-contestedChan
+	assert.Len(t, contested, 2)
+	assert.Equal(t, tx2.ID(), contested[0].ID())
+	assert.Equal(t, tx1.ID(), contested[1].ID())
+
+	entry, err := m1.GetEntry(tx2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryPending, entry.Status)
+	entry, err = m1.GetEntry(tx1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, EntryContested, entry.Status)
+	assert.Equal(t, tx2.ID(), m1.nullifiers[types.NewNullifier(n1.Bytes())])
+
+	// A node which sees the spends in the opposite order also prefers
+	// the replacement.
+	m2, contestedChan2 := newMempool()
+	assert.NoError(t, m2.ProcessTransaction(tx2))
+	assert.NoError(t, m2.ProcessTransaction(tx1))
+
+	contested = <-contestedChan2
+	assert.Len(t, contested, 2)
+	assert.Equal(t, tx2.ID(), contested[0].ID())
+	assert.Equal(t, tx1.ID(), contested[1].ID())
+
+	// Once the spends are being voted on a later spend is only held
+	// as a conflict, even if it pays more.
+	tx3 := newTestTx(txoRoot, 200000, n1)
+	assert.NoError(t, m1.ProcessTransaction(tx3))
+
+	contested = <-contestedChan
+	assert.Len(t, contested, 2)
+	assert.Equal(t, tx2.ID(), contested[0].ID())
+	assert.Equal(t, tx3.ID(), contested[1].ID())
+
+	m1.ConflictRejected(tx1.ID())
+	m1.ConflictRejected(tx3.ID())
+	m1.ConflictFinalized(tx2.ID())
+	assert.Eventually(t, func() bool {
+		_, err1 := m1.GetEntry(tx1.ID())
+		_, err3 := m1.GetEntry(tx3.ID())
+		return errors.Is(err1, ErrNotFound) && errors.Is(err3, ErrNotFound)
+	}, time.Second, time.Millisecond*10)
+
+	txs := m1.GetTransactions()
+	assert.Len(t, txs, 1)
+	assert.Contains(t, txs, tx2.ID())
+}
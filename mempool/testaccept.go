@@ -0,0 +1,51 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+)
+
+// TestResult holds the fee and size of a transaction checked with
+// TestTransaction.
+type TestResult struct {
+	Fee            types.Amount
+	FeePerKilobyte types.Amount
+	Size           int
+}
+
+// TestTransaction runs the same validation as ProcessTransaction,
+// including the proof and signature checks, but does not add the
+// transaction to the pool. The returned error is the RuleError or
+// PolicyError the transaction would be rejected with, if any.
+//
+// The result is returned whenever the fee and size could be computed,
+// even if the transaction is rejected.
+//
+// This method is safe for concurrent access.
+func (m *Mempool) TestTransaction(tx *transactions.Transaction) (*TestResult, error) {
+	result := &TestResult{}
+	if size, err := tx.SerializedSize(); err == nil {
+		result.Size = size
+	}
+	if fpkb, isFeePayer, err := CalcFeePerKilobyte(tx); err == nil && isFeePayer {
+		result.Fee = transactionFee(tx)
+		result.FeePerKilobyte = fpkb
+	}
+
+	ttx, err := m.checkTransaction(tx)
+	if err != nil {
+		return result, err
+	}
+
+	resultChan := make(chan error)
+	m.msgChan <- &validationReq{
+		ttx:        ttx,
+		dryRun:     true,
+		resultChan: resultChan,
+	}
+	return result, <-resultChan
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"errors"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMempoolTestTransaction(t *testing.T) {
+	view := newMockBlockchainView()
+	txoRoot := view.addTxoRoot()
+	m := newTestMempool(t, view)
+
+	n1 := randomID()
+	tx1 := newTestTx(txoRoot, 20000, n1)
+	size, err := tx1.SerializedSize()
+	assert.NoError(t, err)
+	fpkb, _, err := CalcFeePerKilobyte(tx1)
+	assert.NoError(t, err)
+
+	// A valid transaction passes but is not added to the pool.
+	result, err := m.TestTransaction(tx1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Amount(20000), result.Fee)
+	assert.Equal(t, fpkb, result.FeePerKilobyte)
+	assert.Equal(t, size, result.Size)
+	assert.Len(t, m.GetTransactions(), 0)
+	assert.Equal(t, 0, m.Size())
+
+	assert.NoError(t, m.ProcessTransaction(tx1))
+
+	// A duplicate is rejected.
+	_, err = m.TestTransaction(tx1)
+	assert.ErrorIs(t, err, ErrDuplicateTx)
+
+	// A double spend returns the rule error and leaves the
+	// original transaction in the pool.
+	result, err = m.TestTransaction(newTestTx(txoRoot, 25000, n1))
+	var ruleErr blockchain.RuleError
+	assert.True(t, errors.As(err, &ruleErr))
+	assert.Equal(t, blockchain.ErrorCode(blockchain.ErrDoubleSpend), ruleErr.ErrorCode)
+	assert.Equal(t, types.Amount(25000), result.Fee)
+
+	// A fee below the policy minimum returns the policy error.
+	_, err = m.TestTransaction(newTestTx(txoRoot, 1, randomID()))
+	var policyErr PolicyError
+	assert.True(t, errors.As(err, &policyErr))
+	assert.Equal(t, ErrorCode(ErrFeeTooLow), policyErr.ErrorCode)
+
+	// A valid replacement passes without replacing the original.
+	_, err = m.TestTransaction(newTestTx(txoRoot, 40000, n1))
+	assert.NoError(t, err)
+
+	txs := m.GetTransactions()
+	assert.Len(t, txs, 1)
+	assert.Contains(t, txs, tx1.ID())
+	assert.Equal(t, tx1.ID(), m.nullifiers[types.NewNullifier(n1.Bytes())])
+	assert.Equal(t, size, m.Size())
+}
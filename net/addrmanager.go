@@ -0,0 +1,655 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/project-illium/ilxd/net/pb"
+	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/repo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	mrand "math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// newBucketCount is the number of buckets in the new table.
+	newBucketCount = 256
+
+	// triedBucketCount is the number of buckets in the tried table.
+	triedBucketCount = 64
+
+	// bucketSize is the maximum number of addresses in each bucket.
+	bucketSize = 64
+
+	// newBucketsPerSourceGroup is the number of new buckets that
+	// addresses from a single source group can be placed in.
+	newBucketsPerSourceGroup = 32
+
+	// triedBucketsPerGroup is the number of tried buckets that
+	// addresses from a single group can be placed in.
+	triedBucketsPerGroup = 8
+
+	// addrTTL is how long we keep an address that we haven't seen.
+	addrTTL = time.Hour * 24 * 30
+
+	// numRetries is the number of failed attempts after which we
+	// consider a never successful address to be bad.
+	numRetries = 3
+
+	// maxFailures is the number of failed attempts after which we
+	// consider an address that hasn't succeeded in minBadDays to be bad.
+	maxFailures = 10
+
+	// minBadDays is the number of days since the last success
+	// before an address can be considered bad due to failures.
+	minBadDays = 7
+
+	// addrManagerSaveInterval is how often the address manager is
+	// persisted to the datastore.
+	addrManagerSaveInterval = time.Minute * 10
+
+	// maxSelectIterations bounds the number of random draws when
+	// selecting addresses.
+	maxSelectIterations = 2000
+
+	// dhtSourceGroups is the number of source groups that addresses
+	// learned from DHT queries are spread across.
+	dhtSourceGroups = 2
+
+	groupLocal   = "local"
+	groupRelay   = "relay"
+	groupUnknown = "unknown"
+)
+
+// knownAddress tracks the addresses for a peer along with the
+// metadata needed by the address manager.
+type knownAddress struct {
+	addrInfo    peer.AddrInfo
+	group       string
+	srcGroup    string
+	lastSeen    time.Time
+	lastAttempt time.Time
+	lastSuccess time.Time
+	attempts    uint32
+	tried       bool
+	bucket      int
+}
+
+// isBad returns whether the address is old enough or has failed
+// enough times that we should no longer keep it around.
+func (ka *knownAddress) isBad(now time.Time) bool {
+	// Never remove addresses that were tried in the last minute.
+	if now.Sub(ka.lastAttempt) < time.Minute {
+		return false
+	}
+	if ka.lastSeen.After(now.Add(time.Minute * 10)) {
+		return true
+	}
+	if now.Sub(ka.lastSeen) > addrTTL {
+		return true
+	}
+	if ka.lastSuccess.IsZero() && ka.attempts >= numRetries {
+		return true
+	}
+	if now.Sub(ka.lastSuccess) > minBadDays*time.Hour*24 && ka.attempts >= maxFailures {
+		return true
+	}
+	return false
+}
+
+// chance returns the relative probability that this address
+// should be selected.
+func (ka *knownAddress) chance(now time.Time) float64 {
+	c := 1.0
+	if now.Sub(ka.lastAttempt) < time.Minute*10 {
+		c *= 0.01
+	}
+	attempts := ka.attempts
+	if attempts > 8 {
+		attempts = 8
+	}
+	for i := uint32(0); i < attempts; i++ {
+		c *= 0.66
+	}
+	return c
+}
+
+// AddrManager is a peer address manager modeled after bitcoind's
+// addrman. Addresses which we have not yet successfully connected
+// to are kept in the new table, and addresses that we have connected
+// to are moved into the tried table.
+//
+// The bucket an address lands in is determined by a secret key, the
+// network group of the address, and the network group of the source
+// that told us about it. This limits the number of slots any single
+// IP range can occupy which makes it expensive for an attacker to
+// eclipse the node by flooding it with addresses.
+//
+// The address manager is persisted in the datastore so that we do
+// not have to rely exclusively on the seed addrs on startup.
+type AddrManager struct {
+	ds         repo.Datastore
+	key        [32]byte
+	addrIndex  map[peer.ID]*knownAddress
+	newTable   [newBucketCount]map[peer.ID]*knownAddress
+	triedTable [triedBucketCount]map[peer.ID]*knownAddress
+	nNew       int
+	nTried     int
+	mtx        sync.RWMutex
+	done       chan struct{}
+}
+
+// NewAddrManager returns a new AddrManager loaded from the datastore.
+func NewAddrManager(ds repo.Datastore) (*AddrManager, error) {
+	am := &AddrManager{
+		ds:        ds,
+		addrIndex: make(map[peer.ID]*knownAddress),
+		mtx:       sync.RWMutex{},
+		done:      make(chan struct{}),
+	}
+	for i := range am.newTable {
+		am.newTable[i] = make(map[peer.ID]*knownAddress)
+	}
+	for i := range am.triedTable {
+		am.triedTable[i] = make(map[peer.ID]*knownAddress)
+	}
+	if err := am.load(); err != nil {
+		return nil, err
+	}
+	if err := am.importCachedAddrInfos(); err != nil {
+		return nil, err
+	}
+	go am.run()
+	return am, nil
+}
+
+// AddAddrs adds the peer's addresses to the new table if the peer is
+// not already known. The source is the address of the peer that told
+// us about the addresses or nil if they came from ourselves.
+func (am *AddrManager) AddAddrs(ai peer.AddrInfo, source ma.Multiaddr) {
+	if len(ai.Addrs) == 0 {
+		return
+	}
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	srcGroup := groupLocal
+	if source != nil {
+		srcGroup = GroupKey(source)
+	}
+	am.addAddrs(ai, srcGroup, time.Now())
+}
+
+// AddDHTAddrs adds the addresses of a peer returned by a DHT query.
+// The DHT doesn't tell us which peer returned the addresses so they
+// are placed in one of a small number of DHT source groups, keyed by
+// the peer ID. This keeps them out of the local group while limiting
+// the share of the new table that DHT results can occupy.
+func (am *AddrManager) AddDHTAddrs(ai peer.AddrInfo) {
+	if len(ai.Addrs) == 0 {
+		return
+	}
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	srcGroup := "dht:" + strconv.FormatUint(am.keyedHash([]byte(ai.ID))%dhtSourceGroups, 10)
+	am.addAddrs(ai, srcGroup, time.Now())
+}
+
+// Attempt records that we attempted to connect to the peer.
+func (am *AddrManager) Attempt(p peer.ID) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	ka, ok := am.addrIndex[p]
+	if !ok {
+		return
+	}
+	ka.lastAttempt = time.Now()
+	ka.attempts++
+}
+
+// Good marks the peer as having been successfully connected to. This
+// moves the peer from the new table to the tried table.
+func (am *AddrManager) Good(p peer.ID) {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	ka, ok := am.addrIndex[p]
+	if !ok {
+		return
+	}
+	now := time.Now()
+	ka.lastSeen = now
+	ka.lastSuccess = now
+	ka.lastAttempt = now
+	ka.attempts = 0
+
+	if ka.tried {
+		return
+	}
+
+	delete(am.newTable[ka.bucket], p)
+	am.nNew--
+
+	bucket := am.triedBucket(ka)
+	if len(am.triedTable[bucket]) >= bucketSize {
+		// The bucket is full. Move the oldest entry back
+		// into the new table to make room.
+		var oldest *knownAddress
+		for _, e := range am.triedTable[bucket] {
+			if oldest == nil || e.lastSuccess.Before(oldest.lastSuccess) {
+				oldest = e
+			}
+		}
+		delete(am.triedTable[bucket], oldest.addrInfo.ID)
+		am.nTried--
+		oldest.tried = false
+		am.insertNew(oldest, now)
+	}
+	ka.tried = true
+	ka.bucket = bucket
+	am.triedTable[bucket][p] = ka
+	am.nTried++
+}
+
+// Select returns up to n randomly selected peers to dial. Tried
+// and new addresses are selected with equal probability and
+// addresses that have failed recent attempts are less likely to
+// be selected. The exclude function is called on each address
+// before it is selected and may be used to filter out peers that
+// are already connected or in the same network group as other
+// outbound peers.
+func (am *AddrManager) Select(n int, exclude func(p peer.ID, group string) bool) []peer.AddrInfo {
+	am.mtx.RLock()
+	defer am.mtx.RUnlock()
+
+	var (
+		ret      = make([]peer.AddrInfo, 0, n)
+		selected = make(map[peer.ID]bool)
+		now      = time.Now()
+		factor   = 1.0
+	)
+	for i := 0; i < maxSelectIterations && len(ret) < n; i++ {
+		if am.nNew+am.nTried == len(selected) {
+			break
+		}
+		var ka *knownAddress
+		if am.nTried > 0 && (am.nNew == 0 || mrand.Intn(2) == 0) {
+			ka = randomEntry(am.triedTable[:])
+		} else {
+			ka = randomEntry(am.newTable[:])
+		}
+		if ka == nil || selected[ka.addrInfo.ID] {
+			continue
+		}
+		if mrand.Float64() >= factor*ka.chance(now) {
+			factor *= 1.2
+			continue
+		}
+		selected[ka.addrInfo.ID] = true
+		factor = 1.0
+		if exclude != nil && exclude(ka.addrInfo.ID, ka.group) {
+			continue
+		}
+		ret = append(ret, ka.addrInfo)
+	}
+	return ret
+}
+
+// Size returns the number of addresses in the new and tried tables.
+func (am *AddrManager) Size() (int, int) {
+	am.mtx.RLock()
+	defer am.mtx.RUnlock()
+
+	return am.nNew, am.nTried
+}
+
+// Close saves the address manager to the datastore and shuts it down.
+func (am *AddrManager) Close() error {
+	close(am.done)
+	return am.save()
+}
+
+func (am *AddrManager) run() {
+	ticker := time.NewTicker(addrManagerSaveInterval)
+	for {
+		select {
+		case <-ticker.C:
+			if err := am.save(); err != nil {
+				log.WithCaller(true).Error("Error saving address manager", log.Args("error", err))
+			}
+		case <-am.done:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (am *AddrManager) addAddrs(ai peer.AddrInfo, srcGroup string, lastSeen time.Time) {
+	if ka, ok := am.addrIndex[ai.ID]; ok {
+		ka.addrInfo.Addrs = ai.Addrs
+		if lastSeen.After(ka.lastSeen) {
+			ka.lastSeen = lastSeen
+		}
+		return
+	}
+	ka := &knownAddress{
+		addrInfo: ai,
+		group:    peerGroupKey(ai),
+		srcGroup: srcGroup,
+		lastSeen: lastSeen,
+	}
+	am.insertNew(ka, time.Now())
+}
+
+// insertNew inserts the address into its new bucket evicting a
+// bad or the oldest address if the bucket is full.
+func (am *AddrManager) insertNew(ka *knownAddress, now time.Time) {
+	bucket := am.newBucket(ka)
+	if len(am.newTable[bucket]) >= bucketSize {
+		var evict *knownAddress
+		for _, e := range am.newTable[bucket] {
+			if e.isBad(now) {
+				evict = e
+				break
+			}
+			if evict == nil || e.lastSeen.Before(evict.lastSeen) {
+				evict = e
+			}
+		}
+		delete(am.newTable[bucket], evict.addrInfo.ID)
+		delete(am.addrIndex, evict.addrInfo.ID)
+		am.nNew--
+	}
+	ka.bucket = bucket
+	am.newTable[bucket][ka.addrInfo.ID] = ka
+	am.addrIndex[ka.addrInfo.ID] = ka
+	am.nNew++
+}
+
+func (am *AddrManager) newBucket(ka *knownAddress) int {
+	h1 := am.keyedHash([]byte(ka.group), []byte(ka.srcGroup)) % newBucketsPerSourceGroup
+	return int(am.keyedHash([]byte(ka.srcGroup), uint64Bytes(h1)) % newBucketCount)
+}
+
+func (am *AddrManager) triedBucket(ka *knownAddress) int {
+	h1 := am.keyedHash([]byte(ka.addrInfo.ID)) % triedBucketsPerGroup
+	return int(am.keyedHash([]byte(ka.group), uint64Bytes(h1)) % triedBucketCount)
+}
+
+func (am *AddrManager) keyedHash(data ...[]byte) uint64 {
+	b := make([]byte, 0, 32)
+	b = append(b, am.key[:]...)
+	for _, d := range data {
+		b = append(b, d...)
+	}
+	h := hash.HashFunc(b)
+	return binary.BigEndian.Uint64(h[:8])
+}
+
+// removeBad removes all bad addresses from the new table.
+func (am *AddrManager) removeBad() {
+	now := time.Now()
+	for _, bucket := range am.newTable {
+		for p, ka := range bucket {
+			if ka.isBad(now) {
+				delete(bucket, p)
+				delete(am.addrIndex, p)
+				am.nNew--
+			}
+		}
+	}
+}
+
+func (am *AddrManager) save() error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	am.removeBad()
+
+	dbam := &pb.DBAddrManager{
+		Key:   am.key[:],
+		Addrs: make([]*pb.DBKnownAddress, 0, len(am.addrIndex)),
+	}
+	for p, ka := range am.addrIndex {
+		pid, err := p.Marshal()
+		if err != nil {
+			return err
+		}
+		dbka := &pb.DBKnownAddress{
+			Peer_ID:     pid,
+			Addrs:       make([][]byte, 0, len(ka.addrInfo.Addrs)),
+			SourceGroup: ka.srcGroup,
+			LastSeen:    timestamppb.New(ka.lastSeen),
+			LastAttempt: timestamppb.New(ka.lastAttempt),
+			LastSuccess: timestamppb.New(ka.lastSuccess),
+			Attempts:    ka.attempts,
+			Tried:       ka.tried,
+		}
+		for _, addr := range ka.addrInfo.Addrs {
+			dbka.Addrs = append(dbka.Addrs, addr.Bytes())
+		}
+		dbam.Addrs = append(dbam.Addrs, dbka)
+	}
+	ser, err := proto.Marshal(dbam)
+	if err != nil {
+		return err
+	}
+	return am.ds.Put(context.Background(), datastore.NewKey(repo.AddrManagerDatastoreKey), ser)
+}
+
+func (am *AddrManager) load() error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	ser, err := am.ds.Get(context.Background(), datastore.NewKey(repo.AddrManagerDatastoreKey))
+	if errors.Is(err, datastore.ErrNotFound) {
+		_, err := rand.Read(am.key[:])
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	var dbam pb.DBAddrManager
+	if err := proto.Unmarshal(ser, &dbam); err != nil {
+		return err
+	}
+	copy(am.key[:], dbam.Key)
+
+	now := time.Now()
+	for _, dbka := range dbam.Addrs {
+		p, err := peer.IDFromBytes(dbka.Peer_ID)
+		if err != nil {
+			continue
+		}
+		ai := peer.AddrInfo{
+			ID:    p,
+			Addrs: make([]ma.Multiaddr, 0, len(dbka.Addrs)),
+		}
+		for _, b := range dbka.Addrs {
+			addr, err := ma.NewMultiaddrBytes(b)
+			if err != nil {
+				continue
+			}
+			ai.Addrs = append(ai.Addrs, addr)
+		}
+		if len(ai.Addrs) == 0 {
+			continue
+		}
+		ka := &knownAddress{
+			addrInfo:    ai,
+			group:       peerGroupKey(ai),
+			srcGroup:    dbka.SourceGroup,
+			lastSeen:    dbka.LastSeen.AsTime(),
+			lastAttempt: dbka.LastAttempt.AsTime(),
+			lastSuccess: dbka.LastSuccess.AsTime(),
+			attempts:    dbka.Attempts,
+		}
+		if ka.isBad(now) {
+			continue
+		}
+		if dbka.Tried {
+			bucket := am.triedBucket(ka)
+			if len(am.triedTable[bucket]) < bucketSize {
+				ka.tried = true
+				ka.bucket = bucket
+				am.triedTable[bucket][p] = ka
+				am.addrIndex[p] = ka
+				am.nTried++
+				continue
+			}
+		}
+		am.insertNew(ka, now)
+	}
+	return nil
+}
+
+// importCachedAddrInfos moves any addrinfos that were persisted
+// by older versions of the node into the new table.
+func (am *AddrManager) importCachedAddrInfos() error {
+	am.mtx.Lock()
+	defer am.mtx.Unlock()
+
+	results, err := am.ds.Query(context.Background(), query.Query{
+		Prefix: repo.CachedAddrInfoDatastoreKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	var toDelete []string
+	for r := range results.Next() {
+		toDelete = append(toDelete, r.Key)
+
+		var addrInfo pb.DBAddrInfo
+		if err := proto.Unmarshal(r.Value, &addrInfo); err != nil {
+			continue
+		}
+		s := strings.Split(r.Key, "/")
+		p, err := peer.Decode(s[len(s)-1])
+		if err != nil {
+			continue
+		}
+		ai := peer.AddrInfo{
+			ID:    p,
+			Addrs: make([]ma.Multiaddr, 0, len(addrInfo.Addrs)),
+		}
+		for _, b := range addrInfo.Addrs {
+			addr, err := ma.NewMultiaddrBytes(b)
+			if err != nil {
+				continue
+			}
+			ai.Addrs = append(ai.Addrs, addr)
+		}
+		if len(ai.Addrs) > 0 {
+			am.addAddrs(ai, groupLocal, addrInfo.LastSeen.AsTime())
+		}
+	}
+	results.Close()
+
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	batch, err := am.ds.Batch(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, key := range toDelete {
+		if err := batch.Delete(context.Background(), datastore.NewKey(key)); err != nil {
+			return err
+		}
+	}
+	return batch.Commit(context.Background())
+}
+
+// GroupKey returns the network group of the address. For IPv4
+// addresses this is the /16 and for IPv6 the /32. Non-routable
+// addresses all share the same local group and relay addresses
+// share the relay group.
+func GroupKey(addr ma.Multiaddr) string {
+	if _, err := addr.ValueForProtocol(ma.P_CIRCUIT); err == nil {
+		return groupRelay
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		for _, code := range []int{ma.P_DNS, ma.P_DNS4, ma.P_DNS6, ma.P_DNSADDR} {
+			if v, err := addr.ValueForProtocol(code); err == nil {
+				return "dns:" + v
+			}
+		}
+		return groupUnknown
+	}
+	if !manet.IsPublicAddr(addr) {
+		return groupLocal
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(16, 32)).String() + "/16"
+	}
+	return ip.Mask(net.CIDRMask(32, 128)).String() + "/32"
+}
+
+// peerGroupKey returns the network group of the peer's first
+// routable address.
+func peerGroupKey(ai peer.AddrInfo) string {
+	group := groupUnknown
+	for i, addr := range ai.Addrs {
+		g := GroupKey(addr)
+		if g != groupLocal && g != groupRelay && g != groupUnknown {
+			return g
+		}
+		if i == 0 {
+			group = g
+		}
+	}
+	return group
+}
+
+// randomEntry returns a random entry from the first non-empty
+// bucket starting at a random position in the table.
+func randomEntry(table []map[peer.ID]*knownAddress) *knownAddress {
+	var (
+		start  = mrand.Intn(len(table))
+		bucket map[peer.ID]*knownAddress
+	)
+	for i := 0; i < len(table); i++ {
+		bucket = table[(start+i)%len(table)]
+		if len(bucket) > 0 {
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		return nil
+	}
+	i := mrand.Intn(len(bucket))
+	for _, ka := range bucket {
+		if i == 0 {
+			return ka
+		}
+		i--
+	}
+	return nil
+}
+
+func uint64Bytes(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/peer"
+	pt "github.com/libp2p/go-libp2p/core/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/project-illium/ilxd/net/pb"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+)
+
+func randomPeer(t *testing.T, ip string, addrCount int) peer.AddrInfo {
+	var (
+		pid   peer.ID
+		err   error
+		addrs = make([]ma.Multiaddr, addrCount)
+		aFmt  = "/ip4/%s/tcp/%d"
+	)
+
+	t.Helper()
+	if pid, err = pt.RandPeerID(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < addrCount; i++ {
+		if addrs[i], err = ma.NewMultiaddr(fmt.Sprintf(aFmt, ip, 9000+i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return peer.AddrInfo{ID: pid, Addrs: addrs}
+}
+
+func TestGroupKey(t *testing.T) {
+	tests := []struct {
+		addr  string
+		group string
+	}{
+		{"/ip4/1.2.3.4/tcp/9001", "1.2.0.0/16"},
+		{"/ip4/1.2.200.4/udp/9001/quic-v1", "1.2.0.0/16"},
+		{"/ip4/1.3.3.4/tcp/9001", "1.3.0.0/16"},
+		{"/ip4/127.0.0.1/tcp/9001", groupLocal},
+		{"/ip4/192.168.1.1/tcp/9001", groupLocal},
+		{"/ip6/2001:db8:1234::1/tcp/9001", "2001:db8::/32"},
+		{"/dns4/seed.illium.org/tcp/9001", "dns:seed.illium.org"},
+		{"/ip4/1.2.3.4/tcp/9001/p2p/12D3KooWRMtSGTbMqxsWhLHE2mqMVoYiN4wVgmHmBwvZKUiMqeyN/p2p-circuit", groupRelay},
+	}
+	for _, test := range tests {
+		addr, err := ma.NewMultiaddr(test.addr)
+		assert.NoError(t, err)
+		assert.Equal(t, test.group, GroupKey(addr), test.addr)
+	}
+}
+
+func TestAddrManager(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	am, err := NewAddrManager(ds)
+	assert.NoError(t, err)
+
+	source, err := ma.NewMultiaddr("/ip4/5.6.7.8/tcp/9001")
+	assert.NoError(t, err)
+
+	peers := make(map[peer.ID]bool)
+	var addrInfos []peer.AddrInfo
+	for i := 0; i < 10; i++ {
+		ai := randomPeer(t, fmt.Sprintf("%d.1.1.1", i+1), 2)
+		peers[ai.ID] = true
+		addrInfos = append(addrInfos, ai)
+		am.AddAddrs(ai, source)
+	}
+	nNew, nTried := am.Size()
+	assert.Equal(t, 10, nNew)
+	assert.Equal(t, 0, nTried)
+
+	// Adding again should not create duplicates.
+	am.AddAddrs(addrInfos[0], source)
+	nNew, _ = am.Size()
+	assert.Equal(t, 10, nNew)
+
+	am.Good(addrInfos[0].ID)
+	nNew, nTried = am.Size()
+	assert.Equal(t, 9, nNew)
+	assert.Equal(t, 1, nTried)
+
+	selected := am.Select(5, nil)
+	assert.Len(t, selected, 5)
+	seen := make(map[peer.ID]bool)
+	for _, ai := range selected {
+		assert.True(t, peers[ai.ID])
+		assert.False(t, seen[ai.ID])
+		seen[ai.ID] = true
+	}
+
+	// Exclude everything but the tried peer.
+	selected = am.Select(5, func(p peer.ID, group string) bool {
+		return p != addrInfos[0].ID
+	})
+	assert.Len(t, selected, 1)
+	assert.Equal(t, addrInfos[0].ID, selected[0].ID)
+
+	// Reload from the datastore.
+	assert.NoError(t, am.Close())
+	am2, err := NewAddrManager(ds)
+	assert.NoError(t, err)
+	defer am2.Close()
+
+	assert.Equal(t, am.key, am2.key)
+	nNew, nTried = am2.Size()
+	assert.Equal(t, 9, nNew)
+	assert.Equal(t, 1, nTried)
+	assert.True(t, am2.addrIndex[addrInfos[0].ID].tried)
+	assert.Len(t, am2.addrIndex[addrInfos[1].ID].addrInfo.Addrs, 2)
+}
+
+func TestAddrManagerBucketing(t *testing.T) {
+	am, err := NewAddrManager(mock.NewMapDatastore())
+	assert.NoError(t, err)
+	defer am.Close()
+
+	// Addresses in the same group from the same source all land
+	// in the same new bucket so a single IP range can't fill the
+	// table.
+	source, err := ma.NewMultiaddr("/ip4/5.6.7.8/tcp/9001")
+	assert.NoError(t, err)
+	for i := 0; i < bucketSize*3; i++ {
+		am.AddAddrs(randomPeer(t, fmt.Sprintf("1.2.%d.%d", i/250, i%250+1), 1), source)
+	}
+	nNew, _ := am.Size()
+	assert.Equal(t, bucketSize, nNew)
+
+	// Addresses from different groups spread across buckets.
+	for i := 0; i < bucketSize; i++ {
+		am.AddAddrs(randomPeer(t, fmt.Sprintf("%d.2.3.4", i+10), 1), source)
+	}
+	nNew, _ = am.Size()
+	assert.Greater(t, nNew, bucketSize+bucketSize/2)
+
+	// Addresses we discovered ourselves share a single source group
+	// so no matter how many groups they span they are confined to
+	// newBucketsPerSourceGroup buckets.
+	am2, err := NewAddrManager(mock.NewMapDatastore())
+	assert.NoError(t, err)
+	defer am2.Close()
+
+	buckets := make(map[int]bool)
+	for i := 0; i < 250; i++ {
+		ai := randomPeer(t, fmt.Sprintf("%d.%d.3.4", i/5+1, i%5+1), 1)
+		am2.AddAddrs(ai, nil)
+		buckets[am2.addrIndex[ai.ID].bucket] = true
+	}
+	assert.LessOrEqual(t, len(buckets), newBucketsPerSourceGroup)
+
+	// DHT results should stay out of the local group and be limited
+	// to the buckets of the DHT source groups.
+	am3, err := NewAddrManager(mock.NewMapDatastore())
+	assert.NoError(t, err)
+	defer am3.Close()
+
+	buckets = make(map[int]bool)
+	for i := 0; i < 250; i++ {
+		ai := randomPeer(t, fmt.Sprintf("%d.%d.3.4", i/5+1, i%5+1), 1)
+		am3.AddDHTAddrs(ai)
+		assert.NotEqual(t, groupLocal, am3.addrIndex[ai.ID].srcGroup)
+		buckets[am3.addrIndex[ai.ID].bucket] = true
+	}
+	assert.LessOrEqual(t, len(buckets), newBucketsPerSourceGroup*dhtSourceGroups)
+}
+
+func TestAddrManagerImportCachedAddrInfos(t *testing.T) {
+	ds := mock.NewMapDatastore()
+
+	ai := randomPeer(t, "1.2.3.4", 2)
+	dbai := &pb.DBAddrInfo{
+		LastSeen: timestamppb.Now(),
+	}
+	for _, addr := range ai.Addrs {
+		dbai.Addrs = append(dbai.Addrs, addr.Bytes())
+	}
+	ser, err := proto.Marshal(dbai)
+	assert.NoError(t, err)
+	key := datastore.NewKey(repo.CachedAddrInfoDatastoreKey + ai.ID.String())
+	assert.NoError(t, ds.Put(context.Background(), key, ser))
+
+	am, err := NewAddrManager(ds)
+	assert.NoError(t, err)
+	defer am.Close()
+
+	nNew, _ := am.Size()
+	assert.Equal(t, 1, nNew)
+	assert.Len(t, am.addrIndex[ai.ID].addrInfo.Addrs, 2)
+
+	has, err := ds.Has(context.Background(), key)
+	assert.NoError(t, err)
+	assert.False(t, has)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"strings"
+)
+
+const (
+	// BandwidthCategoryBlocks is the bandwidth used by block
+	// messages on the pubsub block topic.
+	BandwidthCategoryBlocks = "blocks"
+	// BandwidthCategoryTransactions is the bandwidth used by
+	// transaction messages on the pubsub transaction topic and
+	// by the tx relay and mempool sync protocols.
+	BandwidthCategoryTransactions = "transactions"
+	// BandwidthCategoryPubsub is the total bandwidth used by the
+	// gossipsub protocol, including control messages.
+	BandwidthCategoryPubsub = "pubsub"
+	// BandwidthCategoryConsensus is the bandwidth used by the
+	// avalanche consensus protocol.
+	BandwidthCategoryConsensus = "consensus"
+	// BandwidthCategoryChainService is the bandwidth used by the
+	// chain service protocol.
+	BandwidthCategoryChainService = "chainservice"
+	// BandwidthCategoryDHT is the bandwidth used by the kademlia DHT.
+	BandwidthCategoryDHT = "dht"
+	// BandwidthCategoryOther is the bandwidth used by all other
+	// protocols (identify, ping, autonat, relay, etc).
+	BandwidthCategoryOther = "other"
+)
+
+// BandwidthCounter tracks the bytes sent and received by the node
+// in total, per peer, and per protocol category.
+//
+// libp2p only sees gossipsub as a single protocol, so the pubsub
+// topics are tracked separately by a pubsub tracer which logs the
+// size of each block and transaction message that is sent or received.
+type BandwidthCounter struct {
+	prefix protocol.ID
+	bwc    *metrics.BandwidthCounter
+	topics *metrics.BandwidthCounter
+}
+
+// NewBandwidthCounter returns a new BandwidthCounter for the
+// network using the provided protocol prefix.
+func NewBandwidthCounter(prefix protocol.ID) *BandwidthCounter {
+	return &BandwidthCounter{
+		prefix: prefix,
+		bwc:    metrics.NewBandwidthCounter(),
+		topics: metrics.NewBandwidthCounter(),
+	}
+}
+
+// Totals returns the total bandwidth stats across all peers
+// and protocols.
+func (b *BandwidthCounter) Totals() metrics.Stats {
+	return b.bwc.GetBandwidthTotals()
+}
+
+// ForPeer returns the bandwidth stats for the given peer
+// across all protocols.
+func (b *BandwidthCounter) ForPeer(p peer.ID) metrics.Stats {
+	return b.bwc.GetBandwidthForPeer(p)
+}
+
+// ByCategory returns the bandwidth stats for each protocol
+// category.
+func (b *BandwidthCounter) ByCategory() map[string]metrics.Stats {
+	ret := map[string]metrics.Stats{
+		BandwidthCategoryBlocks:       b.topics.GetBandwidthForProtocol(BlockTopic),
+		BandwidthCategoryTransactions: b.topics.GetBandwidthForProtocol(TransactionsTopic),
+		BandwidthCategoryPubsub:       {},
+		BandwidthCategoryConsensus:    {},
+		BandwidthCategoryChainService: {},
+		BandwidthCategoryDHT:          {},
+		BandwidthCategoryOther:        {},
+	}
+	for proto, stats := range b.bwc.GetBandwidthByProtocol() {
+		category := b.categorize(proto)
+		s := ret[category]
+		s.TotalIn += stats.TotalIn
+		s.TotalOut += stats.TotalOut
+		s.RateIn += stats.RateIn
+		s.RateOut += stats.RateOut
+		ret[category] = s
+	}
+	return ret
+}
+
+func (b *BandwidthCounter) categorize(proto protocol.ID) string {
+	s := string(proto)
+	if !strings.HasPrefix(s, string(b.prefix)) {
+		return BandwidthCategoryOther
+	}
+	s = strings.TrimPrefix(s, string(b.prefix))
+	switch {
+	case strings.HasPrefix(s, "/meshsub/"):
+		return BandwidthCategoryPubsub
+	case strings.HasPrefix(s, "/consensus/"):
+		return BandwidthCategoryConsensus
+	case strings.HasPrefix(s, TxRelayProtocol), strings.HasPrefix(s, MempoolSyncProtocol):
+		return BandwidthCategoryTransactions
+	case strings.HasPrefix(s, "/chainservice/"):
+		return BandwidthCategoryChainService
+	case strings.HasPrefix(s, "/kad/"):
+		return BandwidthCategoryDHT
+	default:
+		return BandwidthCategoryOther
+	}
+}
+
+// bandwidthTracer is a pubsub RawTracer which logs the size of
+// messages sent and received on each topic.
+type bandwidthTracer struct {
+	topics *metrics.BandwidthCounter
+}
+
+func (t *bandwidthTracer) logRecv(msg *pubsub.Message) {
+	if msg.Message == nil {
+		return
+	}
+	t.topics.LogRecvMessageStream(int64(msg.Size()), protocol.ID(msg.GetTopic()), msg.ReceivedFrom)
+}
+
+func (t *bandwidthTracer) ValidateMessage(msg *pubsub.Message)  { t.logRecv(msg) }
+func (t *bandwidthTracer) DuplicateMessage(msg *pubsub.Message) { t.logRecv(msg) }
+
+func (t *bandwidthTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
+	for _, msg := range rpc.GetPublish() {
+		t.topics.LogSentMessageStream(int64(msg.Size()), protocol.ID(msg.GetTopic()), p)
+	}
+}
+
+func (t *bandwidthTracer) AddPeer(p peer.ID, proto protocol.ID)             {}
+func (t *bandwidthTracer) RemovePeer(p peer.ID)                             {}
+func (t *bandwidthTracer) Join(topic string)                                {}
+func (t *bandwidthTracer) Leave(topic string)                               {}
+func (t *bandwidthTracer) Graft(p peer.ID, topic string)                    {}
+func (t *bandwidthTracer) Prune(p peer.ID, topic string)                    {}
+func (t *bandwidthTracer) DeliverMessage(msg *pubsub.Message)               {}
+func (t *bandwidthTracer) RejectMessage(msg *pubsub.Message, reason string) {}
+func (t *bandwidthTracer) ThrottlePeer(p peer.ID)                           {}
+func (t *bandwidthTracer) RecvRPC(rpc *pubsub.RPC)                          {}
+func (t *bandwidthTracer) DropRPC(rpc *pubsub.RPC, p peer.ID)               {}
+func (t *bandwidthTracer) UndeliverableMessage(msg *pubsub.Message)         {}
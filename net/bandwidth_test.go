@@ -0,0 +1,55 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBandwidthCounter(t *testing.T) {
+	prefix := protocol.ID("/ilx/regtest")
+	bwc := NewBandwidthCounter(prefix)
+	p := peer.ID("peer")
+
+	tests := []struct {
+		proto    protocol.ID
+		category string
+	}{
+		{prefix + "/meshsub/1.1.0", BandwidthCategoryPubsub},
+		{prefix + "/consensus/1.0.0", BandwidthCategoryConsensus},
+		{prefix + "/chainservice/1.0.0", BandwidthCategoryChainService},
+		{prefix + "/kad/1.0.0", BandwidthCategoryDHT},
+		{"/ipfs/id/1.0.0", BandwidthCategoryOther},
+		{"/ilx/mainnet/consensus/1.0.0", BandwidthCategoryOther},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.category, bwc.categorize(test.proto))
+		bwc.bwc.LogRecvMessageStream(100, test.proto, p)
+		bwc.bwc.LogSentMessageStream(50, test.proto, p)
+	}
+
+	tracer := &bandwidthTracer{topics: bwc.topics}
+	tracer.topics.LogRecvMessageStream(10, BlockTopic, p)
+	tracer.topics.LogSentMessageStream(20, TransactionsTopic, p)
+
+	// The meters are updated asynchronously by a sweeper
+	// so we need to wait for the totals to show up.
+	assert.Eventually(t, func() bool {
+		byCategory := bwc.ByCategory()
+		stats := bwc.ForPeer(p)
+		return len(byCategory) == 7 &&
+			byCategory[BandwidthCategoryConsensus].TotalIn == 100 &&
+			byCategory[BandwidthCategoryConsensus].TotalOut == 50 &&
+			byCategory[BandwidthCategoryOther].TotalIn == 200 &&
+			byCategory[BandwidthCategoryBlocks].TotalIn == 10 &&
+			byCategory[BandwidthCategoryTransactions].TotalOut == 20 &&
+			stats.TotalIn == 600 &&
+			stats.TotalOut == 300
+	}, time.Second*10, time.Millisecond*100)
+}
@@ -7,9 +7,13 @@ package net
 import (
 	"context"
 	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/project-illium/ilxd/net/pb"
 	"github.com/project-illium/ilxd/repo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"net"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,40 +29,90 @@ import (
 	"github.com/ipfs/go-datastore/query"
 )
 
+const (
+	// BanReasonBanscore is the reason recorded for peers banned
+	// for exceeding the max banscore.
+	BanReasonBanscore = "banscore exceeded"
+	// BanReasonManual is the reason recorded for bans that were
+	// created without a reason.
+	BanReasonManual = "manually banned"
+)
+
+// Ban describes an active ban.
+type Ban struct {
+	// Peer is set for peer bans.
+	Peer peer.ID
+	// Subnet is set for IP address and subnet bans. Banned
+	// IP addresses have a full length mask.
+	Subnet *net.IPNet
+	// Reason is the reason the ban was created.
+	Reason string
+	// Expiration is when the ban will be lifted.
+	Expiration time.Time
+}
+
+type banInfo struct {
+	expiration time.Time
+	reason     string
+}
+
+type subnetBan struct {
+	ipnet *net.IPNet
+	banInfo
+	timer *time.Timer
+}
+
 // ConnectionGater implements a connection gater that allows the application to perform
 // access control on incoming and outgoing connections. The difference between this
 // and the libp2p BasicConnectionGater is this class provides a method for incrementally
 // increasing a peer's banscore and bans them only for a specified duration.
+//
+// Peers and subnets may also be added to an allowlist. Allowlisted peers bypass all
+// bans and are exempt from banscore. This is intended for our own sentry nodes.
 type ConnectionGater struct {
 	sync.RWMutex
 
 	scores map[peer.ID]*DynamicBanScore
 
-	blockedPeers map[peer.ID]time.Time
-	blockedAddrs map[string]time.Time
+	blockedPeers   map[peer.ID]banInfo
+	blockedAddrs   map[string]banInfo
+	blockedSubnets map[string]*subnetBan
+
+	allowedPeers   map[peer.ID]struct{}
+	allowedSubnets map[string]*net.IPNet
 
 	banDuration time.Duration
 	maxBanscore uint32
 
 	ds       repo.Datastore
 	addrBook peerstore.AddrBook
+
+	// network is used to look up the remote addresses of a peer's
+	// live connections when checking the subnet allowlist.
+	network network.Network
 }
 
 const (
-	keyPeer = "peer/"
-	keyAddr = "addr/"
+	keyPeer        = "peer/"
+	keyAddr        = "addr/"
+	keySubnet      = "subnet/"
+	keyAllowPeer   = "allow/peer/"
+	keyAllowSubnet = "allow/subnet/"
 )
 
 // NewConnectionGater creates a new connection gater.
 func NewConnectionGater(ds repo.Datastore, addrBook peerstore.AddrBook, banDuration time.Duration, maxBanscore uint32) (*ConnectionGater, error) {
 	cg := &ConnectionGater{
-		blockedPeers: make(map[peer.ID]time.Time),
-		blockedAddrs: make(map[string]time.Time),
-		scores:       make(map[peer.ID]*DynamicBanScore),
-		banDuration:  banDuration,
-		maxBanscore:  maxBanscore,
-		addrBook:     addrBook,
-		ds:           ds,
+		blockedPeers:   make(map[peer.ID]banInfo),
+		blockedAddrs:   make(map[string]banInfo),
+		blockedSubnets: make(map[string]*subnetBan),
+		allowedPeers:   make(map[peer.ID]struct{}),
+		allowedSubnets: make(map[string]*net.IPNet),
+		scores:         make(map[peer.ID]*DynamicBanScore),
+		banDuration:    banDuration,
+		maxBanscore:    maxBanscore,
+		addrBook:       addrBook,
+		ds:             ds,
 	}
 
 	err := cg.loadRules(context.Background())
@@ -86,12 +140,12 @@ func (cg *ConnectionGater) loadRules(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		t := time.Time{}
-		if err = t.GobDecode(r.Entry.Value); err != nil {
+		info, err := decodeBanInfo(r.Entry.Value)
+		if err != nil {
 			return err
 		}
-		cg.blockedPeers[p] = t
-		time.AfterFunc(time.Until(t), func() {
+		cg.blockedPeers[p] = info
+		time.AfterFunc(time.Until(info.expiration), func() {
 			if err := cg.UnblockPeer(p); err != nil {
 				log.WithCaller(true).Error("Error unblocking peer after expiration", log.Args("error", err))
 			}
@@ -110,18 +164,78 @@ func (cg *ConnectionGater) loadRules(ctx context.Context) error {
 		}
 
 		ip := net.ParseIP(path.Base(r.Key))
-		t := time.Time{}
-		if err = t.GobDecode(r.Entry.Value); err != nil {
+		info, err := decodeBanInfo(r.Entry.Value)
+		if err != nil {
 			return err
 		}
-		cg.blockedAddrs[ip.String()] = t
-		time.AfterFunc(time.Until(t), func() {
+		cg.blockedAddrs[ip.String()] = info
+		time.AfterFunc(time.Until(info.expiration), func() {
 			if err := cg.UnblockAddr(ip); err != nil {
 				log.WithCaller(true).Error("Error unblocking peer after expiration", log.Args("error", err))
 			}
 		})
 	}
 
+	// load blocked subnets
+	res, err = cg.ds.Query(ctx, query.Query{Prefix: repo.ConnGaterKeyPrefix + keySubnet})
+	if err != nil {
+		return err
+	}
+
+	for r := range res.Next() {
+		if r.Error != nil {
+			return err
+		}
+
+		_, ipnet, err := net.ParseCIDR(strings.TrimPrefix(r.Key, repo.ConnGaterKeyPrefix+keySubnet))
+		if err != nil {
+			return err
+		}
+		info, err := decodeBanInfo(r.Entry.Value)
+		if err != nil {
+			return err
+		}
+		cg.Lock()
+		cg.setSubnetBan(ipnet, info)
+		cg.Unlock()
+	}
+
+	// load allowed peers
+	res, err = cg.ds.Query(ctx, query.Query{Prefix: repo.ConnGaterKeyPrefix + keyAllowPeer})
+	if err != nil {
+		return err
+	}
+
+	for r := range res.Next() {
+		if r.Error != nil {
+			return err
+		}
+
+		p, err := peer.Decode(path.Base(r.Key))
+		if err != nil {
+			return err
+		}
+		cg.allowedPeers[p] = struct{}{}
+	}
+
+	// load allowed subnets
+	res, err = cg.ds.Query(ctx, query.Query{Prefix: repo.ConnGaterKeyPrefix + keyAllowSubnet})
+	if err != nil {
+		return err
+	}
+
+	for r := range res.Next() {
+		if r.Error != nil {
+			return err
+		}
+
+		_, ipnet, err := net.ParseCIDR(strings.TrimPrefix(r.Key, repo.ConnGaterKeyPrefix+keyAllowSubnet))
+		if err != nil {
+			return err
+		}
+		cg.allowedSubnets[ipnet.String()] = ipnet
+	}
+
 	return nil
 }
 
@@ -130,8 +244,16 @@ func (cg *ConnectionGater) loadRules(ctx context.Context) error {
 //
 // If persistent is non-zero the persistent score will never decrease. The transient score does
 // decrease according to a decay function.
+//
+// Allowlisted peers are exempt from banscore.
 func (cg *ConnectionGater) IncreaseBanscore(p peer.ID, persistent, transient uint32) (bool, error) {
-	if _, ok := cg.blockedPeers[p]; ok || cg.banDuration == 0 {
+	if cg.IsPeerAllowed(p) {
+		return false, nil
+	}
+	cg.RLock()
+	_, ok := cg.blockedPeers[p]
+	cg.RUnlock()
+	if ok || cg.banDuration == 0 {
 		return false, nil
 	}
 	cg.Lock()
@@ -153,7 +275,7 @@ func (cg *ConnectionGater) IncreaseBanscore(p peer.ID, persistent, transient uin
 			"peer":     p,
 			"duration": cg.banDuration,
 		}))
-		if err := cg.BlockPeer(p); err != nil {
+		if err := cg.blockPeer(p, BanReasonBanscore); err != nil {
 			return false, err
 		}
 
@@ -164,12 +286,14 @@ func (cg *ConnectionGater) IncreaseBanscore(p peer.ID, persistent, transient uin
 				if ip.IsLoopback() {
 					continue
 				}
-				if err := cg.BlockAddr(ip); err != nil {
+				if err := cg.blockAddr(ip, BanReasonBanscore); err != nil {
 					return false, err
 				}
 			}
 		}
+		cg.Lock()
 		delete(cg.scores, p)
+		cg.Unlock()
 	}
 	cg.Lock()
 	defer cg.Unlock()
@@ -184,8 +308,15 @@ func (cg *ConnectionGater) IncreaseBanscore(p peer.ID, persistent, transient uin
 // BlockPeer adds a peer to the set of blocked peers.
 // Note: active connections to the peer are not automatically closed.
 func (cg *ConnectionGater) BlockPeer(p peer.ID) error {
-	banExpiration := time.Now().Add(cg.banDuration)
-	b, err := banExpiration.GobEncode()
+	return cg.blockPeer(p, BanReasonManual)
+}
+
+func (cg *ConnectionGater) blockPeer(p peer.ID, reason string) error {
+	info := banInfo{
+		expiration: time.Now().Add(cg.banDuration),
+		reason:     reason,
+	}
+	b, err := encodeBanInfo(info)
 	if err != nil {
 		return err
 	}
@@ -199,7 +330,7 @@ func (cg *ConnectionGater) BlockPeer(p peer.ID) error {
 
 	cg.Lock()
 	defer cg.Unlock()
-	cg.blockedPeers[p] = banExpiration
+	cg.blockedPeers[p] = info
 
 	time.AfterFunc(cg.banDuration, func() {
 		if err := cg.UnblockPeer(p); err != nil {
@@ -257,8 +388,15 @@ func (cg *ConnectionGater) ListBlockedPeers() []peer.ID {
 // BlockAddr adds an IP address to the set of blocked addresses.
 // Note: active connections to the IP address are not automatically closed.
 func (cg *ConnectionGater) BlockAddr(ip net.IP) error {
-	banExpiration := time.Now().Add(cg.banDuration)
-	b, err := banExpiration.GobEncode()
+	return cg.blockAddr(ip, BanReasonManual)
+}
+
+func (cg *ConnectionGater) blockAddr(ip net.IP, reason string) error {
+	info := banInfo{
+		expiration: time.Now().Add(cg.banDuration),
+		reason:     reason,
+	}
+	b, err := encodeBanInfo(info)
 	if err != nil {
 		return err
 	}
@@ -273,7 +411,7 @@ func (cg *ConnectionGater) BlockAddr(ip net.IP) error {
 	cg.Lock()
 	defer cg.Unlock()
 
-	cg.blockedAddrs[ip.String()] = banExpiration
+	cg.blockedAddrs[ip.String()] = info
 	time.AfterFunc(cg.banDuration, func() {
 		if err := cg.UnblockAddr(ip); err != nil {
 			log.WithCaller(true).Error("Error unblocking ip addr after expiration", log.Args("error", err))
@@ -315,6 +453,317 @@ func (cg *ConnectionGater) ListBlockedAddrs() []net.IP {
 	return result
 }
 
+// BlockSubnet adds a subnet to the set of blocked subnets for the given
+// duration. If the duration is zero the default ban duration is used.
+// Note: active connections to IP addresses in the subnet are not automatically
+// closed.
+func (cg *ConnectionGater) BlockSubnet(ipnet *net.IPNet, duration time.Duration, reason string) error {
+	if duration == 0 {
+		duration = cg.banDuration
+	}
+	if reason == "" {
+		reason = BanReasonManual
+	}
+	info := banInfo{
+		expiration: time.Now().Add(duration),
+		reason:     reason,
+	}
+	b, err := encodeBanInfo(info)
+	if err != nil {
+		return err
+	}
+
+	// The lock is held while writing to the datastore so that an
+	// expiring ban for the subnet cannot delete the new entry.
+	cg.Lock()
+	defer cg.Unlock()
+
+	if cg.ds != nil {
+		err := cg.ds.Put(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keySubnet+ipnet.String()), b)
+		if err != nil {
+			log.WithCaller(true).Error("Error writing blocked subnet to datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	cg.setSubnetBan(ipnet, info)
+	return nil
+}
+
+// setSubnetBan records the subnet ban and schedules it to be lifted when
+// it expires. If the subnet is already banned the existing ban, and its
+// timer, are replaced so that an earlier expiration cannot lift the new
+// ban. The caller must hold the lock.
+func (cg *ConnectionGater) setSubnetBan(ipnet *net.IPNet, info banInfo) {
+	if prev, ok := cg.blockedSubnets[ipnet.String()]; ok {
+		prev.timer.Stop()
+	}
+	ban := &subnetBan{ipnet: ipnet, banInfo: info}
+	ban.timer = time.AfterFunc(time.Until(info.expiration), func() {
+		cg.Lock()
+		defer cg.Unlock()
+
+		// The subnet may have been banned again after this timer fired
+		// but before it took the lock.
+		if cg.blockedSubnets[ipnet.String()] != ban {
+			return
+		}
+		if err := cg.unblockSubnet(ipnet); err != nil {
+			log.WithCaller(true).Error("Error unblocking subnet after expiration", log.Args("error", err))
+		}
+	})
+	cg.blockedSubnets[ipnet.String()] = ban
+}
+
+// UnblockSubnet removes a subnet from the set of blocked subnets
+func (cg *ConnectionGater) UnblockSubnet(ipnet *net.IPNet) error {
+	cg.Lock()
+	defer cg.Unlock()
+
+	return cg.unblockSubnet(ipnet)
+}
+
+// unblockSubnet is the implementation of UnblockSubnet. The caller
+// must hold the lock.
+func (cg *ConnectionGater) unblockSubnet(ipnet *net.IPNet) error {
+	if cg.ds != nil {
+		err := cg.ds.Delete(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keySubnet+ipnet.String()))
+		if err != nil {
+			log.WithCaller(true).Error("Error deleting blocked subnet from datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	if ban, ok := cg.blockedSubnets[ipnet.String()]; ok {
+		ban.timer.Stop()
+		delete(cg.blockedSubnets, ipnet.String())
+	}
+	return nil
+}
+
+// ListBlockedSubnets return a list of blocked subnets
+func (cg *ConnectionGater) ListBlockedSubnets() []*net.IPNet {
+	cg.RLock()
+	defer cg.RUnlock()
+
+	result := make([]*net.IPNet, 0, len(cg.blockedSubnets))
+	for _, ban := range cg.blockedSubnets {
+		result = append(result, ban.ipnet)
+	}
+
+	return result
+}
+
+// ListBans returns all active peer, IP address, and subnet bans
+// along with the reason and expiration of each.
+func (cg *ConnectionGater) ListBans() []Ban {
+	cg.RLock()
+	defer cg.RUnlock()
+
+	result := make([]Ban, 0, len(cg.blockedPeers)+len(cg.blockedAddrs)+len(cg.blockedSubnets))
+	for p, info := range cg.blockedPeers {
+		result = append(result, Ban{
+			Peer:       p,
+			Reason:     info.reason,
+			Expiration: info.expiration,
+		})
+	}
+	for ipStr, info := range cg.blockedAddrs {
+		ip := net.ParseIP(ipStr)
+		bits := net.IPv6len * 8
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = net.IPv4len * 8
+		}
+		result = append(result, Ban{
+			Subnet:     &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)},
+			Reason:     info.reason,
+			Expiration: info.expiration,
+		})
+	}
+	for _, ban := range cg.blockedSubnets {
+		result = append(result, Ban{
+			Subnet:     ban.ipnet,
+			Reason:     ban.reason,
+			Expiration: ban.expiration,
+		})
+	}
+
+	return result
+}
+
+// AllowPeer adds a peer to the allowlist. Allowlisted peers bypass
+// all bans and are exempt from banscore.
+func (cg *ConnectionGater) AllowPeer(p peer.ID) error {
+	if cg.ds != nil {
+		err := cg.ds.Put(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keyAllowPeer+p.String()), []byte{})
+		if err != nil {
+			log.WithCaller(true).Error("Error writing allowed peer to datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	cg.Lock()
+	defer cg.Unlock()
+
+	cg.allowedPeers[p] = struct{}{}
+	delete(cg.scores, p)
+
+	return nil
+}
+
+// RemoveAllowedPeer removes a peer from the allowlist.
+func (cg *ConnectionGater) RemoveAllowedPeer(p peer.ID) error {
+	if cg.ds != nil {
+		err := cg.ds.Delete(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keyAllowPeer+p.String()))
+		if err != nil {
+			log.WithCaller(true).Error("Error deleting allowed peer from datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	cg.Lock()
+	defer cg.Unlock()
+
+	delete(cg.allowedPeers, p)
+
+	return nil
+}
+
+// AllowSubnet adds a subnet to the allowlist. Peers connecting from
+// IP addresses in the subnet bypass all bans and are exempt from
+// banscore.
+func (cg *ConnectionGater) AllowSubnet(ipnet *net.IPNet) error {
+	if cg.ds != nil {
+		err := cg.ds.Put(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keyAllowSubnet+ipnet.String()), []byte{})
+		if err != nil {
+			log.WithCaller(true).Error("Error writing allowed subnet to datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	cg.Lock()
+	defer cg.Unlock()
+
+	cg.allowedSubnets[ipnet.String()] = ipnet
+
+	return nil
+}
+
+// RemoveAllowedSubnet removes a subnet from the allowlist.
+func (cg *ConnectionGater) RemoveAllowedSubnet(ipnet *net.IPNet) error {
+	if cg.ds != nil {
+		err := cg.ds.Delete(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keyAllowSubnet+ipnet.String()))
+		if err != nil {
+			log.WithCaller(true).Error("Error deleting allowed subnet from datastore", log.Args("error", err))
+			return err
+		}
+	}
+
+	cg.Lock()
+	defer cg.Unlock()
+
+	delete(cg.allowedSubnets, ipnet.String())
+
+	return nil
+}
+
+// ListAllowlist returns the allowlisted peers and subnets.
+func (cg *ConnectionGater) ListAllowlist() ([]peer.ID, []*net.IPNet) {
+	cg.RLock()
+	defer cg.RUnlock()
+
+	peers := make([]peer.ID, 0, len(cg.allowedPeers))
+	for p := range cg.allowedPeers {
+		peers = append(peers, p)
+	}
+	subnets := make([]*net.IPNet, 0, len(cg.allowedSubnets))
+	for _, ipnet := range cg.allowedSubnets {
+		subnets = append(subnets, ipnet)
+	}
+
+	return peers, subnets
+}
+
+// setNetwork sets the network used to look up a peer's live connections.
+// Until it is set peers are only allowlisted by their peer ID.
+func (cg *ConnectionGater) setNetwork(n network.Network) {
+	cg.Lock()
+	defer cg.Unlock()
+
+	cg.network = n
+}
+
+// IsPeerAllowed returns whether the peer is on the allowlist either
+// by its peer ID or by the remote address of one of its live connections.
+//
+// The addresses in the peerstore are not used as a peer may advertise
+// any address it wants.
+func (cg *ConnectionGater) IsPeerAllowed(p peer.ID) bool {
+	cg.RLock()
+	_, ok := cg.allowedPeers[p]
+	n := cg.network
+	cg.RUnlock()
+	if ok {
+		return true
+	}
+	if n == nil {
+		return false
+	}
+	for _, conn := range n.ConnsToPeer(p) {
+		if cg.IsAddrAllowed(conn.RemoteMultiaddr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAddrAllowed returns whether the multiaddr is in an allowlisted subnet.
+func (cg *ConnectionGater) IsAddrAllowed(addr ma.Multiaddr) bool {
+	if addr == nil {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+
+	cg.RLock()
+	defer cg.RUnlock()
+
+	for _, ipnet := range cg.allowedSubnets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIPBlocked returns whether the IP address is blocked either directly
+// or as part of a subnet. The caller must hold the lock.
+func (cg *ConnectionGater) isIPBlocked(ip net.IP) bool {
+	if _, ok := cg.blockedAddrs[ip.String()]; ok {
+		return true
+	}
+	for _, ban := range cg.blockedSubnets {
+		if ban.ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIPAllowed returns whether the IP address is in an allowlisted
+// subnet. The caller must hold the lock.
+func (cg *ConnectionGater) isIPAllowed(ip net.IP) bool {
+	for _, ipnet := range cg.allowedSubnets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // ConnectionGater interface
 var _ connmgr.ConnectionGater = (*ConnectionGater)(nil)
 
@@ -322,6 +771,10 @@ func (cg *ConnectionGater) InterceptPeerDial(p peer.ID) (allow bool) {
 	cg.RLock()
 	defer cg.RUnlock()
 
+	if _, ok := cg.allowedPeers[p]; ok {
+		return true
+	}
+
 	_, block := cg.blockedPeers[p]
 	return !block
 }
@@ -331,14 +784,20 @@ func (cg *ConnectionGater) InterceptAddrDial(p peer.ID, a ma.Multiaddr) (allow b
 	cg.RLock()
 	defer cg.RUnlock()
 
+	if _, ok := cg.allowedPeers[p]; ok {
+		return true
+	}
+
 	ip, err := manet.ToIP(a)
 	if err != nil {
 		log.WithCaller(true).Error("Error converting multiaddr to ip addr", log.Args("error", err))
 		return true
 	}
 
-	_, block := cg.blockedAddrs[ip.String()]
-	return !block
+	if cg.isIPAllowed(ip) {
+		return true
+	}
+	return !cg.isIPBlocked(ip)
 }
 
 func (cg *ConnectionGater) InterceptAccept(cma network.ConnMultiaddrs) (allow bool) {
@@ -353,8 +812,13 @@ func (cg *ConnectionGater) InterceptAccept(cma network.ConnMultiaddrs) (allow bo
 		return true
 	}
 
-	_, block := cg.blockedAddrs[ip.String()]
-	return !block
+	// Allowlisted peer IDs are not known until the connection is secured
+	// so we let the connection through here and check the IP again in
+	// InterceptSecured.
+	if len(cg.allowedPeers) > 0 || cg.isIPAllowed(ip) {
+		return true
+	}
+	return !cg.isIPBlocked(ip)
 }
 
 func (cg *ConnectionGater) InterceptSecured(dir network.Direction, p peer.ID, cma network.ConnMultiaddrs) (allow bool) {
@@ -367,6 +831,17 @@ func (cg *ConnectionGater) InterceptSecured(dir network.Direction, p peer.ID, cm
 	cg.RLock()
 	defer cg.RUnlock()
 
+	if _, ok := cg.allowedPeers[p]; ok {
+		return true
+	}
+
+	if cma.RemoteMultiaddr() != nil && len(cg.allowedPeers) > 0 {
+		// InterceptAccept deferred the IP check to here.
+		if ip, err := manet.ToIP(cma.RemoteMultiaddr()); err == nil && !cg.isIPAllowed(ip) && cg.isIPBlocked(ip) {
+			return false
+		}
+	}
+
 	_, block := cg.blockedPeers[p]
 	return !block
 }
@@ -374,3 +849,26 @@ func (cg *ConnectionGater) InterceptSecured(dir network.Direction, p peer.ID, cm
 func (cg *ConnectionGater) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
 	return true, 0
 }
+
+func encodeBanInfo(info banInfo) ([]byte, error) {
+	return proto.Marshal(&pb.DBBan{
+		Expiration: timestamppb.New(info.expiration),
+		Reason:     info.reason,
+	})
+}
+
+func decodeBanInfo(b []byte) (banInfo, error) {
+	// Older versions of the node stored just the gob encoded expiration.
+	t := time.Time{}
+	if err := t.GobDecode(b); err == nil {
+		return banInfo{expiration: t, reason: BanReasonManual}, nil
+	}
+	var dbBan pb.DBBan
+	if err := proto.Unmarshal(b, &dbBan); err != nil {
+		return banInfo{}, err
+	}
+	return banInfo{
+		expiration: dbBan.Expiration.AsTime(),
+		reason:     dbBan.Reason,
+	}, nil
+}
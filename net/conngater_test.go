@@ -5,9 +5,15 @@
 package net
 
 import (
+	"context"
+	"github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/repo/mock"
 	"github.com/stretchr/testify/assert"
 	"net"
@@ -231,6 +237,229 @@ func TestBanscore(t *testing.T) {
 	assert.Equal(t, "1.2.3.4", blockedAddrs[0].String())
 }
 
+func TestConnectionGaterSubnets(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	pstore, err := pstoremem.NewPeerstore()
+	assert.NoError(t, err)
+
+	peerA, _ := peer.Decode("12D3KooWSE3nPEMZEXGpDRjZesMEVquvs3YjYPJdiC4ve66rVuu5")
+
+	_, subnet, err := net.ParseCIDR("1.2.3.0/24")
+	assert.NoError(t, err)
+
+	cg, err := NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+
+	allow := cg.InterceptAddrDial(peerA, ma.StringCast("/ip4/1.2.3.4/tcp/1234"))
+	assert.True(t, allow, "expected gater to allow peerA in 1.2.3.4")
+
+	err = cg.BlockSubnet(subnet, time.Hour, "spam")
+	assert.NoError(t, err)
+
+	allow = cg.InterceptAddrDial(peerA, ma.StringCast("/ip4/1.2.3.4/tcp/1234"))
+	assert.False(t, allow, "expected gater to deny peerA in 1.2.3.4")
+
+	allow = cg.InterceptAccept(&mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/1.2.3.200/tcp/1234")})
+	assert.False(t, allow, "expected gater to deny 1.2.3.200")
+
+	allow = cg.InterceptAccept(&mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/1.2.4.1/tcp/1234")})
+	assert.True(t, allow, "expected gater to allow 1.2.4.1")
+
+	err = cg.BlockPeer(peerA)
+	assert.NoError(t, err)
+
+	// make a new gater reusing the datastore to test persistence
+	cg, err = NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+
+	bans := cg.ListBans()
+	assert.Len(t, bans, 2)
+	for _, ban := range bans {
+		if ban.Peer != "" {
+			assert.Equal(t, peerA, ban.Peer)
+			assert.Equal(t, BanReasonManual, ban.Reason)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), ban.Expiration, time.Second*5)
+		} else {
+			assert.Equal(t, "1.2.3.0/24", ban.Subnet.String())
+			assert.Equal(t, "spam", ban.Reason)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), ban.Expiration, time.Second*5)
+		}
+	}
+
+	allow = cg.InterceptAccept(&mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/1.2.3.200/tcp/1234")})
+	assert.False(t, allow, "expected gater to deny 1.2.3.200")
+
+	err = cg.UnblockSubnet(subnet)
+	assert.NoError(t, err)
+
+	allow = cg.InterceptAccept(&mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/1.2.3.200/tcp/1234")})
+	assert.True(t, allow, "expected gater to allow 1.2.3.200")
+
+	cg, err = NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+	assert.Len(t, cg.ListBlockedSubnets(), 0)
+
+	// Banning the subnet again replaces the earlier, shorter ban
+	// and its expiration does not lift the new ban.
+	err = cg.BlockSubnet(subnet, time.Millisecond*50, "spam")
+	assert.NoError(t, err)
+	err = cg.BlockSubnet(subnet, time.Hour, "spam")
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 200)
+
+	allow = cg.InterceptAccept(&mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/1.2.3.200/tcp/1234")})
+	assert.False(t, allow, "expected gater to deny 1.2.3.200")
+	for _, ban := range cg.ListBans() {
+		if ban.Subnet != nil {
+			assert.WithinDuration(t, time.Now().Add(time.Hour), ban.Expiration, time.Second*5)
+		}
+	}
+
+	cg, err = NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+	assert.Len(t, cg.ListBlockedSubnets(), 1)
+}
+
+func TestConnectionGaterAllowlist(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	pstore, err := pstoremem.NewPeerstore()
+	assert.NoError(t, err)
+
+	peerA, _ := peer.Decode("12D3KooWSE3nPEMZEXGpDRjZesMEVquvs3YjYPJdiC4ve66rVuu5")
+	peerB, _ := peer.Decode("12D3KooWARnj9CFGko6iX3PV8sYfMG94SSbMtTm7XPjtiiKjV7Fs")
+
+	pstore.AddAddr(peerB, ma.StringCast("/ip4/5.6.7.8/tcp/1234"), time.Hour)
+
+	_, blocked, err := net.ParseCIDR("5.6.0.0/16")
+	assert.NoError(t, err)
+	_, allowed, err := net.ParseCIDR("5.6.7.0/24")
+	assert.NoError(t, err)
+
+	cg, err := NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+
+	assert.NoError(t, cg.BlockSubnet(blocked, 0, ""))
+	assert.NoError(t, cg.BlockPeer(peerA))
+
+	assert.NoError(t, cg.AllowPeer(peerA))
+	assert.NoError(t, cg.AllowSubnet(allowed))
+
+	// make a new gater reusing the datastore to test persistence
+	cg, err = NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+
+	peers, subnets := cg.ListAllowlist()
+	assert.Len(t, peers, 1)
+	assert.Len(t, subnets, 1)
+	assert.True(t, cg.IsPeerAllowed(peerA))
+
+	// peerB advertises an address in the allowed subnet but it
+	// is not connected from it.
+	assert.False(t, cg.IsPeerAllowed(peerB))
+
+	// Allowlisted peers bypass peer bans.
+	allow := cg.InterceptPeerDial(peerA)
+	assert.True(t, allow, "expected gater to allow peerA")
+
+	allow = cg.InterceptSecured(network.DirInbound, peerA, &mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/5.6.1.1/tcp/1234")})
+	assert.True(t, allow, "expected gater to allow peerA")
+
+	// Allowlisted subnets bypass subnet bans.
+	allow = cg.InterceptAddrDial(peerB, ma.StringCast("/ip4/5.6.7.8/tcp/1234"))
+	assert.True(t, allow, "expected gater to allow peerB in 5.6.7.8")
+
+	allow = cg.InterceptAddrDial(peerB, ma.StringCast("/ip4/5.6.1.1/tcp/1234"))
+	assert.False(t, allow, "expected gater to deny peerB in 5.6.1.1")
+
+	// Non-allowlisted peers connecting from a blocked subnet are denied
+	// once the peer ID is known.
+	allow = cg.InterceptSecured(network.DirInbound, peerB, &mockConnMultiaddrs{local: nil, remote: ma.StringCast("/ip4/5.6.1.1/tcp/1234")})
+	assert.False(t, allow, "expected gater to deny peerB in 5.6.1.1")
+
+	// Allowlisted peers are exempt from banscore.
+	banned, err := cg.IncreaseBanscore(peerA, 101, 0)
+	assert.NoError(t, err)
+	assert.False(t, banned)
+
+	assert.NoError(t, cg.RemoveAllowedPeer(peerA))
+	assert.NoError(t, cg.RemoveAllowedSubnet(allowed))
+	assert.False(t, cg.IsPeerAllowed(peerA))
+	assert.False(t, cg.IsPeerAllowed(peerB))
+
+	allow = cg.InterceptPeerDial(peerA)
+	assert.False(t, allow, "expected gater to deny peerA")
+}
+
+func TestConnectionGaterAllowlistConns(t *testing.T) {
+	mn := mocknet.New()
+	defer mn.Close()
+
+	addPeer := func(addr string) host.Host {
+		sk, _, err := crypto.GenerateEd25519Key(nil)
+		assert.NoError(t, err)
+		h, err := mn.AddPeer(sk, ma.StringCast(addr))
+		assert.NoError(t, err)
+		return h
+	}
+	self := addPeer("/ip4/1.2.3.4/tcp/1234")
+	sentry := addPeer("/ip4/5.6.7.8/tcp/1234")
+	attacker := addPeer("/ip4/9.9.9.9/tcp/1234")
+	assert.NoError(t, mn.LinkAll())
+
+	_, allowed, err := net.ParseCIDR("5.6.7.0/24")
+	assert.NoError(t, err)
+
+	cg, err := NewConnectionGater(mock.NewMapDatastore(), self.Peerstore(), time.Minute, 100)
+	assert.NoError(t, err)
+	cg.setNetwork(self.Network())
+	assert.NoError(t, cg.AllowSubnet(allowed))
+
+	// The attacker advertises an address in the allowed subnet.
+	self.Peerstore().AddAddr(attacker.ID(), ma.StringCast("/ip4/5.6.7.9/tcp/1234"), time.Hour)
+
+	assert.False(t, cg.IsPeerAllowed(sentry.ID()))
+	assert.False(t, cg.IsPeerAllowed(attacker.ID()))
+
+	_, err = mn.ConnectPeers(self.ID(), sentry.ID())
+	assert.NoError(t, err)
+	_, err = mn.ConnectPeers(self.ID(), attacker.ID())
+	assert.NoError(t, err)
+
+	// Only the peer connected from the allowed subnet is allowlisted.
+	assert.True(t, cg.IsPeerAllowed(sentry.ID()))
+	assert.False(t, cg.IsPeerAllowed(attacker.ID()))
+
+	banned, err := cg.IncreaseBanscore(sentry.ID(), 101, 0)
+	assert.NoError(t, err)
+	assert.False(t, banned)
+
+	banned, err = cg.IncreaseBanscore(attacker.ID(), 101, 0)
+	assert.NoError(t, err)
+	assert.True(t, banned)
+}
+
+func TestConnectionGaterLegacyBans(t *testing.T) {
+	ds := mock.NewMapDatastore()
+	pstore, err := pstoremem.NewPeerstore()
+	assert.NoError(t, err)
+
+	peerA, _ := peer.Decode("12D3KooWSE3nPEMZEXGpDRjZesMEVquvs3YjYPJdiC4ve66rVuu5")
+
+	// Older versions stored the gob encoded expiration.
+	expiration := time.Now().Add(time.Hour)
+	b, err := expiration.GobEncode()
+	assert.NoError(t, err)
+	assert.NoError(t, ds.Put(context.Background(), datastore.NewKey(repo.ConnGaterKeyPrefix+keyPeer+peerA.String()), b))
+
+	cg, err := NewConnectionGater(ds, pstore, time.Minute, 100)
+	assert.NoError(t, err)
+
+	bans := cg.ListBans()
+	assert.Len(t, bans, 1)
+	assert.Equal(t, peerA, bans[0].Peer)
+	assert.True(t, expiration.Equal(bans[0].Expiration))
+}
+
 type mockConnMultiaddrs struct {
 	local, remote ma.Multiaddr
 }
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"encoding/hex"
+	"github.com/libp2p/go-libp2p/core/host"
+	inet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
+	"github.com/project-illium/ilxd/params/hash"
+	"time"
+)
+
+// mdnsConnectTimeout is how long we wait when dialing a peer
+// discovered via mDNS.
+const mdnsConnectTimeout = time.Second * 30
+
+// mdnsServiceName returns the mDNS service name for the network. The
+// name is derived from the protocol prefix so that nodes only discover
+// other nodes on the same network. DNS-SD service labels are limited
+// in length so a short hash of the prefix is used rather than the
+// prefix itself.
+func mdnsServiceName(protocolPrefix string) string {
+	h := hash.HashFunc([]byte(protocolPrefix))
+	return "_ilx-" + hex.EncodeToString(h[:4]) + "._udp"
+}
+
+// mdnsNotifee connects to peers discovered on the local network.
+type mdnsNotifee struct {
+	ctx  context.Context
+	host host.Host
+}
+
+// HandlePeerFound is called by the mDNS service when a peer is found.
+func (m *mdnsNotifee) HandlePeerFound(pi peer.AddrInfo) {
+	if pi.ID == m.host.ID() || m.host.Network().Connectedness(pi.ID) == inet.Connected {
+		return
+	}
+	ctx, cancel := context.WithTimeout(m.ctx, mdnsConnectTimeout)
+	defer cancel()
+	if err := m.host.Connect(ctx, pi); err != nil {
+		log.Trace("Failed to connect to mDNS peer", log.ArgsFromMap(map[string]any{
+			"peer":  pi.ID,
+			"error": err,
+		}))
+		return
+	}
+	log.Debug("Connected to peer discovered via mDNS", log.Args("peer", pi.ID))
+}
+
+// startMDNS starts the mDNS discovery service. The returned service
+// must be closed when the network shuts down.
+func startMDNS(ctx context.Context, h host.Host, protocolPrefix string) (mdns.Service, error) {
+	svc := mdns.NewMdnsService(h, mdnsServiceName(protocolPrefix), &mdnsNotifee{ctx: ctx, host: h})
+	if err := svc.Start(); err != nil {
+		return nil, err
+	}
+	return svc, nil
+}
@@ -22,19 +22,26 @@ import (
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/core/routing"
+	"github.com/libp2p/go-libp2p/p2p/discovery/mdns"
 	discovery "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
 	"github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/libp2p/go-libp2p/p2p/transport/websocket"
+	webtransport "github.com/libp2p/go-libp2p/p2p/transport/webtransport"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	mh "github.com/multiformats/go-multihash"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/params/hash"
+	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
+	gonet "net"
+	"os"
 	"time"
 )
 
@@ -43,6 +50,25 @@ const (
 	TransactionsTopic       = "transactions"
 	RelayKey                = "/ilx/relaypeers"
 	ValidatorProtectionFlag = "validator"
+	AllowlistProtectionFlag = "allowlist"
+
+	// DefaultMaxPeers is the default connection manager high water
+	// mark. When the number of connections exceeds this value the
+	// connection manager trims unprotected connections down to
+	// one quarter of the maximum.
+	DefaultMaxPeers = 400
+
+	// DefaultMinOutboundPeers is the default number of outbound peers
+	// that we try to maintain using addresses from the address manager.
+	DefaultMinOutboundPeers = 8
+
+	// DefaultConnGracePeriod is the default amount of time a newly
+	// opened connection is protected from being trimmed.
+	DefaultConnGracePeriod = time.Minute
+
+	// outboundInterval is how often we check whether we need more
+	// outbound peers.
+	outboundInterval = time.Second * 30
 )
 
 type Network struct {
@@ -53,18 +79,35 @@ type Network struct {
 	pubsub      *pubsub.PubSub
 	txTopic     *pubsub.Topic
 	blockTopic  *pubsub.Topic
-	pstoreds    *Peerstoreds
+	addrManager *AddrManager
+	allowlist   *rcmgr.Allowlist
 	txSub       *pubsub.Subscription
 	blkSub      *pubsub.Subscription
+	bwc         *BandwidthCounter
+	txRelay     *txRelay
+	reconciler  *mempoolReconciler
+	mdns        mdns.Service
+	gossipsubID protocol.ID
+	maxInbound  int
+	minOutbound int
+
+	acceptToMempool func(tx *transactions.Transaction) error
 }
 
 func NewNetwork(ctx context.Context, opts ...Option) (*Network, error) {
-	var cfg config
+	cfg := config{
+		maxPeers:        DefaultMaxPeers,
+		minOutbound:     DefaultMinOutboundPeers,
+		connGracePeriod: DefaultConnGracePeriod,
+	}
 	for _, opt := range opts {
 		if err := opt(&cfg); err != nil {
 			return nil, err
 		}
 	}
+	if cfg.maxInbound == 0 {
+		cfg.maxInbound = cfg.maxPeers - cfg.minOutbound
+	}
 
 	if err := cfg.validate(); err != nil {
 		return nil, err
@@ -106,11 +149,15 @@ func NewNetwork(ctx context.Context, opts ...Option) (*Network, error) {
 		pstore peerstore.Peerstore
 		cmgr   coreconmgr.ConnManager
 	)
-	cmgr, err = connmgr.NewConnManager(
-		100, // Lowwater
-		400, // HighWater,
-		connmgr.WithGracePeriod(time.Minute),
-	)
+	lowWater := cfg.maxPeers / 4
+	if lowWater < cfg.minOutbound {
+		lowWater = cfg.minOutbound
+	}
+	cmgrOpts := []connmgr.Option{connmgr.WithGracePeriod(cfg.connGracePeriod)}
+	if cfg.connSilencePeriod > 0 {
+		cmgrOpts = append(cmgrOpts, connmgr.WithSilencePeriod(cfg.connSilencePeriod))
+	}
+	cmgr, err = connmgr.NewConnManager(lowWater, cfg.maxPeers, cmgrOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -124,23 +171,29 @@ func NewNetwork(ctx context.Context, opts ...Option) (*Network, error) {
 		pstore = cfg.host.Peerstore()
 	}
 
-	pstoreds := NewPeerstoreds(cfg.datastore, pstore)
-	addrInfos, err := pstoreds.AddrInfos()
+	addrManager, err := NewAddrManager(cfg.datastore)
 	if err != nil {
 		return nil, err
 	}
-loop:
-	for i, ai := range addrInfos {
+	// Shut down the address manager if we fail to build the network.
+	started := false
+	defer func() {
+		if !started {
+			addrManager.Close()
+		}
+	}()
+	addrInfos := addrManager.Select(50, func(p peer.ID, group string) bool {
+		if p == self {
+			return true
+		}
 		for _, s := range seedAddrs {
-			if ai.ID == s.ID || ai.ID == self {
-				continue loop
+			if p == s.ID {
+				return true
 			}
 		}
-		seedAddrs = append(seedAddrs, ai)
-		if i > 50 {
-			break
-		}
-	}
+		return false
+	})
+	seedAddrs = append(seedAddrs, addrInfos...)
 
 	conngater, err := NewConnectionGater(cfg.datastore, pstore, cfg.banDuration, cfg.maxBanscore)
 	if err != nil {
@@ -157,6 +210,14 @@ loop:
 		dht.ProtocolPrefix(cfg.params.ProtocolPrefix),
 		dht.BootstrapPeers(seedAddrs...),
 		dht.Mode(mode),
+		// The query filter sees every peer returned by a DHT query. We
+		// use it to add the peers to the address manager. The DHT does not
+		// tell us which peer returned them so they are bucketed under the
+		// DHT source groups rather than as our own discoveries.
+		dht.QueryFilter(func(_ interface{}, ai peer.AddrInfo) bool {
+			addrManager.AddDHTAddrs(ai)
+			return true
+		}),
 	}
 
 	peerSource := func(ctx context.Context, numPeers int) <-chan peer.AddrInfo {
@@ -169,6 +230,8 @@ loop:
 		return kdht.FindProvidersAsync(ctx, id, numPeers)
 	}
 
+	bwc := NewBandwidthCounter(cfg.params.ProtocolPrefix)
+
 	// Start with the default scaling limits.
 	scalingLimits := rcmgr.DefaultLimits
 
@@ -220,27 +283,83 @@ loop:
 	limits := rCfg.Build(scaledDefaultLimits)
 
 	// The resource manager expects a limiter, se we create one from our limits.
+	// If a limits file was provided any limits set in the file override ours.
 	limiter := rcmgr.NewFixedLimiter(limits)
+	if cfg.resourceLimitsFile != "" {
+		f, err := os.Open(cfg.resourceLimitsFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: error opening resource limits file: %s", ErrNetworkConfig, err)
+		}
+		limiter, err = rcmgr.NewLimiterFromJSON(f, limits)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%w: error parsing resource limits file: %s", ErrNetworkConfig, err)
+		}
+	}
 
 	// Metrics are enabled by default. If you want to disable metrics, use the
 	// WithMetricsDisabled option
 	// Initialize the resource manager
-	rm, err := rcmgr.NewResourceManager(limiter, rcmgr.WithMetricsDisabled())
+	_, allowedSubnets := conngater.ListAllowlist()
+	allowedAddrs := make([]multiaddr.Multiaddr, 0, len(allowedSubnets))
+	for _, ipnet := range allowedSubnets {
+		addr, err := subnetMultiaddr(ipnet)
+		if err != nil {
+			return nil, err
+		}
+		allowedAddrs = append(allowedAddrs, addr)
+	}
+
+	rm, err := rcmgr.NewResourceManager(limiter, rcmgr.WithMetricsDisabled(), rcmgr.WithAllowlistedMultiaddrs(allowedAddrs))
 	if err != nil {
 		panic(err)
 	}
 
+	// QUIC and TCP plus optionally WebSocket and WebTransport
+	// for browser clients.
+	listenAddrs := cfg.listenAddrs
+	transports := libp2p.ChainOptions(
+		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.Transport(quic.NewTransport),
+	)
+	if cfg.enableWebsocket {
+		transports = libp2p.ChainOptions(transports, libp2p.Transport(websocket.New))
+	}
+	if cfg.enableWebTransport {
+		transports = libp2p.ChainOptions(transports, libp2p.Transport(webtransport.New))
+	}
+	if cfg.psk != nil {
+		// QUIC and WebTransport do their own encryption and
+		// cannot be used with the private network protector.
+		listenAddrs, err = filterQUICAddrs(cfg.listenAddrs)
+		if err != nil {
+			return nil, err
+		}
+		transports = libp2p.ChainOptions(
+			libp2p.Transport(tcp.NewTCPTransport),
+			libp2p.PrivateNetwork(cfg.psk),
+		)
+		if cfg.enableWebsocket {
+			transports = libp2p.ChainOptions(transports, libp2p.Transport(websocket.New))
+		}
+	}
+
+	// Use the static relays if provided, otherwise find relays
+	// through the DHT.
+	autoRelay := libp2p.EnableAutoRelayWithPeerSource(peerSource)
+	if len(cfg.staticRelays) > 0 {
+		autoRelay = libp2p.EnableAutoRelayWithStaticRelays(cfg.staticRelays)
+	}
+
 	hostOpts := libp2p.ChainOptions(
 		// Use the keypair we generated
 		libp2p.Identity(cfg.privateKey),
 		// Multiple listen addresses
-		libp2p.ListenAddrStrings(cfg.listenAddrs...),
+		libp2p.ListenAddrStrings(listenAddrs...),
 		// Noise and TLS
 		libp2p.DefaultSecurity,
 
-		// QUIC and TCP
-		libp2p.Transport(tcp.NewTCPTransport),
-		libp2p.Transport(quic.NewTransport),
+		transports,
 
 		libp2p.DefaultMuxers,
 
@@ -257,7 +376,7 @@ loop:
 		// Let this host use relays and advertise itself on relays if
 		// it finds it is behind NAT. Use libp2p.Relay(options...) to
 		// enable active relays and more.
-		libp2p.EnableAutoRelayWithPeerSource(peerSource),
+		autoRelay,
 		// If you want to help other peers to figure out if they are behind
 		// NATs, you can launch the server-side of AutoNAT too (AutoRelay
 		// already runs the client)
@@ -268,8 +387,6 @@ loop:
 
 		libp2p.EnableRelay(),
 
-		libp2p.EnableHolePunching(),
-
 		libp2p.UserAgent(cfg.userAgent),
 
 		libp2p.Ping(true),
@@ -279,11 +396,19 @@ loop:
 		libp2p.ConnectionGater(conngater),
 
 		libp2p.ResourceManager(rm),
+
+		libp2p.BandwidthReporter(bwc.bwc),
 	)
 
 	if !cfg.disableNatPortMap {
 		hostOpts = libp2p.ChainOptions(libp2p.NATPortMap(), hostOpts)
 	}
+	if !cfg.disableHolePunching {
+		hostOpts = libp2p.ChainOptions(hostOpts, libp2p.EnableHolePunching())
+	}
+	if cfg.enableRelayService {
+		hostOpts = libp2p.ChainOptions(hostOpts, libp2p.EnableRelayService())
+	}
 	if cfg.forceServerMode {
 		libp2p.ForceReachabilityPublic()
 	}
@@ -302,6 +427,7 @@ loop:
 			return nil, err
 		}
 	}
+	conngater.setNetwork(host.Network())
 
 	// Create a new PubSub service using the GossipSub router
 	ps, err := pubsub.NewGossipSub(
@@ -310,6 +436,7 @@ loop:
 		pubsub.WithNoAuthor(),
 		pubsub.WithDiscovery(discovery.NewRoutingDiscovery(kdht)),
 		pubsub.WithMaxMessageSize(cfg.maxMessageSize),
+		pubsub.WithRawTracer(&bandwidthTracer{topics: bwc.topics}),
 		pubsub.WithMessageIdFn(func(pmsg *pb.Message) string {
 			h := hash.HashFunc(pmsg.Data)
 			return string(h[:])
@@ -325,7 +452,17 @@ loop:
 		return nil, err
 	}
 
+	var relay *txRelay
+	if cfg.fetchTx != nil {
+		relay = newTxRelay(ctx, host, cfg.params.ProtocolPrefix+TxRelayProtocol+TxRelayProtocolVersion, cfg.acceptToMempool, cfg.fetchTx)
+	}
+
 	err = ps.RegisterTopicValidator(TransactionsTopic, pubsub.ValidatorEx(func(ctx context.Context, p peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+		// Our own transactions were already added to the mempool
+		// by BroadcastTransaction before being published.
+		if p == host.ID() {
+			return pubsub.ValidationAccept
+		}
 		tx := &transactions.Transaction{}
 		if err := tx.Deserialize(m.Data); err != nil {
 			return pubsub.ValidationReject
@@ -353,6 +490,12 @@ loop:
 		case blockchain.NotCurrentError:
 			return pubsub.ValidationIgnore
 		case nil:
+			// Pubsub will forward the transaction to our mesh
+			// peers. Announce it to the peers using the relay
+			// protocol as well.
+			if relay != nil {
+				relay.announce(tx.ID(), p)
+			}
 			return pubsub.ValidationAccept
 		default:
 			log.Debug("Mempool reject transaction", log.ArgsFromMap(map[string]any{
@@ -499,13 +642,33 @@ loop:
 		pubsub:      ps,
 		txTopic:     txTopic,
 		blockTopic:  blockTopic,
-		pstoreds:    pstoreds,
+		addrManager: addrManager,
 		txSub:       txSub,
 		blkSub:      blockSub,
+		bwc:         bwc,
+		txRelay:     relay,
+		gossipsubID: cfg.params.ProtocolPrefix + pubsub.GossipSubID_v11,
+		maxInbound:  cfg.maxInbound,
+		minOutbound: cfg.minOutbound,
+
+		acceptToMempool: cfg.acceptToMempool,
+	}
+	if relay != nil {
+		relay.start(net)
+		if cfg.getTxids != nil {
+			net.reconciler = newMempoolReconciler(ctx, host, cfg.params.ProtocolPrefix+MempoolSyncProtocol+MempoolSyncProtocolVersion, relay, cfg.getTxids)
+			net.reconciler.start()
+		}
+	}
+	if cfg.host == nil {
+		net.allowlist = rcmgr.GetAllowlist(rm)
 	}
 
 	connected := func(_ inet.Network, conn inet.Conn) {
 		log.Trace(fmt.Sprintf("Connected to peer %s", conn.RemotePeer()))
+		if conngater.IsPeerAllowed(conn.RemotePeer()) || conngater.IsAddrAllowed(conn.RemoteMultiaddr()) {
+			cmgr.Protect(conn.RemotePeer(), AllowlistProtectionFlag)
+		}
 	}
 	disconnected := func(_ inet.Network, conn inet.Conn) {
 		log.Trace(fmt.Sprintf("Disconnected from peer %s", conn.RemotePeer()))
@@ -518,6 +681,20 @@ loop:
 
 	host.Network().Notify(notifier)
 
+	identifySub, err := host.EventBus().Subscribe(new(event.EvtPeerIdentificationCompleted))
+	if err != nil {
+		return nil, err
+	}
+	go net.handleIdentifiedPeers(identifySub)
+	go net.maintainOutboundPeers(ctx)
+
+	if cfg.enableMDNS {
+		net.mdns, err = startMDNS(ctx, host, string(cfg.params.ProtocolPrefix))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	subReachability, err := host.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
 	if err != nil {
 		return nil, err
@@ -544,13 +721,21 @@ loop:
 			}
 		}
 	}(subReachability, kdht)
+	started = true
 	return net, nil
 }
 
 func (n *Network) Close() error {
 	n.txSub.Cancel()
 	n.blkSub.Cancel()
-	n.pstoreds.Close()
+	if n.mdns != nil {
+		if err := n.mdns.Close(); err != nil {
+			log.WithCaller(true).Error("Error closing mDNS service", log.Args("error", err))
+		}
+	}
+	if err := n.addrManager.Close(); err != nil {
+		log.WithCaller(true).Error("Error closing address manager", log.Args("error", err))
+	}
 	if err := n.host.Close(); err != nil {
 		return err
 	}
@@ -563,6 +748,189 @@ func (n *Network) Close() error {
 	return nil
 }
 
+// handleIdentifiedPeers adds the listen addresses of newly identified
+// peers to the address manager. Peers we dialed out to are marked as
+// good and moved into the tried table.
+//
+// The source of the addresses is the remote address of the connection
+// rather than any address the peer announces. The peer can't choose
+// the address we observe so it can't use its announced addresses to
+// spread itself across the new table.
+//
+// Inbound peers are disconnected if we are over the inbound limit. This
+// is checked after identify, rather than on connect, so that validator
+// and allowlisted connections have already been protected and do not
+// count against the limit.
+func (n *Network) handleIdentifiedPeers(sub event.Subscription) {
+	defer sub.Close()
+	for evt := range sub.Out() {
+		e, ok := evt.(event.EvtPeerIdentificationCompleted)
+		if !ok {
+			return
+		}
+		conns := n.host.Network().ConnsToPeer(e.Peer)
+		if len(conns) == 0 {
+			continue
+		}
+		ai := peer.AddrInfo{
+			ID:    e.Peer,
+			Addrs: n.host.Peerstore().Addrs(e.Peer),
+		}
+		n.addrManager.AddAddrs(ai, conns[0].RemoteMultiaddr())
+		if conns[0].Stat().Direction == inet.DirOutbound {
+			n.addrManager.Good(e.Peer)
+		} else if n.exceedsInboundLimit(e.Peer) {
+			log.Debug("Disconnecting inbound peer, max inbound peers reached", log.Args("peer", e.Peer))
+			n.host.Network().ClosePeer(e.Peer)
+		}
+	}
+}
+
+// exceedsInboundLimit returns whether accepting the inbound peer would
+// put us over the max inbound limit. Protected peers are exempt and are
+// not counted.
+func (n *Network) exceedsInboundLimit(p peer.ID) bool {
+	if n.connManager.IsProtected(p, "") {
+		return false
+	}
+	inbound := make(map[peer.ID]bool)
+	for _, conn := range n.host.Network().Conns() {
+		rp := conn.RemotePeer()
+		if conn.Stat().Direction == inet.DirInbound && !n.connManager.IsProtected(rp, "") {
+			inbound[rp] = true
+		}
+	}
+	return len(inbound) > n.maxInbound
+}
+
+// maintainOutboundPeers periodically dials addresses from the address
+// manager if we have fewer than minOutbound outbound connections.
+// Only one outbound peer is selected from each network group to make
+// it harder for an attacker controlling an IP range to eclipse us.
+func (n *Network) maintainOutboundPeers(ctx context.Context) {
+	ticker := time.NewTicker(outboundInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			var (
+				outbound = 0
+				groups   = make(map[string]bool)
+			)
+			for _, conn := range n.host.Network().Conns() {
+				if conn.Stat().Direction == inet.DirOutbound {
+					outbound++
+					groups[GroupKey(conn.RemoteMultiaddr())] = true
+				}
+			}
+			if outbound >= n.minOutbound {
+				continue
+			}
+			candidates := n.addrManager.Select(n.minOutbound-outbound, func(p peer.ID, group string) bool {
+				if p == n.host.ID() || n.host.Network().Connectedness(p) == inet.Connected {
+					return true
+				}
+				if group != groupLocal && group != groupUnknown && groups[group] {
+					return true
+				}
+				groups[group] = true
+				return false
+			})
+			for _, ai := range candidates {
+				n.addrManager.Attempt(ai.ID)
+				go func(ai peer.AddrInfo) {
+					dialCtx, cancel := context.WithTimeout(ctx, time.Second*30)
+					defer cancel()
+					if err := n.host.Connect(dialCtx, ai); err != nil {
+						log.Trace("Failed to dial outbound peer", log.ArgsFromMap(map[string]any{
+							"peer":  ai.ID,
+							"error": err,
+						}))
+					}
+				}(ai)
+			}
+		}
+	}
+}
+
+// AllowPeer adds the peer to the connection gater's allowlist and
+// protects any existing connections to the peer from being trimmed.
+func (n *Network) AllowPeer(p peer.ID) error {
+	if err := n.connGater.AllowPeer(p); err != nil {
+		return err
+	}
+	if len(n.host.Network().ConnsToPeer(p)) > 0 {
+		n.connManager.Protect(p, AllowlistProtectionFlag)
+	}
+	return nil
+}
+
+// AllowSubnet adds the subnet to the connection gater's and resource
+// manager's allowlists and protects any existing connections from the
+// subnet from being trimmed.
+func (n *Network) AllowSubnet(ipnet *gonet.IPNet) error {
+	if err := n.connGater.AllowSubnet(ipnet); err != nil {
+		return err
+	}
+	if n.allowlist != nil {
+		addr, err := subnetMultiaddr(ipnet)
+		if err != nil {
+			return err
+		}
+		if err := n.allowlist.Add(addr); err != nil {
+			return err
+		}
+	}
+	for _, conn := range n.host.Network().Conns() {
+		if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil && ipnet.Contains(ip) {
+			n.connManager.Protect(conn.RemotePeer(), AllowlistProtectionFlag)
+		}
+	}
+	return nil
+}
+
+// RemoveAllowedPeer removes the peer from the connection gater's
+// allowlist.
+func (n *Network) RemoveAllowedPeer(p peer.ID) error {
+	if err := n.connGater.RemoveAllowedPeer(p); err != nil {
+		return err
+	}
+	if !n.connGater.IsPeerAllowed(p) {
+		n.connManager.Unprotect(p, AllowlistProtectionFlag)
+	}
+	return nil
+}
+
+// RemoveAllowedSubnet removes the subnet from the connection gater's
+// and resource manager's allowlists.
+func (n *Network) RemoveAllowedSubnet(ipnet *gonet.IPNet) error {
+	if err := n.connGater.RemoveAllowedSubnet(ipnet); err != nil {
+		return err
+	}
+	if n.allowlist != nil {
+		addr, err := subnetMultiaddr(ipnet)
+		if err != nil {
+			return err
+		}
+		if err := n.allowlist.Remove(addr); err != nil {
+			return err
+		}
+	}
+	for _, conn := range n.host.Network().Conns() {
+		if !n.connGater.IsPeerAllowed(conn.RemotePeer()) && !n.connGater.IsAddrAllowed(conn.RemoteMultiaddr()) {
+			n.connManager.Unprotect(conn.RemotePeer(), AllowlistProtectionFlag)
+		}
+	}
+	return nil
+}
+
+// AddrManager returns the network's address manager.
+func (n *Network) AddrManager() *AddrManager {
+	return n.addrManager
+}
+
 func (n *Network) Host() host.Host {
 	return n.host
 }
@@ -583,6 +951,11 @@ func (n *Network) ConnGater() *ConnectionGater {
 	return n.connGater
 }
 
+// BandwidthCounter returns the network's bandwidth counter.
+func (n *Network) BandwidthCounter() *BandwidthCounter {
+	return n.bwc
+}
+
 func (n *Network) SubscribeBlocks() (*pubsub.Subscription, error) {
 	return n.blockTopic.Subscribe()
 }
@@ -599,7 +972,37 @@ func (n *Network) BroadcastBlock(blk *blocks.XThinnerBlock) error {
 	return n.blockTopic.Publish(context.Background(), ser)
 }
 
+// BroadcastTransaction adds the transaction to the mempool and, if
+// it is valid, relays it to our peers. An error is returned if the
+// transaction fails mempool validation.
 func (n *Network) BroadcastTransaction(tx *transactions.Transaction) error {
+	if err := n.acceptToMempool(tx); err != nil {
+		return err
+	}
+	return n.relayTransaction(tx, "")
+}
+
+// RequestTransaction downloads the transaction from the peer using the
+// tx relay protocol, if we do not already have it, and submits it to the
+// mempool.
+func (n *Network) RequestTransaction(txid types.ID, p peer.ID) {
+	if n.txRelay == nil || !n.txRelay.supportsRelay(p) {
+		return
+	}
+	n.txRelay.handleInv(p, [][]byte{txid.Bytes()})
+}
+
+// relayTransaction announces a transaction that was accepted into the
+// mempool to peers using the tx relay protocol. For backwards
+// compatibility the full transaction is also published over pubsub
+// if any connected peers do not support the relay protocol.
+func (n *Network) relayTransaction(tx *transactions.Transaction, from peer.ID) error {
+	if n.txRelay != nil {
+		n.txRelay.announce(tx.ID(), from)
+		if !n.hasLegacyTxPeers() {
+			return nil
+		}
+	}
 	ser, err := tx.Serialize()
 	if err != nil {
 		return err
@@ -607,6 +1010,40 @@ func (n *Network) BroadcastTransaction(tx *transactions.Transaction) error {
 	return n.txTopic.Publish(context.Background(), ser)
 }
 
+// hasLegacyTxPeers returns whether we are connected to any peers which
+// receive transactions over pubsub but do not support the tx relay
+// protocol.
+func (n *Network) hasLegacyTxPeers() bool {
+	for _, p := range n.host.Network().Peers() {
+		protos, err := n.host.Peerstore().SupportsProtocols(p, n.gossipsubID, n.txRelay.protocol)
+		if err != nil {
+			continue
+		}
+		var gossip, relay bool
+		for _, proto := range protos {
+			switch proto {
+			case n.gossipsubID:
+				gossip = true
+			case n.txRelay.protocol:
+				relay = true
+			}
+		}
+		if gossip && !relay {
+			return true
+		}
+	}
+	return false
+}
+
+func subnetMultiaddr(ipnet *gonet.IPNet) (multiaddr.Multiaddr, error) {
+	ones, _ := ipnet.Mask.Size()
+	proto := "ip6"
+	if ipnet.IP.To4() != nil {
+		proto = "ip4"
+	}
+	return multiaddr.NewMultiaddr(fmt.Sprintf("/%s/%s/ipcidr/%d", proto, ipnet.IP.String(), ones))
+}
+
 func (n *Network) IncreaseBanscore(p peer.ID, persistent, transient uint32) {
 	banned, err := n.connGater.IncreaseBanscore(p, persistent, transient)
 	if err != nil {
@@ -619,3 +1056,26 @@ func (n *Network) IncreaseBanscore(p peer.ID, persistent, transient uint32) {
 		n.host.Network().ClosePeer(p) //nolint:errcheck
 	}
 }
+
+// filterQUICAddrs removes any QUIC based listen addresses, including
+// WebTransport, from the list as they cannot be used on a private network.
+func filterQUICAddrs(addrs []string) ([]string, error) {
+	ret := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		maddr, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformatted listen addr", ErrNetworkConfig)
+		}
+		if _, err := maddr.ValueForProtocol(multiaddr.P_UDP); err == nil {
+			log.Warn("Ignoring UDP listen addr on private network", log.ArgsFromMap(map[string]any{
+				"addr": addr,
+			}))
+			continue
+		}
+		ret = append(ret, addr)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("%w: private network requires a TCP listen addr", ErrNetworkConfig)
+	}
+	return ret, nil
+}
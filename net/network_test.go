@@ -0,0 +1,137 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMaxInboundPeers(t *testing.T) {
+	mn := mocknet.New()
+
+	h, err := mn.GenPeer()
+	assert.NoError(t, err)
+	n, err := NewNetwork(context.Background(), []Option{
+		WithHost(h),
+		Params(&params.RegestParams),
+		BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+			return nil
+		}),
+		MempoolValidator(func(*transactions.Transaction) error {
+			return nil
+		}),
+		Datastore(mock.NewMapDatastore()),
+		MaxMessageSize(repo.DefaultMaxMessageSize),
+		MaxPeers(10),
+		MaxInboundPeers(1),
+	}...)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n.maxInbound)
+	assert.Equal(t, DefaultMinOutboundPeers, n.minOutbound)
+
+	p1, err := mn.GenPeer()
+	assert.NoError(t, err)
+	p2, err := mn.GenPeer()
+	assert.NoError(t, err)
+	assert.NoError(t, mn.LinkAll())
+
+	_, err = mn.ConnectPeers(p1.ID(), h.ID())
+	assert.NoError(t, err)
+	_, err = mn.ConnectPeers(p2.ID(), h.ID())
+	assert.NoError(t, err)
+
+	// Once identify completes one of the inbound peers should
+	// be disconnected.
+	assert.Eventually(t, func() bool {
+		return len(h.Network().Peers()) == 1
+	}, time.Second*10, time.Millisecond*10)
+}
+
+func TestConnManagerOptionsValidation(t *testing.T) {
+	tests := []struct {
+		opts  []Option
+		valid bool
+	}{
+		{opts: []Option{MaxPeers(50)}, valid: true},
+		{opts: []Option{MaxPeers(0)}, valid: false},
+		{opts: []Option{MaxPeers(50), MinOutboundPeers(51)}, valid: false},
+		{opts: []Option{MaxPeers(50), MaxInboundPeers(51)}, valid: false},
+		{opts: []Option{MaxPeers(50), MaxInboundPeers(40), MinOutboundPeers(10)}, valid: true},
+	}
+	for i, test := range tests {
+		cfg := config{
+			params:          &params.RegestParams,
+			datastore:       mock.NewMapDatastore(),
+			acceptToMempool: func(*transactions.Transaction) error { return nil },
+			validateBlock:   func(*blocks.XThinnerBlock, peer.ID) error { return nil },
+			maxPeers:        DefaultMaxPeers,
+			minOutbound:     DefaultMinOutboundPeers,
+		}
+		cfg.host, _ = mocknet.New().GenPeer()
+		for _, opt := range test.opts {
+			assert.NoError(t, opt(&cfg))
+		}
+		err := cfg.validate()
+		if test.valid {
+			assert.NoError(t, err, "test %d", i)
+		} else {
+			assert.Error(t, err, "test %d", i)
+		}
+	}
+}
+
+func TestMDNSServiceName(t *testing.T) {
+	name := mdnsServiceName(string(params.RegestParams.ProtocolPrefix))
+	assert.Equal(t, name, mdnsServiceName(string(params.RegestParams.ProtocolPrefix)))
+	assert.NotEqual(t, name, mdnsServiceName(string(params.MainnetParams.ProtocolPrefix)))
+
+	// DNS-SD service labels must be no longer than 15 characters.
+	assert.LessOrEqual(t, len(name)-len("._udp"), 15)
+}
+
+func TestWebsocketTransport(t *testing.T) {
+	var networks []*Network
+	for i := 0; i < 2; i++ {
+		sk, _, err := crypto.GenerateEd25519Key(nil)
+		assert.NoError(t, err)
+		n, err := NewNetwork(context.Background(), []Option{
+			PrivateKey(sk),
+			ListenAddrs([]string{"/ip4/127.0.0.1/tcp/0/ws"}),
+			Params(&params.RegestParams),
+			BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			MempoolValidator(func(*transactions.Transaction) error {
+				return nil
+			}),
+			Datastore(mock.NewMapDatastore()),
+			MaxMessageSize(repo.DefaultMaxMessageSize),
+			DisableNatPortMap(),
+			EnableWebsocket(),
+		}...)
+		assert.NoError(t, err)
+		defer n.Close()
+		networks = append(networks, n)
+	}
+	addrs := networks[1].Host().Addrs()
+	assert.Len(t, addrs, 1)
+	_, err := addrs[0].ValueForProtocol(multiaddr.P_WS)
+	assert.NoError(t, err)
+
+	err = networks[0].Host().Connect(context.Background(), peer.AddrInfo{ID: networks[1].Host().ID(), Addrs: addrs})
+	assert.NoError(t, err)
+}
@@ -10,8 +10,11 @@ import (
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/pnet"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
 	"time"
@@ -29,6 +32,27 @@ func MempoolValidator(acceptToMempool func(tx *transactions.Transaction) error)
 	}
 }
 
+// MempoolFetcher sets the function used to look up transactions in the
+// mempool. If set, transactions are relayed by announcing txids to peers
+// and serving the transaction bodies they request.
+func MempoolFetcher(fetchTx func(txid types.ID) (*transactions.Transaction, error)) Option {
+	return func(cfg *config) error {
+		cfg.fetchTx = fetchTx
+		return nil
+	}
+}
+
+// MempoolTxids sets the function used to list the txids in the mempool.
+// If set along with MempoolFetcher, the mempool is periodically
+// reconciled with peers so that transactions missed while offline
+// are recovered.
+func MempoolTxids(getTxids func() []types.ID) Option {
+	return func(cfg *config) error {
+		cfg.getTxids = getTxids
+		return nil
+	}
+}
+
 func BlockValidator(validateBlock func(blk *blocks.XThinnerBlock, p peer.ID) error) Option {
 	return func(cfg *config) error {
 		cfg.validateBlock = validateBlock
@@ -123,6 +147,149 @@ func ForceDHTServerMode() Option {
 	}
 }
 
+// PrivateNetwork enables the libp2p private network protector
+// using the provided 32 byte pre-shared key. Only nodes holding
+// the same key will be able to connect to this node.
+//
+// QUIC does not support private networks so only the TCP
+// transport is used when this option is set.
+func PrivateNetwork(psk pnet.PSK) Option {
+	return func(cfg *config) error {
+		cfg.psk = psk
+		return nil
+	}
+}
+
+// MaxPeers sets the connection manager's high water mark. When we have
+// more connections than this the connection manager trims unprotected
+// connections down to a quarter of this value (or MinOutboundPeers if
+// greater). The default is DefaultMaxPeers.
+func MaxPeers(n int) Option {
+	return func(cfg *config) error {
+		cfg.maxPeers = n
+		return nil
+	}
+}
+
+// MaxInboundPeers sets the maximum number of inbound peers. Validators
+// and allowlisted peers are protected and do not count against this
+// limit. If not set it defaults to MaxPeers minus MinOutboundPeers.
+func MaxInboundPeers(n int) Option {
+	return func(cfg *config) error {
+		cfg.maxInbound = n
+		return nil
+	}
+}
+
+// MinOutboundPeers sets the number of outbound peers we try to maintain
+// using addresses from the address manager. The default is
+// DefaultMinOutboundPeers.
+func MinOutboundPeers(n int) Option {
+	return func(cfg *config) error {
+		cfg.minOutbound = n
+		return nil
+	}
+}
+
+// ConnManagerGracePeriod sets the amount of time a newly opened
+// connection is protected from being trimmed by the connection manager.
+func ConnManagerGracePeriod(d time.Duration) Option {
+	return func(cfg *config) error {
+		cfg.connGracePeriod = d
+		return nil
+	}
+}
+
+// ConnManagerSilencePeriod sets the minimum amount of time between
+// connection manager trims. If not set the libp2p default is used.
+func ConnManagerSilencePeriod(d time.Duration) Option {
+	return func(cfg *config) error {
+		cfg.connSilencePeriod = d
+		return nil
+	}
+}
+
+// ResourceLimitsFile sets the path to a JSON file of libp2p resource
+// manager limits. Any limits set in the file override the defaults.
+// This has no effect if WithHost is used.
+func ResourceLimitsFile(path string) Option {
+	return func(cfg *config) error {
+		cfg.resourceLimitsFile = path
+		return nil
+	}
+}
+
+// EnableMDNS enables peer discovery on the local network using mDNS.
+// Only nodes using the same protocol prefix will discover each other.
+func EnableMDNS() Option {
+	return func(cfg *config) error {
+		cfg.enableMDNS = true
+		return nil
+	}
+}
+
+// EnableWebsocket enables the WebSocket transport. WebSocket listen
+// addresses, for example /ip4/0.0.0.0/tcp/9003/ws, should be added
+// using ListenAddrs. This allows browser clients to connect to the node.
+func EnableWebsocket() Option {
+	return func(cfg *config) error {
+		cfg.enableWebsocket = true
+		return nil
+	}
+}
+
+// EnableWebTransport enables the WebTransport transport. WebTransport
+// listen addresses, for example /ip4/0.0.0.0/udp/9003/quic-v1/webtransport,
+// should be added using ListenAddrs. Browsers can connect to WebTransport
+// addresses without the node needing a CA signed certificate.
+//
+// WebTransport runs over QUIC and is not used on private networks.
+func EnableWebTransport() Option {
+	return func(cfg *config) error {
+		cfg.enableWebTransport = true
+		return nil
+	}
+}
+
+// EnableRelayService enables the circuit relay v2 service allowing
+// peers behind NATs to be reached through this node. This should only
+// be used by publicly reachable nodes.
+func EnableRelayService() Option {
+	return func(cfg *config) error {
+		cfg.enableRelayService = true
+		return nil
+	}
+}
+
+// StaticRelays sets the circuit relay v2 relays, in multiaddr format,
+// that this node will use if it finds it is behind a NAT. If not set
+// relays are found through the DHT.
+func StaticRelays(addrs []string) Option {
+	return func(cfg *config) error {
+		for _, addr := range addrs {
+			ma, err := multiaddr.NewMultiaddr(addr)
+			if err != nil {
+				return fmt.Errorf("%w: malformatted relay addr", ErrNetworkConfig)
+			}
+			pi, err := peer.AddrInfoFromP2pAddr(ma)
+			if err != nil {
+				return fmt.Errorf("%w: relay addr must include the peer ID", ErrNetworkConfig)
+			}
+			cfg.staticRelays = append(cfg.staticRelays, *pi)
+		}
+		return nil
+	}
+}
+
+// DisableHolePunching disables the use of hole punching to upgrade
+// relayed connections to direct connections.
+func DisableHolePunching() Option {
+	return func(cfg *config) error {
+		cfg.disableHolePunching = true
+		return nil
+	}
+}
+
 type config struct {
 	params            *params.NetworkParams
 	userAgent         string
@@ -134,10 +301,27 @@ type config struct {
 	privateKey        crypto.PrivKey
 	datastore         repo.Datastore
 	acceptToMempool   func(tx *transactions.Transaction) error
+	fetchTx           func(txid types.ID) (*transactions.Transaction, error)
+	getTxids          func() []types.ID
 	validateBlock     func(blk *blocks.XThinnerBlock, p peer.ID) error
 	maxBanscore       uint32
 	forceServerMode   bool
+	enableMDNS        bool
 	banDuration       time.Duration
+	psk               pnet.PSK
+
+	maxPeers           int
+	maxInbound         int
+	minOutbound        int
+	connGracePeriod    time.Duration
+	connSilencePeriod  time.Duration
+	resourceLimitsFile string
+
+	enableWebsocket     bool
+	enableWebTransport  bool
+	enableRelayService  bool
+	staticRelays        []peer.AddrInfo
+	disableHolePunching bool
 }
 
 func (cfg *config) validate() error {
@@ -156,6 +340,18 @@ func (cfg *config) validate() error {
 	if cfg.datastore == nil && cfg.host == nil {
 		return fmt.Errorf("%w: datastore is nil", ErrNetworkConfig)
 	}
+	if cfg.psk != nil && len(cfg.psk) != 32 {
+		return fmt.Errorf("%w: private network key must be 32 bytes", ErrNetworkConfig)
+	}
+	if cfg.maxPeers <= 0 {
+		return fmt.Errorf("%w: max peers must be positive", ErrNetworkConfig)
+	}
+	if cfg.minOutbound < 0 || cfg.minOutbound > cfg.maxPeers {
+		return fmt.Errorf("%w: min outbound peers must be between zero and max peers", ErrNetworkConfig)
+	}
+	if cfg.maxInbound < 0 || cfg.maxInbound > cfg.maxPeers {
+		return fmt.Errorf("%w: max inbound peers must be between zero and max peers", ErrNetworkConfig)
+	}
 	if cfg.acceptToMempool == nil {
 		return fmt.Errorf("%w: acceptToMempool is nil", ErrNetworkConfig)
 	}
@@ -0,0 +1,349 @@
+// Copyright (c) 2024 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	ctxio "github.com/jbenet/go-context/io"
+	"github.com/libp2p/go-libp2p/core/host"
+	inet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-msgio"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/wire"
+	"google.golang.org/protobuf/proto"
+	"io"
+	mrand "math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	// MempoolSyncProtocol is the libp2p protocol ID used to
+	// reconcile mempools with peers.
+	MempoolSyncProtocol = "/mempoolsync/"
+
+	// MempoolSyncProtocolVersion is the version of the MempoolSyncProtocol.
+	MempoolSyncProtocolVersion = "1.0.0"
+
+	// reconcileInterval is how often we reconcile our mempool with
+	// a randomly selected peer.
+	reconcileInterval = time.Minute
+
+	// minReconcileCells and maxReconcileCells bound the size of the
+	// IBLTs we send. At the max size we can recover roughly 20,000
+	// differences.
+	minReconcileCells = 96
+	maxReconcileCells = 1 << 15
+
+	// reconcileCellsPerDiff is the number of IBLT cells we use for
+	// each difference found in the previous round with a peer.
+	reconcileCellsPerDiff = 3
+
+	// maxReconcileAttempts is the maximum number of times we retry
+	// with a larger IBLT if the difference fails to decode.
+	maxReconcileAttempts = 3
+
+	// maxReconcileTxids is the maximum number of txids a peer may
+	// send in response to a reconciliation request.
+	maxReconcileTxids = 100000
+
+	// reconcileRequestWindow is the window over which we limit a
+	// peer to maxReconcileAttempts requests. This allows one round,
+	// including retries, per window.
+	reconcileRequestWindow = time.Second * 10
+
+	// reconcileRequestTimeout is how long we wait for a peer to
+	// respond to a reconciliation request.
+	reconcileRequestTimeout = time.Second * 30
+)
+
+// mempoolReconciler periodically reconciles our mempool with our peers.
+//
+// Transaction announcements can be missed if we were offline or if a
+// peer dropped them. To converge on the same mempool we periodically
+// send a random peer an IBLT of our mempool txids. The peer subtracts
+// its own IBLT and decodes the difference. It then fetches the txids
+// we have that it is missing, and returns the txids it has that we
+// are missing so that we can fetch them. Keeping mempools in sync also
+// improves the rate at which xthinner blocks can be decoded without
+// having to request missing transactions.
+type mempoolReconciler struct {
+	ctx      context.Context
+	host     host.Host
+	ms       MessageSender
+	protocol protocol.ID
+	relay    *txRelay
+	getTxids func() []types.ID
+
+	estimates map[peer.ID]int
+	requests  map[peer.ID]*requestWindow
+	mtx       sync.Mutex
+}
+
+// requestWindow tracks the number of requests a peer has made
+// in the current window.
+type requestWindow struct {
+	start time.Time
+	count int
+}
+
+func newMempoolReconciler(ctx context.Context, h host.Host, proto protocol.ID, relay *txRelay, getTxids func() []types.ID) *mempoolReconciler {
+	return &mempoolReconciler{
+		ctx:       ctx,
+		host:      h,
+		ms:        NewMessageSender(h, proto),
+		protocol:  proto,
+		relay:     relay,
+		getTxids:  getTxids,
+		estimates: make(map[peer.ID]int),
+		requests:  make(map[peer.ID]*requestWindow),
+		mtx:       sync.Mutex{},
+	}
+}
+
+// start registers the stream handler and begins the periodic
+// reconciliation loop.
+func (r *mempoolReconciler) start() {
+	r.host.SetStreamHandler(r.protocol, r.handleNewStream)
+	r.host.Network().Notify(&inet.NotifyBundle{
+		DisconnectedF: func(_ inet.Network, conn inet.Conn) {
+			if r.host.Network().Connectedness(conn.RemotePeer()) == inet.Connected {
+				return
+			}
+			r.mtx.Lock()
+			delete(r.estimates, conn.RemotePeer())
+			delete(r.requests, conn.RemotePeer())
+			r.mtx.Unlock()
+		},
+	})
+	go r.reconcileLoop()
+}
+
+func (r *mempoolReconciler) reconcileLoop() {
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			var candidates []peer.ID
+			for _, p := range r.host.Network().Peers() {
+				protos, err := r.host.Peerstore().SupportsProtocols(p, r.protocol)
+				if err == nil && len(protos) > 0 {
+					candidates = append(candidates, p)
+				}
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+			p := candidates[mrand.Intn(len(candidates))]
+			if err := r.reconcile(p); err != nil {
+				log.Debug("Error reconciling mempool with peer", log.ArgsFromMap(map[string]any{
+					"peer":  p,
+					"error": err,
+				}))
+			}
+		}
+	}
+}
+
+// reconcile runs a round of mempool reconciliation with the peer. If
+// the difference fails to decode we retry with a larger IBLT. On the
+// last attempt the peer is asked to send its full set of txids.
+func (r *mempoolReconciler) reconcile(p peer.ID) error {
+	r.mtx.Lock()
+	cells, ok := r.estimates[p]
+	r.mtx.Unlock()
+	if !ok {
+		cells = minReconcileCells
+	}
+
+	txids := r.getTxids()
+	for attempt := 0; attempt < maxReconcileAttempts; attempt++ {
+		salt := make([]byte, 8)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		tbl := mempool.NewIBLT(cells, salt)
+		for _, txid := range txids {
+			tbl.Insert(txid)
+		}
+		sendAll := attempt == maxReconcileAttempts-1 || cells >= maxReconcileCells
+
+		ctx, cancel := context.WithTimeout(r.ctx, reconcileRequestTimeout)
+		resp := new(wire.MsgReconcileResp)
+		err := r.ms.SendRequest(ctx, p, &wire.MsgReconcileReq{
+			Salt:             salt,
+			Iblt:             tbl.Serialize(),
+			SendAllOnFailure: sendAll,
+		}, resp)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if resp.Error != wire.ErrorResponse_None {
+			return fmt.Errorf("error response from peer: %s", resp.GetError().String())
+		}
+		if len(resp.Txids) > maxReconcileTxids {
+			r.relay.network.IncreaseBanscore(p, 0, 20)
+			return fmt.Errorf("peer %s sent too many txids", p)
+		}
+
+		if resp.Decoded || sendAll {
+			if len(resp.Txids) > 0 {
+				r.relay.handleInv(p, resp.Txids)
+			}
+			next := int(resp.DiffSize) * reconcileCellsPerDiff
+			if !resp.Decoded {
+				next = cells * 4
+			}
+			r.mtx.Lock()
+			r.estimates[p] = clampReconcileCells(next)
+			r.mtx.Unlock()
+			return nil
+		}
+		cells = clampReconcileCells(cells * 4)
+	}
+	return nil
+}
+
+func clampReconcileCells(cells int) int {
+	if cells < minReconcileCells {
+		return minReconcileCells
+	}
+	if cells > maxReconcileCells {
+		return maxReconcileCells
+	}
+	return cells
+}
+
+func (r *mempoolReconciler) handleNewStream(s inet.Stream) {
+	go r.handleNewMessage(s)
+}
+
+func (r *mempoolReconciler) handleNewMessage(s inet.Stream) {
+	defer s.Close()
+	contextReader := ctxio.NewReader(r.ctx, s)
+	reader := msgio.NewVarintReaderSize(contextReader, 1<<23)
+	remotePeer := s.Conn().RemotePeer()
+	defer reader.Close()
+	ticker := time.NewTicker(time.Minute)
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			return
+		default:
+		}
+
+		msgBytes, err := reader.ReadMsg()
+		if err != nil {
+			reader.ReleaseMsg(msgBytes)
+			if err == io.EOF || err == inet.ErrReset {
+				s.Close()
+				return
+			}
+			log.Debug("Error reading from mempool sync stream", log.ArgsFromMap(map[string]any{
+				"peer":  remotePeer,
+				"error": err,
+			}))
+			s.Reset()
+			return
+		}
+		req := new(wire.MsgReconcileReq)
+		if err := proto.Unmarshal(msgBytes, req); err != nil {
+			reader.ReleaseMsg(msgBytes)
+			log.Debug("Error unmarshalling mempool sync message", log.ArgsFromMap(map[string]any{
+				"peer":  remotePeer,
+				"error": err,
+			}))
+			s.Reset()
+			return
+		}
+		reader.ReleaseMsg(msgBytes)
+
+		resp := r.handleReconcile(remotePeer, req)
+		if err := WriteMsg(s, resp); err != nil {
+			log.Debug("Error writing mempool sync response", log.ArgsFromMap(map[string]any{
+				"peer":  remotePeer,
+				"error": err,
+			}))
+			s.Reset()
+			return
+		}
+		ticker.Reset(time.Minute)
+	}
+}
+
+func (r *mempoolReconciler) handleReconcile(p peer.ID, req *wire.MsgReconcileReq) *wire.MsgReconcileResp {
+	r.mtx.Lock()
+	window, ok := r.requests[p]
+	if !ok || time.Since(window.start) >= reconcileRequestWindow {
+		window = &requestWindow{start: time.Now()}
+		r.requests[p] = window
+	}
+	if window.count >= maxReconcileAttempts {
+		r.mtx.Unlock()
+		return &wire.MsgReconcileResp{Error: wire.ErrorResponse_RateLimited}
+	}
+	window.count++
+	r.mtx.Unlock()
+
+	if len(req.Salt) > 32 {
+		return &wire.MsgReconcileResp{Error: wire.ErrorResponse_BadRequest}
+	}
+	theirs, err := mempool.DeserializeIBLT(req.Iblt, req.Salt)
+	if err != nil || theirs.NumCells() > maxReconcileCells {
+		return &wire.MsgReconcileResp{Error: wire.ErrorResponse_BadRequest}
+	}
+
+	txids := r.getTxids()
+	ours := mempool.NewIBLT(theirs.NumCells(), req.Salt)
+	for _, txid := range txids {
+		ours.Insert(txid)
+	}
+	diff, err := ours.Subtract(theirs)
+	if err != nil {
+		return &wire.MsgReconcileResp{Error: wire.ErrorResponse_BadRequest}
+	}
+
+	onlyOurs, onlyTheirs, err := diff.Decode()
+	if err != nil {
+		resp := &wire.MsgReconcileResp{}
+		if req.SendAllOnFailure {
+			if len(txids) > maxReconcileTxids {
+				txids = txids[:maxReconcileTxids]
+			}
+			resp.Txids = idsToBytes(txids)
+		}
+		return resp
+	}
+
+	// Fetch the transactions the peer has that we don't.
+	if len(onlyTheirs) > 0 {
+		r.relay.handleInv(p, idsToBytes(onlyTheirs))
+	}
+	return &wire.MsgReconcileResp{
+		Decoded:  true,
+		Txids:    idsToBytes(onlyOurs),
+		DiffSize: uint32(len(onlyOurs) + len(onlyTheirs)),
+	}
+}
+
+func idsToBytes(ids []types.ID) [][]byte {
+	ret := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, id.Bytes())
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/types/wire"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func (m *testMempool) txids() []types.ID {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	txids := make([]types.ID, 0, len(m.txs))
+	for txid := range m.txs {
+		txids = append(txids, txid)
+	}
+	return txids
+}
+
+func TestMempoolReconciler(t *testing.T) {
+	mn := mocknet.New()
+
+	var (
+		networks []*Network
+		mempools []*testMempool
+	)
+	for i := 0; i < 2; i++ {
+		h, err := mn.GenPeer()
+		assert.NoError(t, err)
+		mp := &testMempool{txs: make(map[types.ID]*transactions.Transaction)}
+		n, err := NewNetwork(context.Background(), []Option{
+			WithHost(h),
+			Params(&params.RegestParams),
+			BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			MempoolValidator(mp.accept),
+			MempoolFetcher(mp.fetch),
+			MempoolTxids(mp.txids),
+			Datastore(mock.NewMapDatastore()),
+			MaxMessageSize(repo.DefaultMaxMessageSize),
+		}...)
+		assert.NoError(t, err)
+		networks = append(networks, n)
+		mempools = append(mempools, mp)
+	}
+
+	// Populate the mempools directly so that nothing is announced.
+	newTx := func(fee uint64) *transactions.Transaction {
+		return transactions.WrapTransaction(&transactions.StandardTransaction{
+			Fee: fee,
+		})
+	}
+	for i := 0; i < 100; i++ {
+		tx := newTx(uint64(i))
+		assert.NoError(t, mempools[0].accept(tx))
+		assert.NoError(t, mempools[1].accept(tx))
+	}
+	// More differences than the minimum IBLT can decode so the
+	// first attempt must be retried with a larger table.
+	for i := 100; i < 200; i++ {
+		assert.NoError(t, mempools[0].accept(newTx(uint64(i))))
+	}
+	for i := 200; i < 230; i++ {
+		assert.NoError(t, mempools[1].accept(newTx(uint64(i))))
+	}
+
+	assert.NoError(t, mn.LinkAll())
+	assert.NoError(t, mn.ConnectAllButSelf())
+
+	p1 := networks[1].Host().ID()
+	assert.Eventually(t, func() bool {
+		protos, err := networks[0].Host().Peerstore().SupportsProtocols(p1, networks[0].reconciler.protocol)
+		return err == nil && len(protos) > 0
+	}, time.Second*10, time.Millisecond*10)
+
+	assert.NoError(t, networks[0].reconciler.reconcile(p1))
+
+	assert.Eventually(t, func() bool {
+		return len(mempools[0].txids()) == 230 && len(mempools[1].txids()) == 230
+	}, time.Second*10, time.Millisecond*10)
+
+	// The estimate for the next round is sized to the difference.
+	networks[0].reconciler.mtx.Lock()
+	assert.Equal(t, 130*reconcileCellsPerDiff, networks[0].reconciler.estimates[p1])
+	networks[0].reconciler.mtx.Unlock()
+
+	// A peer may only make maxReconcileAttempts requests per window.
+	p0 := networks[0].Host().ID()
+	rec := networks[1].reconciler
+	rec.mtx.Lock()
+	delete(rec.requests, p0)
+	rec.mtx.Unlock()
+	req := &wire.MsgReconcileReq{
+		Salt: []byte{0x01},
+		Iblt: mempool.NewIBLT(minReconcileCells, []byte{0x01}).Serialize(),
+	}
+	for i := 0; i < maxReconcileAttempts; i++ {
+		assert.Equal(t, wire.ErrorResponse_None, rec.handleReconcile(p0, req).Error)
+	}
+	assert.Equal(t, wire.ErrorResponse_RateLimited, rec.handleReconcile(p0, req).Error)
+}
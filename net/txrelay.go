@@ -0,0 +1,515 @@
+// Copyright (c) 2024 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	ctxio "github.com/jbenet/go-context/io"
+	"github.com/libp2p/go-libp2p/core/host"
+	inet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-msgio"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/mempool"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/types/wire"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// TxRelayProtocol is the libp2p protocol ID used to announce
+	// and fetch transactions.
+	TxRelayProtocol = "/txrelay/"
+
+	// TxRelayProtocolVersion is the version of the TxRelayProtocol.
+	TxRelayProtocolVersion = "1.0.0"
+
+	// maxInvPerMsg is the maximum number of txids a peer may
+	// announce in a single inv message.
+	maxInvPerMsg = 5000
+
+	// maxTxsPerRequest is the maximum number of transactions that
+	// may be requested in a single request.
+	maxTxsPerRequest = 100
+
+	// maxKnownInventory is the maximum number of txids we remember
+	// a peer knowing about. Older entries are evicted first.
+	maxKnownInventory = 10000
+
+	// maxRejectedInventory is the maximum number of rejected txids
+	// we remember so that we do not fetch them again.
+	maxRejectedInventory = 10000
+
+	// maxTxAnnouncers is the maximum number of alternate peers we
+	// track for a transaction that is being fetched.
+	maxTxAnnouncers = 8
+
+	// maxInflightPerPeer is the maximum number of transactions we
+	// request from a single peer at once. Further announcements from
+	// the peer are not requested until earlier requests complete.
+	maxInflightPerPeer = 1000
+
+	// undeliveredTxBanscore is the transient banscore increase a peer
+	// receives when it fails to deliver transactions it announced.
+	undeliveredTxBanscore = 5
+
+	// invTrickleInterval is how often queued announcements are
+	// flushed to each peer. Batching announcements keeps the number
+	// of messages down and makes it harder to trace a transaction
+	// back to its origin.
+	invTrickleInterval = time.Millisecond * 200
+
+	// txRequestTimeout is how long we wait for a peer to respond
+	// to a transaction request.
+	txRequestTimeout = time.Second * 30
+)
+
+// inventorySet is a bounded set of txids which evicts the
+// oldest entry when full.
+type inventorySet struct {
+	ids   map[types.ID]struct{}
+	order []types.ID
+	limit int
+}
+
+func newInventorySet(limit int) *inventorySet {
+	return &inventorySet{
+		ids:   make(map[types.ID]struct{}),
+		limit: limit,
+	}
+}
+
+func (s *inventorySet) has(txid types.ID) bool {
+	_, ok := s.ids[txid]
+	return ok
+}
+
+func (s *inventorySet) add(txid types.ID) {
+	if s.has(txid) {
+		return
+	}
+	if len(s.order) >= s.limit {
+		delete(s.ids, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.ids[txid] = struct{}{}
+	s.order = append(s.order, txid)
+}
+
+// txRequest tracks a transaction we are fetching along with the
+// other peers which announced it in case the first one fails to
+// deliver.
+type txRequest struct {
+	peer       peer.ID
+	announcers []peer.ID
+}
+
+// txRelay relays transactions by announcing txids to peers and
+// letting them request the bodies of the transactions they do not
+// already have. This avoids sending every transaction (and its
+// proof) to every peer as happens with pubsub.
+type txRelay struct {
+	ctx             context.Context
+	host            host.Host
+	ms              MessageSender
+	protocol        protocol.ID
+	acceptToMempool func(tx *transactions.Transaction) error
+	fetchTx         func(txid types.ID) (*transactions.Transaction, error)
+	network         *Network
+
+	known        map[peer.ID]*inventorySet
+	queued       map[peer.ID][]types.ID
+	inflight     map[types.ID]*txRequest
+	peerInflight map[peer.ID]int
+	rejected     *inventorySet
+	mtx          sync.Mutex
+}
+
+func newTxRelay(ctx context.Context, h host.Host, proto protocol.ID,
+	acceptToMempool func(tx *transactions.Transaction) error,
+	fetchTx func(txid types.ID) (*transactions.Transaction, error)) *txRelay {
+
+	return &txRelay{
+		ctx:             ctx,
+		host:            h,
+		ms:              NewMessageSender(h, proto),
+		protocol:        proto,
+		acceptToMempool: acceptToMempool,
+		fetchTx:         fetchTx,
+		known:           make(map[peer.ID]*inventorySet),
+		queued:          make(map[peer.ID][]types.ID),
+		inflight:        make(map[types.ID]*txRequest),
+		peerInflight:    make(map[peer.ID]int),
+		rejected:        newInventorySet(maxRejectedInventory),
+		mtx:             sync.Mutex{},
+	}
+}
+
+// start registers the stream handler and begins flushing queued
+// announcements. Accepted transactions are handed back to the
+// network to be relayed onward.
+func (r *txRelay) start(n *Network) {
+	r.network = n
+	r.host.SetStreamHandler(r.protocol, r.handleNewStream)
+	r.host.Network().Notify(&inet.NotifyBundle{
+		DisconnectedF: func(_ inet.Network, conn inet.Conn) {
+			if r.host.Network().Connectedness(conn.RemotePeer()) == inet.Connected {
+				return
+			}
+			r.mtx.Lock()
+			delete(r.known, conn.RemotePeer())
+			delete(r.queued, conn.RemotePeer())
+			r.mtx.Unlock()
+		},
+	})
+	go r.trickleLoop()
+}
+
+// supportsRelay returns whether the peer speaks the tx relay protocol.
+func (r *txRelay) supportsRelay(p peer.ID) bool {
+	protos, err := r.host.Peerstore().SupportsProtocols(p, r.protocol)
+	return err == nil && len(protos) > 0
+}
+
+// announce queues the txid to be announced to all connected peers
+// which support the relay protocol and do not already know about it.
+func (r *txRelay) announce(txid types.ID, from peer.ID) {
+	peers := r.host.Network().Peers()
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, p := range peers {
+		if p == from || !r.supportsRelay(p) {
+			continue
+		}
+		known := r.knownInventory(p)
+		if known.has(txid) {
+			continue
+		}
+		known.add(txid)
+		r.queued[p] = append(r.queued[p], txid)
+	}
+}
+
+func (r *txRelay) knownInventory(p peer.ID) *inventorySet {
+	known, ok := r.known[p]
+	if !ok {
+		known = newInventorySet(maxKnownInventory)
+		r.known[p] = known
+	}
+	return known
+}
+
+func (r *txRelay) trickleLoop() {
+	ticker := time.NewTicker(invTrickleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.mtx.Lock()
+			queued := r.queued
+			r.queued = make(map[peer.ID][]types.ID)
+			r.mtx.Unlock()
+
+			for p, txids := range queued {
+				go r.sendInv(p, txids)
+			}
+		}
+	}
+}
+
+func (r *txRelay) sendInv(p peer.ID, txids []types.ID) {
+	for len(txids) > 0 {
+		n := len(txids)
+		if n > maxInvPerMsg {
+			n = maxInvPerMsg
+		}
+		inv := &wire.MsgTxInv{Txids: make([][]byte, 0, n)}
+		for _, txid := range txids[:n] {
+			inv.Txids = append(inv.Txids, txid.Bytes())
+		}
+		txids = txids[n:]
+
+		err := r.ms.SendMessage(r.ctx, p, &wire.MsgTxRelay{
+			Msg: &wire.MsgTxRelay_Inv{Inv: inv},
+		})
+		if err != nil {
+			log.Debug("Error sending tx inv to peer", log.ArgsFromMap(map[string]any{
+				"peer":  p,
+				"error": err,
+			}))
+			return
+		}
+	}
+}
+
+func (r *txRelay) handleNewStream(s inet.Stream) {
+	go r.handleNewMessage(s)
+}
+
+func (r *txRelay) handleNewMessage(s inet.Stream) {
+	defer s.Close()
+	contextReader := ctxio.NewReader(r.ctx, s)
+	reader := msgio.NewVarintReaderSize(contextReader, 1<<23)
+	remotePeer := s.Conn().RemotePeer()
+	defer reader.Close()
+	ticker := time.NewTicker(time.Minute)
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			return
+		default:
+		}
+
+		msgBytes, err := reader.ReadMsg()
+		if err != nil {
+			reader.ReleaseMsg(msgBytes)
+			if err == io.EOF || err == inet.ErrReset {
+				s.Close()
+				return
+			}
+			log.Debug("Error reading from tx relay stream", log.ArgsFromMap(map[string]any{
+				"peer":  remotePeer,
+				"error": err,
+			}))
+			s.Reset()
+			return
+		}
+		msg := new(wire.MsgTxRelay)
+		if err := proto.Unmarshal(msgBytes, msg); err != nil {
+			reader.ReleaseMsg(msgBytes)
+			log.Debug("Error unmarshalling tx relay message", log.ArgsFromMap(map[string]any{
+				"peer":  remotePeer,
+				"error": err,
+			}))
+			s.Reset()
+			return
+		}
+		reader.ReleaseMsg(msgBytes)
+
+		switch m := msg.Msg.(type) {
+		case *wire.MsgTxRelay_Inv:
+			if len(m.Inv.Txids) > maxInvPerMsg {
+				r.network.IncreaseBanscore(remotePeer, 0, 20)
+				s.Reset()
+				return
+			}
+			r.handleInv(remotePeer, m.Inv.Txids)
+		case *wire.MsgTxRelay_GetTxs:
+			resp := r.handleGetTxs(m.GetTxs)
+			if err := WriteMsg(s, resp); err != nil {
+				log.Debug("Error writing tx relay response", log.ArgsFromMap(map[string]any{
+					"peer":  remotePeer,
+					"error": err,
+				}))
+				s.Reset()
+				return
+			}
+		}
+		ticker.Reset(time.Minute)
+	}
+}
+
+func (r *txRelay) handleGetTxs(req *wire.GetTxsReq) *wire.MsgTxsResp {
+	if len(req.Txids) > maxTxsPerRequest {
+		return &wire.MsgTxsResp{Error: wire.ErrorResponse_BadRequest}
+	}
+	resp := &wire.MsgTxsResp{}
+	for _, b := range req.Txids {
+		tx, err := r.fetchTx(types.NewID(b))
+		if err != nil {
+			continue
+		}
+		resp.Transactions = append(resp.Transactions, tx)
+	}
+	return resp
+}
+
+// handleInv records that the peer knows about the announced txids
+// and requests any transactions that we don't yet have, up to
+// maxInflightPerPeer outstanding transactions from the peer.
+func (r *txRelay) handleInv(p peer.ID, txidBytes [][]byte) {
+	// Check which transactions we already have before taking the
+	// lock so that it isn't held while calling into the mempool.
+	txids := make([]types.ID, 0, len(txidBytes))
+	have := make(map[types.ID]bool)
+	for _, b := range txidBytes {
+		txid := types.NewID(b)
+		txids = append(txids, txid)
+		if _, err := r.fetchTx(txid); err == nil {
+			have[txid] = true
+		}
+	}
+
+	toRequest := make([]types.ID, 0, len(txids))
+	skipped := 0
+
+	r.mtx.Lock()
+	known := r.knownInventory(p)
+	for _, txid := range txids {
+		known.add(txid)
+		if have[txid] || r.rejected.has(txid) {
+			continue
+		}
+		if req, ok := r.inflight[txid]; ok {
+			if req.peer != p && len(req.announcers) < maxTxAnnouncers {
+				req.announcers = append(req.announcers, p)
+			}
+			continue
+		}
+		if r.peerInflight[p] >= maxInflightPerPeer {
+			skipped++
+			continue
+		}
+		r.inflight[txid] = &txRequest{peer: p}
+		r.peerInflight[p]++
+		toRequest = append(toRequest, txid)
+	}
+	r.mtx.Unlock()
+
+	if skipped > 0 {
+		log.Debug("Peer exceeded max inflight tx requests", log.ArgsFromMap(map[string]any{
+			"peer":    p,
+			"skipped": skipped,
+		}))
+	}
+
+	for len(toRequest) > 0 {
+		n := len(toRequest)
+		if n > maxTxsPerRequest {
+			n = maxTxsPerRequest
+		}
+		go r.requestTxs(p, toRequest[:n])
+		toRequest = toRequest[n:]
+	}
+}
+
+// requestTxs fetches the transactions from the peer and submits
+// them to the mempool. The peer's banscore is increased if it fails
+// to deliver any of them and they are requested from the next peer
+// which announced them.
+func (r *txRelay) requestTxs(p peer.ID, txids []types.ID) {
+	requested := make(map[types.ID]bool, len(txids))
+	req := &wire.GetTxsReq{Txids: make([][]byte, 0, len(txids))}
+	for _, txid := range txids {
+		requested[txid] = true
+		req.Txids = append(req.Txids, txid.Bytes())
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, txRequestTimeout)
+	defer cancel()
+
+	resp := new(wire.MsgTxsResp)
+	err := r.ms.SendRequest(ctx, p, &wire.MsgTxRelay{
+		Msg: &wire.MsgTxRelay_GetTxs{GetTxs: req},
+	}, resp)
+	if err != nil {
+		log.Debug("Error requesting txs from peer", log.ArgsFromMap(map[string]any{
+			"peer":  p,
+			"error": err,
+		}))
+		resp = new(wire.MsgTxsResp)
+	}
+
+	r.mtx.Lock()
+	r.peerInflight[p] -= len(txids)
+	if r.peerInflight[p] <= 0 {
+		delete(r.peerInflight, p)
+	}
+	r.mtx.Unlock()
+
+	for _, tx := range resp.Transactions {
+		txid := tx.ID()
+		if !requested[txid] {
+			// The peer sent us something we didn't ask for.
+			r.network.IncreaseBanscore(p, 0, 20)
+			continue
+		}
+		delete(requested, txid)
+		r.processTx(tx, p)
+	}
+
+	if len(requested) > 0 {
+		// The peer announced transactions that it didn't deliver.
+		r.network.IncreaseBanscore(p, 0, undeliveredTxBanscore)
+	}
+	for txid := range requested {
+		r.retry(txid)
+	}
+}
+
+// retry requests the transaction from the next peer that announced
+// it or gives up if there are none left.
+func (r *txRelay) retry(txid types.ID) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	req, ok := r.inflight[txid]
+	if !ok {
+		return
+	}
+	if len(req.announcers) == 0 {
+		delete(r.inflight, txid)
+		return
+	}
+	req.peer = req.announcers[0]
+	req.announcers = req.announcers[1:]
+	r.peerInflight[req.peer]++
+	go r.requestTxs(req.peer, []types.ID{txid})
+}
+
+func (r *txRelay) processTx(tx *transactions.Transaction, p peer.ID) {
+	txid := tx.ID()
+	err := r.acceptToMempool(tx)
+
+	r.mtx.Lock()
+	delete(r.inflight, txid)
+	r.mtx.Unlock()
+
+	switch e := err.(type) {
+	case mempool.PolicyError:
+		// Policy errors do not penalize peer
+		log.Debug("Mempool reject transaction", log.ArgsFromMap(map[string]any{
+			"txid":         txid.String(),
+			"from peer":    p,
+			"policy error": e.ErrorCode.String(),
+			"description":  e.Description,
+		}))
+	case blockchain.RuleError:
+		// Rule errors do
+		log.Debug("Mempool reject transaction", log.ArgsFromMap(map[string]any{
+			"txid":        txid.String(),
+			"from peer":   p,
+			"rule error":  e.ErrorCode.String(),
+			"description": e.Description,
+		}))
+		r.mtx.Lock()
+		r.rejected.add(txid)
+		r.mtx.Unlock()
+		r.network.IncreaseBanscore(p, 0, 20)
+	case blockchain.NotCurrentError:
+	case nil:
+		r.network.relayTransaction(tx, p)
+	default:
+		if err != mempool.ErrDuplicateTx {
+			log.Debug("Mempool reject transaction", log.ArgsFromMap(map[string]any{
+				"txid":          txid.String(),
+				"from peer":     p,
+				"unknown error": err,
+			}))
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package net
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMempool struct {
+	txs map[types.ID]*transactions.Transaction
+	mtx sync.Mutex
+}
+
+func (m *testMempool) accept(tx *transactions.Transaction) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.txs[tx.ID()] = tx
+	return nil
+}
+
+func (m *testMempool) fetch(txid types.ID) (*transactions.Transaction, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	tx, ok := m.txs[txid]
+	if !ok {
+		return nil, ErrNetworkConfig
+	}
+	return tx, nil
+}
+
+func (m *testMempool) has(txid types.ID) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	_, ok := m.txs[txid]
+	return ok
+}
+
+func TestTxRelay(t *testing.T) {
+	mn := mocknet.New()
+
+	var (
+		hosts    []host.Host
+		networks []*Network
+		mempools []*testMempool
+	)
+	for i := 0; i < 3; i++ {
+		h, err := mn.GenPeer()
+		assert.NoError(t, err)
+		mp := &testMempool{txs: make(map[types.ID]*transactions.Transaction)}
+		n, err := NewNetwork(context.Background(), []Option{
+			WithHost(h),
+			Params(&params.RegestParams),
+			BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			MempoolValidator(mp.accept),
+			MempoolFetcher(mp.fetch),
+			Datastore(mock.NewMapDatastore()),
+			MaxMessageSize(repo.DefaultMaxMessageSize),
+		}...)
+		assert.NoError(t, err)
+		hosts = append(hosts, h)
+		networks = append(networks, n)
+		mempools = append(mempools, mp)
+	}
+
+	// Connect the nodes in a line so the tx must be relayed
+	// through the middle node.
+	_, err := mn.LinkPeers(hosts[0].ID(), hosts[1].ID())
+	assert.NoError(t, err)
+	_, err = mn.LinkPeers(hosts[1].ID(), hosts[2].ID())
+	assert.NoError(t, err)
+	_, err = mn.ConnectPeers(hosts[0].ID(), hosts[1].ID())
+	assert.NoError(t, err)
+	_, err = mn.ConnectPeers(hosts[1].ID(), hosts[2].ID())
+	assert.NoError(t, err)
+
+	// Wait for identify to finish so the nodes know their peers
+	// support the relay protocol.
+	assert.Eventually(t, func() bool {
+		return networks[0].txRelay.supportsRelay(hosts[1].ID()) &&
+			networks[1].txRelay.supportsRelay(hosts[0].ID()) &&
+			networks[1].txRelay.supportsRelay(hosts[2].ID()) &&
+			networks[2].txRelay.supportsRelay(hosts[1].ID())
+	}, time.Second*10, time.Millisecond*10)
+
+	for _, n := range networks {
+		assert.False(t, n.hasLegacyTxPeers())
+	}
+
+	tx := transactions.WrapTransaction(&transactions.StandardTransaction{
+		Fee:   10,
+		Proof: make([]byte, 1000),
+	})
+	assert.NoError(t, networks[0].BroadcastTransaction(tx))
+	assert.True(t, mempools[0].has(tx.ID()))
+
+	assert.Eventually(t, func() bool {
+		return mempools[1].has(tx.ID()) && mempools[2].has(tx.ID())
+	}, time.Second*10, time.Millisecond*10)
+
+	// The announcing peers should be recorded as knowing the tx
+	// so that it isn't announced back to them.
+	assert.Eventually(t, func() bool {
+		networks[1].txRelay.mtx.Lock()
+		defer networks[1].txRelay.mtx.Unlock()
+		known0, ok0 := networks[1].txRelay.known[hosts[0].ID()]
+		known2, ok2 := networks[1].txRelay.known[hosts[2].ID()]
+		return ok0 && ok2 && known0.has(tx.ID()) && known2.has(tx.ID())
+	}, time.Second*10, time.Millisecond*10)
+
+	// Nothing should be left in flight.
+	for _, n := range networks {
+		n.txRelay.mtx.Lock()
+		assert.Len(t, n.txRelay.inflight, 0)
+		n.txRelay.mtx.Unlock()
+	}
+}
+
+func TestTxRelayUndelivered(t *testing.T) {
+	mn := mocknet.New()
+
+	var (
+		hosts    []host.Host
+		networks []*Network
+	)
+	for i := 0; i < 2; i++ {
+		h, err := mn.GenPeer()
+		assert.NoError(t, err)
+		mp := &testMempool{txs: make(map[types.ID]*transactions.Transaction)}
+		n, err := NewNetwork(context.Background(), []Option{
+			WithHost(h),
+			Params(&params.RegestParams),
+			BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+				return nil
+			}),
+			MempoolValidator(mp.accept),
+			MempoolFetcher(mp.fetch),
+			Datastore(mock.NewMapDatastore()),
+			MaxMessageSize(repo.DefaultMaxMessageSize),
+			BanDuration(time.Hour),
+			MaxBanscore(100),
+		}...)
+		assert.NoError(t, err)
+		hosts = append(hosts, h)
+		networks = append(networks, n)
+	}
+	_, err := mn.LinkPeers(hosts[0].ID(), hosts[1].ID())
+	assert.NoError(t, err)
+	_, err = mn.ConnectPeers(hosts[0].ID(), hosts[1].ID())
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return networks[0].txRelay.supportsRelay(hosts[1].ID()) &&
+			networks[1].txRelay.supportsRelay(hosts[0].ID())
+	}, time.Second*10, time.Millisecond*10)
+
+	relay := networks[1].txRelay
+	cg := networks[1].connGater
+
+	// The peer announces a transaction it doesn't have so its
+	// banscore is increased once the request comes back empty.
+	relay.handleInv(hosts[0].ID(), [][]byte{types.NewID([]byte{0x01}).Bytes()})
+	assert.Eventually(t, func() bool {
+		cg.RLock()
+		defer cg.RUnlock()
+		_, ok := cg.scores[hosts[0].ID()]
+		return ok
+	}, time.Second*10, time.Millisecond*10)
+	assert.Eventually(t, func() bool {
+		relay.mtx.Lock()
+		defer relay.mtx.Unlock()
+		return len(relay.inflight) == 0 && len(relay.peerInflight) == 0
+	}, time.Second*10, time.Millisecond*10)
+
+	// Announcements beyond the inflight limit for the peer are
+	// not requested.
+	relay.mtx.Lock()
+	relay.peerInflight[hosts[0].ID()] = maxInflightPerPeer
+	relay.mtx.Unlock()
+
+	txid := types.NewID([]byte{0x02})
+	relay.handleInv(hosts[0].ID(), [][]byte{txid.Bytes()})
+
+	relay.mtx.Lock()
+	_, ok := relay.inflight[txid]
+	assert.False(t, ok)
+	assert.True(t, relay.known[hosts[0].ID()].has(txid))
+	relay.mtx.Unlock()
+}
+
+func TestInventorySet(t *testing.T) {
+	set := newInventorySet(3)
+	ids := []types.ID{types.NewID([]byte{1}), types.NewID([]byte{2}), types.NewID([]byte{3}), types.NewID([]byte{4})}
+	for _, id := range ids[:3] {
+		set.add(id)
+	}
+	for _, id := range ids[:3] {
+		assert.True(t, set.has(id))
+	}
+	set.add(ids[3])
+	assert.False(t, set.has(ids[0]))
+	assert.True(t, set.has(ids[3]))
+	assert.Len(t, set.order, 3)
+}
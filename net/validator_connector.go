@@ -17,11 +17,13 @@ import (
 
 // ValidatorConnector does two things.
 // First, it strives to maintain active connections to all validators in the
-// validator set.
+// validator set. These connections are protected so that they are never
+// trimmed by the connection manager or counted against the inbound limit.
 // Second, it tracks the percentage of the weighted stake that we are connected to.
 type ValidatorConnector struct {
 	ownID               peer.ID
 	connectedPercentage float64
+	protected           map[peer.ID]bool
 	getValidatorFunc    func(validatorID peer.ID) (*blockchain.Validator, error)
 	getValidatorsFunc   func() []*blockchain.Validator
 	host                host.Host
@@ -39,6 +41,7 @@ func NewValidatorConnector(host host.Host, ownID peer.ID,
 		getValidatorFunc:  getValidatorFunc,
 		getValidatorsFunc: getValidatorsFunc,
 		host:              host,
+		protected:         make(map[peer.ID]bool),
 		mtx:               sync.RWMutex{},
 	}
 
@@ -77,24 +80,56 @@ func (vc *ValidatorConnector) update() {
 		connectedStake = val.WeightedStake
 	}
 
+	validators := make(map[peer.ID]bool)
 	for _, val := range vc.getValidatorsFunc() {
 		totalStake += val.WeightedStake
+		validators[val.PeerID] = true
 
 		switch vc.host.Network().Connectedness(val.PeerID) {
 		case inet.Connected:
 			if val.PeerID != vc.ownID {
 				connectedStake += val.WeightedStake
+				vc.protect(val.PeerID)
 			}
 		case inet.NotConnected, inet.CanConnect:
 			if val.PeerID != vc.ownID {
-				go vc.host.Connect(context.Background(), peer.AddrInfo{ID: val.PeerID})
+				go func(p peer.ID) {
+					if err := vc.host.Connect(context.Background(), peer.AddrInfo{ID: p}); err == nil {
+						vc.protect(p)
+					}
+				}(val.PeerID)
 			}
 		}
 	}
 
 	vc.mtx.Lock()
 	vc.connectedPercentage = float64(connectedStake) / float64(totalStake)
+	var removed []peer.ID
+	for p := range vc.protected {
+		if !validators[p] {
+			removed = append(removed, p)
+		}
+	}
 	vc.mtx.Unlock()
+
+	// Peers which are no longer in the validator set lose their protection.
+	for _, p := range removed {
+		vc.unprotect(p)
+	}
+}
+
+func (vc *ValidatorConnector) protect(p peer.ID) {
+	vc.mtx.Lock()
+	vc.protected[p] = true
+	vc.mtx.Unlock()
+	vc.host.ConnManager().Protect(p, ValidatorProtectionFlag)
+}
+
+func (vc *ValidatorConnector) unprotect(p peer.ID) {
+	vc.mtx.Lock()
+	delete(vc.protected, p)
+	vc.mtx.Unlock()
+	vc.host.ConnManager().Unprotect(p, ValidatorProtectionFlag)
 }
 
 func (vc *ValidatorConnector) handleBlockchainNotification(ntf *blockchain.Notification) {
@@ -106,15 +141,15 @@ func (vc *ValidatorConnector) handleBlockchainNotification(ntf *blockchain.Notif
 func (vc *ValidatorConnector) handlePeerConnected(_ inet.Network, conn inet.Conn) {
 	_, err := vc.getValidatorFunc(conn.RemotePeer())
 	if err == nil {
-		vc.host.ConnManager().Protect(conn.RemotePeer(), ValidatorProtectionFlag)
+		vc.protect(conn.RemotePeer())
 		vc.update()
 	}
 }
 
 func (vc *ValidatorConnector) handlePeerDisconnected(_ inet.Network, conn inet.Conn) {
 	_, err := vc.getValidatorFunc(conn.RemotePeer())
-	if err == nil {
-		vc.host.ConnManager().Unprotect(conn.RemotePeer(), ValidatorProtectionFlag)
+	if err == nil && vc.host.Network().Connectedness(conn.RemotePeer()) != inet.Connected {
+		vc.unprotect(conn.RemotePeer())
 		vc.update()
 	}
 }
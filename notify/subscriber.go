@@ -0,0 +1,90 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+// Package notify delivers notifications to subscriber callbacks in the
+// order in which they were sent without blocking the sender.
+package notify
+
+import "sync"
+
+// DefaultQueueSize is the maximum number of notifications that are
+// queued for a subscriber before the oldest ones are dropped.
+const DefaultQueueSize = 1000
+
+// Subscriber delivers notifications to a callback in the order in which
+// they were sent. Notifications are queued so that sending one never
+// blocks the sender on a slow callback. The queue is bounded and once it
+// is full the oldest notifications are dropped to make room for new ones.
+type Subscriber[T any] struct {
+	callback func(T)
+	maxQueue int
+	queue    []T
+	lagging  bool
+	signal   chan struct{}
+	mtx      sync.Mutex
+}
+
+// NewSubscriber returns a new Subscriber which queues up to maxQueue
+// notifications for the callback. Run must be called to start delivery.
+func NewSubscriber[T any](callback func(T), maxQueue int) *Subscriber[T] {
+	if maxQueue < 1 {
+		maxQueue = 1
+	}
+	return &Subscriber[T]{
+		callback: callback,
+		maxQueue: maxQueue,
+		signal:   make(chan struct{}, 1),
+	}
+}
+
+// Send queues the notification for delivery, dropping the oldest queued
+// notification if the queue is full. It returns true when the subscriber
+// starts dropping notifications so the caller can log it once rather than
+// on every dropped notification.
+func (s *Subscriber[T]) Send(n T) bool {
+	s.mtx.Lock()
+	startedDropping := false
+	if len(s.queue) >= s.maxQueue {
+		var zero T
+		s.queue[0] = zero
+		s.queue = s.queue[1:]
+		startedDropping = !s.lagging
+		s.lagging = true
+	}
+	s.queue = append(s.queue, n)
+	s.mtx.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+	return startedDropping
+}
+
+// Run delivers the queued notifications one at a time until quit
+// is closed.
+func (s *Subscriber[T]) Run(quit <-chan struct{}) {
+	for {
+		select {
+		case <-s.signal:
+		case <-quit:
+			return
+		}
+		for {
+			s.mtx.Lock()
+			if len(s.queue) == 0 {
+				s.lagging = false
+				s.mtx.Unlock()
+				break
+			}
+			n := s.queue[0]
+			var zero T
+			s.queue[0] = zero
+			s.queue = s.queue[1:]
+			s.mtx.Unlock()
+
+			s.callback(n)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package notify
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSubscriberOrder(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	out := make(chan int, 100)
+	sub := NewSubscriber(func(n int) { out <- n }, DefaultQueueSize)
+	go sub.Run(quit)
+
+	for i := 0; i < 100; i++ {
+		assert.False(t, sub.Send(i))
+	}
+	for i := 0; i < 100; i++ {
+		select {
+		case n := <-out:
+			assert.Equal(t, i, n)
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+}
+
+func TestSubscriberDropsOldest(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	block := make(chan struct{})
+	out := make(chan int, 10)
+	sub := NewSubscriber(func(n int) {
+		<-block
+		out <- n
+	}, 3)
+
+	// Nothing is delivered until Run is started so the
+	// queue fills up and the oldest are dropped.
+	assert.False(t, sub.Send(0))
+	assert.False(t, sub.Send(1))
+	assert.False(t, sub.Send(2))
+	assert.True(t, sub.Send(3))
+	assert.False(t, sub.Send(4))
+
+	go sub.Run(quit)
+	close(block)
+
+	for _, expected := range []int{2, 3, 4} {
+		select {
+		case n := <-out:
+			assert.Equal(t, expected, n)
+		case <-time.After(time.Second * 5):
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+
+	// Once the subscriber has caught up the next overflow
+	// will be reported again.
+	time.Sleep(time.Millisecond * 50)
+	sub.mtx.Lock()
+	assert.False(t, sub.lagging)
+	sub.mtx.Unlock()
+}
@@ -5,7 +5,9 @@
 package params
 
 import (
+	"encoding/hex"
 	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/project-illium/ilxd/params/hash"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"math"
@@ -180,3 +182,19 @@ var RegestParams = NetworkParams{
 	LongTermInflationRate:      math.Pow(1.02, 1.0/52) - 1, // Annualizes to 2% over 52 periods.
 	AllowMockProofs:            true,
 }
+
+// PrivateNetwork returns a copy of the params for use with a private
+// network secured by the given pre-shared key.
+//
+// The ProtocolPrefix is extended with a fingerprint of the key so that
+// the private network's protocols, DHT, and pubsub topics never overlap
+// with those of the public network, even if a node is misconfigured.
+// The seed addresses are cleared as the public seeds cannot be reached
+// from inside a private network.
+func (p *NetworkParams) PrivateNetwork(psk []byte) *NetworkParams {
+	fingerprint := hash.HashFunc(append([]byte("ilx-pnet"), psk...))
+	cpy := *p
+	cpy.ProtocolPrefix = protocol.ID(path.Join(string(p.ProtocolPrefix), "pnet", hex.EncodeToString(fingerprint[:8])))
+	cpy.SeedAddrs = nil
+	return &cpy
+}
@@ -64,11 +64,21 @@ type Config struct {
 	Regtest            bool          `short:"r" long:"regtest" description:"Use regression testing mode"`
 	RegtestVal         bool          `long:"regtestval" description:"Set self as the regtest genesis validator. This can only be done on first startup."`
 	DisableNATPortMap  bool          `long:"noupnp" description:"Disable use of upnp"`
+	EnableMDNS         bool          `long:"mdns" description:"Discover other nodes on the local network using mDNS"`
+	EnableWebsocket    bool          `long:"websocket" description:"Enable the WebSocket transport. A WebSocket listen address must also be added with listenaddr."`
+	EnableWebTransport bool          `long:"webtransport" description:"Enable the WebTransport transport. A WebTransport listen address must also be added with listenaddr."`
+	RelayService       bool          `long:"relayservice" description:"Act as a circuit relay for peers behind NATs. This should only be used by publicly reachable nodes."`
+	StaticRelays       []string      `long:"staticrelay" description:"A relay to use if the node is behind a NAT. If not set relays are found through the DHT."`
+	NoHolePunching     bool          `long:"noholepunch" description:"Disable hole punching for relayed connections"`
 	UserAgent          string        `long:"useragent" description:"A custom user agent to advertise to the network"`
 	NoTxIndex          bool          `long:"notxindex" description:"Disable the transaction index"`
 	DropTxIndex        bool          `long:"droptxindex" description:"Delete the tx index from the database"`
 	WSIndex            bool          `long:"wsindex" description:"Enable the wallet server index to serve lite wallets"`
 	DropWSIndex        bool          `long:"dropwsindex" description:"Delete the wallet server index from the database"`
+	WSIndexServeProofs bool          `long:"wsindexserveproofs" description:"Serve txo inclusion proofs from the wallet server index to peers over the p2p network. This lets any peer learn which commitments the index is tracking."`
+	MempoolExpiry      time.Duration `long:"mempoolexpiry" description:"The amount of time a transaction may remain in the mempool without being included in a block before it is discarded" default:"24h"`
+	NoPersistMempool   bool          `long:"nopersistmempool" description:"Do not save the mempool on shutdown and load it on startup"`
+	MaxMempool         uint32        `long:"maxmempool" description:"The maximum size of the mempool in megabytes. When it is exceeded the lowest fee rate transactions are evicted." default:"300"`
 	MaxBanscore        uint32        `long:"maxbanscore" description:"The maximum ban score a peer is allowed to have before getting banned" default:"100"`
 	BanDuration        time.Duration `long:"banduration" description:"The duration for which banned peers are banned for" default:"24h"`
 	WalletSeed         string        `long:"walletseed" description:"A mnemonic seed to initialize the node with. This can only be used on first startup."`
@@ -76,9 +86,12 @@ type Config struct {
 	NetworkKey         string        `long:"networkkey" description:"A network key to use for this node. This will override the node's peer ID."`
 	Prune              bool          `long:"prune" description:"Delete the blockchain from disk. The node will store just the date needed to validate new blocks."`
 	MockProofs         bool          `long:"mock" description:"Set the node to use mock proofs instead of full proofs. This option is only available for regtest."`
+	NetworkPSK         string        `long:"networkpsk" description:"A hex encoded 32 byte pre-shared key. If set the node will only connect to other nodes using the same key, creating a private network separate from the public network."`
 
-	Policy  Policy     `group:"Policy"`
-	RPCOpts RPCOptions `group:"RPC Options"`
+	Policy     Policy             `group:"Policy"`
+	RateLimits RateLimits         `group:"Rate Limits"`
+	ConnMgr    ConnManagerOptions `group:"Connection Manager"`
+	RPCOpts    RPCOptions         `group:"RPC Options"`
 }
 
 type Policy struct {
@@ -89,6 +102,22 @@ type Policy struct {
 	MaxMessageSize     int      `long:"maxmessagesize" description:"The maximum size of a network message. This is a hard limit. Setting this value different than all other nodes could fork you off the network."`
 }
 
+type RateLimits struct {
+	BlockRequestsPerMinute  uint32 `long:"ratelimitblocks" description:"The maximum number of block, block txs, block txids, and accumulator checkpoint requests per minute (each) the node will serve to a single peer" default:"600"`
+	QueryRequestsPerMinute  uint32 `long:"ratelimitqueries" description:"The maximum number of block ID, best block, and inclusion proof requests per minute (each) the node will serve to a single peer" default:"600"`
+	StreamRequestsPerMinute uint32 `long:"ratelimitstreams" description:"The maximum number of headers, block txs, and compressed blocks stream requests per minute (each) the node will serve to a single peer" default:"60"`
+	MaxConcurrentStreams    uint32 `long:"maxconcurrentstreams" description:"The maximum number of headers, block txs, and compressed blocks streams a single peer may have open at once" default:"2"`
+}
+
+type ConnManagerOptions struct {
+	MaxPeers           int           `long:"maxpeers" description:"The maximum number of peers. When exceeded, connections to non-validator, non-allowlisted peers are trimmed down to a quarter of this value." default:"400"`
+	MaxInbound         int           `long:"maxinbound" description:"The maximum number of inbound peers. Validators and allowlisted peers do not count against this limit. Defaults to maxpeers minus minoutbound."`
+	MinOutbound        int           `long:"minoutbound" description:"The number of outbound peers the node will try to maintain" default:"8"`
+	GracePeriod        time.Duration `long:"conngraceperiod" description:"The amount of time a new connection is protected from being trimmed" default:"1m"`
+	SilencePeriod      time.Duration `long:"connsilenceperiod" description:"The minimum amount of time between connection trims" default:"10s"`
+	ResourceLimitsFile string        `long:"rcmgrlimits" description:"A path to a JSON file of libp2p resource manager limits. Any limits set in the file override the node's defaults."`
+}
+
 type RPCOptions struct {
 	RPCCert                    string   `long:"rpccert" description:"A path to the SSL certificate to use with gRPC"`
 	RPCKey                     string   `long:"rpckey" description:"A path to the SSL key to use with gRPC"`
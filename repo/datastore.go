@@ -53,6 +53,14 @@ const (
 	PrunedBlockchainDatastoreKey = "/ilxd/pruned/"
 	// CachedAddrInfoDatastoreKey is the datastore key used to persist addrinfos from the peerstore.
 	CachedAddrInfoDatastoreKey = "/ilxd/peerstore/addrinfo/"
+	// AddrManagerDatastoreKey is the datastore key used to persist the address manager.
+	AddrManagerDatastoreKey = "/ilxd/addrmanager/"
+	// ForkDatastoreKeyPrefix is the datastore key prefix used to persist forks observed during sync.
+	ForkDatastoreKeyPrefix = "/ilxd/fork/"
+	// FeeEstimatorDatastoreKey is the datastore key used to persist the fee estimator.
+	FeeEstimatorDatastoreKey = "/ilxd/feeestimator/"
+	// FeeDeltaDatastoreKeyPrefix is the datastore key prefix used to persist mempool fee deltas.
+	FeeDeltaDatastoreKeyPrefix = "/ilxd/feedelta/"
 )
 
 type Datastore interface {
@@ -6,8 +6,11 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/consensus"
+	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/rpc/pb"
 	"github.com/project-illium/ilxd/types"
@@ -32,8 +35,11 @@ func (s *GrpcServer) GetMempoolInfo(ctx context.Context, req *pb.GetMempoolInfoR
 		bytes += n
 	}
 	return &pb.GetMempoolInfoResponse{
-		Size:  uint32(size),
-		Bytes: uint32(bytes),
+		Size:              uint32(size),
+		Bytes:             uint32(bytes),
+		MaxBytes:          uint64(s.txMemPool.MaxSize()),
+		MinFeePerKilobyte: uint64(s.txMemPool.MinFeePerKilobyte()),
+		Held:              uint32(s.txMemPool.HeldCount()),
 	}, nil
 }
 
@@ -64,6 +70,37 @@ func (s *GrpcServer) GetMempool(ctx context.Context, req *pb.GetMempoolRequest)
 	}, nil
 }
 
+// GetMempoolEntry returns metadata about a single transaction in the mempool.
+func (s *GrpcServer) GetMempoolEntry(ctx context.Context, req *pb.GetMempoolEntryRequest) (*pb.GetMempoolEntryResponse, error) {
+	entry, err := s.txMemPool.GetEntry(types.NewID(req.Transaction_ID))
+	if errors.Is(err, mempool.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "transaction not in mempool")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.GetMempoolEntryResponse{
+		Entry: mempoolEntryToPb(entry),
+	}, nil
+}
+
+// EstimateFee returns the fee per kilobyte a transaction should pay to
+// be confirmed within the target number of blocks. The estimate is based
+// on how long transactions in the mempool, grouped by fee per kilobyte,
+// have recently taken to be confirmed. It is never lower than the node's
+// minimum fee per kilobyte.
+func (s *GrpcServer) EstimateFee(ctx context.Context, req *pb.EstimateFeeRequest) (*pb.EstimateFeeResponse, error) {
+	if s.feeEstimator == nil {
+		return nil, status.Error(codes.Unavailable, "fee estimator is not enabled")
+	}
+	fpkb, err := s.feeEstimator.EstimateFeePerKilobyte(req.TargetBlocks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.EstimateFeeResponse{
+		FeePerKilobyte: uint64(fpkb),
+	}, nil
+}
+
 // GetBlockchainInfo returns data about the blockchain including the most recent block hash and height.
 func (s *GrpcServer) GetBlockchainInfo(ctx context.Context, req *pb.GetBlockchainInfoRequest) (*pb.GetBlockchainInfoResponse, error) {
 	var nt pb.GetBlockchainInfoResponse_Network
@@ -445,6 +482,114 @@ func (s *GrpcServer) SubmitTransaction(ctx context.Context, req *pb.SubmitTransa
 	}, nil
 }
 
+// TestTransaction validates a transaction against the mempool without adding
+// it to the mempool or broadcasting it.
+func (s *GrpcServer) TestTransaction(ctx context.Context, req *pb.TestTransactionRequest) (*pb.TestTransactionResponse, error) {
+	if req.Transaction == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction is nil")
+	}
+	result, err := s.txMemPool.TestTransaction(req.Transaction)
+
+	txid := req.Transaction.ID()
+	resp := &pb.TestTransactionResponse{
+		Transaction_ID: txid[:],
+		Allowed:        err == nil,
+		Fee:            uint64(result.Fee),
+		FeePerKilobyte: uint64(result.FeePerKilobyte),
+		Size:           uint32(result.Size),
+	}
+	if err != nil {
+		resp.RejectReason = err.Error()
+
+		var (
+			ruleErr   blockchain.RuleError
+			policyErr mempool.PolicyError
+		)
+		switch {
+		case errors.As(err, &ruleErr):
+			resp.ErrorType = pb.TestTransactionResponse_RULE
+			resp.ErrorCode = ruleErr.ErrorCode.String()
+		case errors.As(err, &policyErr):
+			resp.ErrorType = pb.TestTransactionResponse_POLICY
+			resp.ErrorCode = policyErr.ErrorCode.String()
+		default:
+			resp.ErrorType = pb.TestTransactionResponse_OTHER
+		}
+	}
+	return resp, nil
+}
+
+// SubscribeMempool returns a stream of notifications when transactions are added
+// to or removed from the mempool.
+func (s *GrpcServer) SubscribeMempool(req *pb.SubscribeMempoolRequest, stream pb.BlockchainService_SubscribeMempoolServer) error {
+	sub := s.subscribeEvents()
+	defer sub.Close()
+
+	for {
+		select {
+		case <-s.quit:
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case n := <-sub.C:
+			notif, ok := n.(*mempool.Notification)
+			if !ok {
+				continue
+			}
+			entry, ok := notif.Data.(*mempool.Entry)
+			if !ok {
+				continue
+			}
+			var event pb.MempoolNotification_Event
+			switch notif.Type {
+			case mempool.NTTransactionAdded:
+				event = pb.MempoolNotification_TX_ADDED
+			case mempool.NTTransactionRemovedByBlock:
+				event = pb.MempoolNotification_TX_REMOVED_BY_BLOCK
+			case mempool.NTTransactionExpired:
+				event = pb.MempoolNotification_TX_EXPIRED
+			case mempool.NTTransactionEvicted:
+				event = pb.MempoolNotification_TX_EVICTED
+			case mempool.NTTransactionStatusChanged:
+				event = pb.MempoolNotification_TX_STATUS_CHANGED
+			default:
+				continue
+			}
+			if err := stream.Send(&pb.MempoolNotification{
+				Event: event,
+				Entry: mempoolEntryToPb(entry),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func mempoolEntryToPb(entry *mempool.Entry) *pb.MempoolEntry {
+	e := &pb.MempoolEntry{
+		Transaction_ID: entry.ID.Bytes(),
+		Type:           entry.Type,
+		Size:           uint32(entry.Size),
+		Fee:            uint64(entry.Fee),
+		FeePerKilobyte: uint64(entry.FeePerKilobyte),
+		FeeDelta:       entry.FeeDelta,
+		EntryTime:      entry.Added.Unix(),
+	}
+	switch entry.Status {
+	case mempool.EntryContested:
+		e.Status = pb.MempoolEntry_CONTESTED
+	case mempool.EntryHeld:
+		e.Status = pb.MempoolEntry_HELD
+	default:
+		e.Status = pb.MempoolEntry_PENDING
+	}
+	if !entry.LocktimeStart.IsZero() {
+		e.LocktimeStart = entry.LocktimeStart.Unix()
+		e.LocktimeEnd = entry.LocktimeEnd.Unix()
+	}
+	return e
+}
+
 // SubscribeBlocks returns a stream of notifications when new blocks are finalized and connected to the chain.
 func (s *GrpcServer) SubscribeBlocks(req *pb.SubscribeBlocksRequest, stream pb.BlockchainService_SubscribeBlocksServer) error {
 	sub := s.subscribeEvents()
@@ -553,3 +698,49 @@ func (s *GrpcServer) SubscribeCompressedBlocks(req *pb.SubscribeCompressedBlocks
 		}
 	}
 }
+
+// SubscribeFinality returns a stream of notifications tracking blocks as they
+// move through consensus. A notification is sent when a block is first seen,
+// as its confidence progresses, and when it is finalized or rejected.
+func (s *GrpcServer) SubscribeFinality(req *pb.SubscribeFinalityRequest, stream pb.BlockchainService_SubscribeFinalityServer) error {
+	sub := s.subscribeEvents()
+	defer sub.Close()
+
+	for {
+		select {
+		case <-s.quit:
+			return nil
+		case n := <-sub.C:
+			if notif, ok := n.(*consensus.Notification); ok {
+				progress, ok := notif.Data.(*consensus.BlockProgress)
+				if !ok {
+					continue
+				}
+				var event pb.FinalityNotification_Event
+				switch notif.Type {
+				case consensus.NTBlockSeen:
+					event = pb.FinalityNotification_SEEN
+				case consensus.NTBlockConfidence:
+					event = pb.FinalityNotification_CONFIDENCE
+				case consensus.NTBlockFinalized:
+					event = pb.FinalityNotification_FINALIZED
+				case consensus.NTBlockRejected:
+					event = pb.FinalityNotification_REJECTED
+				default:
+					continue
+				}
+				resp := &pb.FinalityNotification{
+					Block_ID:          progress.BlockID[:],
+					Height:            progress.Height,
+					Event:             event,
+					Preferred:         progress.Status == consensus.StatusPreferred || progress.Status == consensus.StatusFinalized,
+					Confidence:        uint32(progress.Confidence),
+					FinalizationScore: consensus.AvalancheFinalizationScore,
+				}
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
@@ -6,13 +6,22 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/rpc/pb"
+	ilxsync "github.com/project-illium/ilxd/sync"
 	"github.com/project-illium/ilxd/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"math/rand"
+	gonet "net"
+	"os"
+	"sort"
+	"time"
 )
 
 // GetHostInfo returns info about the libp2p host
@@ -64,10 +73,21 @@ func (s *GrpcServer) GetPeers(ctx context.Context, req *pb.GetPeersRequest) (*pb
 		for _, addr := range maaddrs {
 			addrs = append(addrs, addr.String())
 		}
+		inbound := false
+		conns := s.network.Host().Network().ConnsToPeer(p)
+		if len(conns) > 0 {
+			inbound = conns[0].Stat().Direction == network.DirInbound
+		}
+		stats := s.network.BandwidthCounter().ForPeer(p)
 		ret = append(ret, &pb.Peer{
 			Id:        p.String(),
 			UserAgent: userAgentString,
 			Addrs:     addrs,
+			Inbound:   inbound,
+			BytesIn:   uint64(stats.TotalIn),
+			BytesOut:  uint64(stats.TotalOut),
+			RateIn:    uint64(stats.RateIn),
+			RateOut:   uint64(stats.RateOut),
 		})
 	}
 	return &pb.GetPeersResponse{
@@ -75,6 +95,225 @@ func (s *GrpcServer) GetPeers(ctx context.Context, req *pb.GetPeersRequest) (*pb
 	}, nil
 }
 
+// GetNetTotals returns the total number of bytes sent and received by
+// the node along with the current bandwidth rates. The totals are also
+// broken down by protocol.
+func (s *GrpcServer) GetNetTotals(ctx context.Context, req *pb.GetNetTotalsRequest) (*pb.GetNetTotalsResponse, error) {
+	bwc := s.network.BandwidthCounter()
+	totals := bwc.Totals()
+	byCategory := bwc.ByCategory()
+
+	categories := []string{
+		net.BandwidthCategoryBlocks,
+		net.BandwidthCategoryTransactions,
+		net.BandwidthCategoryPubsub,
+		net.BandwidthCategoryConsensus,
+		net.BandwidthCategoryChainService,
+		net.BandwidthCategoryDHT,
+		net.BandwidthCategoryOther,
+	}
+	protocols := make([]*pb.GetNetTotalsResponse_ProtocolBandwidth, 0, len(categories))
+	for _, category := range categories {
+		stats := byCategory[category]
+		protocols = append(protocols, &pb.GetNetTotalsResponse_ProtocolBandwidth{
+			Protocol: category,
+			BytesIn:  uint64(stats.TotalIn),
+			BytesOut: uint64(stats.TotalOut),
+			RateIn:   uint64(stats.RateIn),
+			RateOut:  uint64(stats.RateOut),
+		})
+	}
+
+	return &pb.GetNetTotalsResponse{
+		BytesIn:   uint64(totals.TotalIn),
+		BytesOut:  uint64(totals.TotalOut),
+		RateIn:    uint64(totals.RateIn),
+		RateOut:   uint64(totals.RateOut),
+		Protocols: protocols,
+	}, nil
+}
+
+// GetSyncStatus returns the progress of the node's sync to the tip of
+// the chain, including the estimated network height, the peers being
+// synced from, the sync rate, and the estimated time remaining.
+func (s *GrpcServer) GetSyncStatus(ctx context.Context, req *pb.GetSyncStatusRequest) (*pb.GetSyncStatusResponse, error) {
+	if s.syncStatusFunc == nil {
+		return nil, status.Error(codes.Unavailable, "sync status is not available")
+	}
+	return &pb.GetSyncStatusResponse{
+		Status: syncStatusToPb(s.syncStatusFunc()),
+	}, nil
+}
+
+// SubscribeSyncStatus returns a stream of notifications with the sync
+// status. The current status is sent immediately and then again each
+// time it changes.
+func (s *GrpcServer) SubscribeSyncStatus(req *pb.SubscribeSyncStatusRequest, stream pb.NodeService_SubscribeSyncStatusServer) error {
+	if s.syncStatusFunc == nil {
+		return status.Error(codes.Unavailable, "sync status is not available")
+	}
+	interval := time.Second * 5
+	if req.IntervalSeconds > 0 {
+		interval = time.Second * time.Duration(req.IntervalSeconds)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *pb.SyncStatus
+	for {
+		current := syncStatusToPb(s.syncStatusFunc())
+		if last == nil || !proto.Equal(current, last) {
+			if err := stream.Send(&pb.SyncStatusNotification{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-s.quit:
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func syncStatusToPb(ss *ilxsync.SyncStatus) *pb.SyncStatus {
+	syncPeers := make([]string, 0, len(ss.SyncPeers))
+	for _, p := range ss.SyncPeers {
+		syncPeers = append(syncPeers, p.String())
+	}
+	buckets := make([]*pb.SyncStatus_PeerBucket, 0, len(ss.Buckets))
+	for _, b := range ss.Buckets {
+		peers := make([]string, 0, len(b.Peers))
+		for _, p := range b.Peers {
+			peers = append(peers, p.String())
+		}
+		buckets = append(buckets, &pb.SyncStatus_PeerBucket{
+			BestBlock_ID: b.BestID.Bytes(),
+			Height:       b.Height,
+			Peers:        peers,
+		})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return len(buckets[i].Peers) > len(buckets[j].Peers)
+	})
+	return &pb.SyncStatus{
+		Current:         ss.Current,
+		Height:          ss.Height,
+		NetworkHeight:   ss.NetworkHeight,
+		SyncPeers:       syncPeers,
+		Buckets:         buckets,
+		BlocksPerSecond: ss.BlocksPerSecond,
+		EtaSeconds:      uint64(ss.ETA.Seconds()),
+	}
+}
+
+// GetChainTips returns the tip of the node's best chain along with the
+// tips of any competing chains seen in forks during the sync.
+func (s *GrpcServer) GetChainTips(ctx context.Context, req *pb.GetChainTipsRequest) (*pb.GetChainTipsResponse, error) {
+	if s.syncManager == nil {
+		return nil, status.Error(codes.Unavailable, "sync manager is not available")
+	}
+	tips, err := s.syncManager.ChainTips()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.GetChainTipsResponse{
+		Tips: make([]*pb.ChainTip, 0, len(tips)),
+	}
+	for _, tip := range tips {
+		var tipStatus pb.ChainTip_Status
+		switch tip.Status {
+		case ilxsync.ChainTipActive:
+			tipStatus = pb.ChainTip_ACTIVE
+		case ilxsync.ChainTipSelected:
+			tipStatus = pb.ChainTip_SELECTED
+		case ilxsync.ChainTipRejected:
+			tipStatus = pb.ChainTip_REJECTED
+		}
+		resp.Tips = append(resp.Tips, &pb.ChainTip{
+			Block_ID:     tip.BlockID[:],
+			Height:       tip.Height,
+			BranchLength: tip.BranchLength,
+			Status:       tipStatus,
+		})
+	}
+	return resp, nil
+}
+
+// GetForks returns the forks the node has observed while syncing, most
+// recent first.
+func (s *GrpcServer) GetForks(ctx context.Context, req *pb.GetForksRequest) (*pb.GetForksResponse, error) {
+	if s.syncManager == nil {
+		return nil, status.Error(codes.Unavailable, "sync manager is not available")
+	}
+	forks, err := s.syncManager.Forks()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.GetForksResponse{
+		Forks: make([]*pb.Fork, 0, len(forks)),
+	}
+	for _, fork := range forks {
+		resp.Forks = append(resp.Forks, forkToPb(fork))
+	}
+	return resp, nil
+}
+
+// SubscribeForks returns a stream of notifications when the node finds
+// peers following competing chains.
+func (s *GrpcServer) SubscribeForks(req *pb.SubscribeForksRequest, stream pb.NodeService_SubscribeForksServer) error {
+	if s.syncManager == nil {
+		return status.Error(codes.Unavailable, "sync manager is not available")
+	}
+	sub := s.subscribeEvents()
+	defer sub.Close()
+
+	for {
+		select {
+		case <-s.quit:
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case n := <-sub.C:
+			if notif, ok := n.(*ilxsync.Notification); ok && notif.Type == ilxsync.NTForkDetected {
+				fork, ok := notif.Data.(*ilxsync.Fork)
+				if !ok {
+					continue
+				}
+				if err := stream.Send(&pb.ForkNotification{Fork: forkToPb(fork)}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func forkToPb(fork *ilxsync.Fork) *pb.Fork {
+	tips := make([]*pb.Fork_Tip, 0, len(fork.Tips))
+	for _, tip := range fork.Tips {
+		peers := make([]string, 0, len(tip.Peers))
+		for _, p := range tip.Peers {
+			peers = append(peers, p.String())
+		}
+		tips = append(tips, &pb.Fork_Tip{
+			Block_ID:   tip.BlockID.Bytes(),
+			Height:     tip.Height,
+			Peers:      peers,
+			ChainScore: float64(tip.Score),
+		})
+	}
+	return &pb.Fork{
+		ForkBlock_ID:      fork.ForkBlock.Bytes(),
+		ForkHeight:        fork.ForkHeight,
+		Tips:              tips,
+		Winner:            fork.Winner.Bytes(),
+		ChosenByConsensus: fork.ChosenByConsensus,
+		Timestamp:         fork.Timestamp.Unix(),
+	}
+}
+
 // AddPeer attempts to connect to the provided peer
 func (s *GrpcServer) AddPeer(ctx context.Context, req *pb.AddPeerRequest) (*pb.AddPeerResponse, error) {
 	peerID, err := peer.Decode(req.Peer_ID)
@@ -114,6 +353,124 @@ func (s *GrpcServer) UnblockPeer(ctx context.Context, req *pb.UnblockPeerRequest
 	return &pb.UnblockPeerResponse{}, nil
 }
 
+// BlockSubnet blocks all IP addresses in the given subnet for the provided
+// time period
+func (s *GrpcServer) BlockSubnet(ctx context.Context, req *pb.BlockSubnetRequest) (*pb.BlockSubnetResponse, error) {
+	_, ipnet, err := gonet.ParseCIDR(req.Subnet)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.network.ConnGater().BlockSubnet(ipnet, time.Second*time.Duration(req.Duration), req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.BlockSubnetResponse{}, nil
+}
+
+// UnblockSubnet removes a subnet from the block list
+func (s *GrpcServer) UnblockSubnet(ctx context.Context, req *pb.UnblockSubnetRequest) (*pb.UnblockSubnetResponse, error) {
+	_, ipnet, err := gonet.ParseCIDR(req.Subnet)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.network.ConnGater().UnblockSubnet(ipnet)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.UnblockSubnetResponse{}, nil
+}
+
+// ListBans returns the active peer, IP address, and subnet bans along with
+// the reason for and expiration of each ban.
+func (s *GrpcServer) ListBans(ctx context.Context, req *pb.ListBansRequest) (*pb.ListBansResponse, error) {
+	bans := s.network.ConnGater().ListBans()
+	ret := make([]*pb.ListBansResponse_Ban, 0, len(bans))
+	for _, ban := range bans {
+		b := &pb.ListBansResponse_Ban{
+			Reason:     ban.Reason,
+			Expiration: ban.Expiration.Unix(),
+		}
+		if ban.Peer != "" {
+			b.Peer_ID = ban.Peer.String()
+		}
+		if ban.Subnet != nil {
+			b.Subnet = ban.Subnet.String()
+		}
+		ret = append(ret, b)
+	}
+	return &pb.ListBansResponse{
+		Bans: ret,
+	}, nil
+}
+
+// AddAllowlist adds a peer ID or subnet to the allowlist. Allowlisted peers
+// bypass all bans, are exempt from banscore, and are protected from being
+// disconnected when the node is over its connection limits.
+func (s *GrpcServer) AddAllowlist(ctx context.Context, req *pb.AddAllowlistRequest) (*pb.AddAllowlistResponse, error) {
+	switch entry := req.Entry.(type) {
+	case *pb.AddAllowlistRequest_Peer_ID:
+		peerID, err := peer.Decode(entry.Peer_ID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.network.AllowPeer(peerID); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case *pb.AddAllowlistRequest_Subnet:
+		_, ipnet, err := gonet.ParseCIDR(entry.Subnet)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.network.AllowSubnet(ipnet); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "peer ID or subnet required")
+	}
+	return &pb.AddAllowlistResponse{}, nil
+}
+
+// RemoveAllowlist removes a peer ID or subnet from the allowlist
+func (s *GrpcServer) RemoveAllowlist(ctx context.Context, req *pb.RemoveAllowlistRequest) (*pb.RemoveAllowlistResponse, error) {
+	switch entry := req.Entry.(type) {
+	case *pb.RemoveAllowlistRequest_Peer_ID:
+		peerID, err := peer.Decode(entry.Peer_ID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.network.RemoveAllowedPeer(peerID); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case *pb.RemoveAllowlistRequest_Subnet:
+		_, ipnet, err := gonet.ParseCIDR(entry.Subnet)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.network.RemoveAllowedSubnet(ipnet); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "peer ID or subnet required")
+	}
+	return &pb.RemoveAllowlistResponse{}, nil
+}
+
+// ListAllowlist returns the allowlisted peer IDs and subnets
+func (s *GrpcServer) ListAllowlist(ctx context.Context, req *pb.ListAllowlistRequest) (*pb.ListAllowlistResponse, error) {
+	peers, subnets := s.network.ConnGater().ListAllowlist()
+	resp := &pb.ListAllowlistResponse{
+		Peer_IDs: make([]string, 0, len(peers)),
+		Subnets:  make([]string, 0, len(subnets)),
+	}
+	for _, p := range peers {
+		resp.Peer_IDs = append(resp.Peer_IDs, p.String())
+	}
+	for _, ipnet := range subnets {
+		resp.Subnets = append(resp.Subnets, ipnet.String())
+	}
+	return resp, nil
+}
+
 // SetLogLevel changes the logging level of the node
 func (s *GrpcServer) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
 	var logLevelSeverity = map[pb.SetLogLevelRequest_Level]string{
@@ -229,3 +586,48 @@ func (s *GrpcServer) RecomputeChainState(ctx context.Context, req *pb.RecomputeC
 	go s.reindexChainFunc() //nolint:errcheck
 	return &pb.RecomputeChainStateResponse{}, nil
 }
+
+// SaveMempool writes the mempool to a file in the node's data directory.
+func (s *GrpcServer) SaveMempool(ctx context.Context, req *pb.SaveMempoolRequest) (*pb.SaveMempoolResponse, error) {
+	if s.saveMempoolFunc == nil {
+		return nil, status.Error(codes.Unavailable, "mempool persistence is not available")
+	}
+	n, err := s.saveMempoolFunc()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.SaveMempoolResponse{
+		NumTransactions: uint32(n),
+	}, nil
+}
+
+// LoadMempool loads the mempool saved in the node's data directory. Each
+// transaction is revalidated and those that are no longer valid or have
+// expired are discarded.
+func (s *GrpcServer) LoadMempool(ctx context.Context, req *pb.LoadMempoolRequest) (*pb.LoadMempoolResponse, error) {
+	if s.loadMempoolFunc == nil {
+		return nil, status.Error(codes.Unavailable, "mempool persistence is not available")
+	}
+	n, err := s.loadMempoolFunc()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, status.Error(codes.NotFound, "no saved mempool")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.LoadMempoolResponse{
+		NumTransactions: uint32(n),
+	}, nil
+}
+
+// PrioritiseTransaction adds a fee delta to a transaction which is used when
+// comparing it against other transactions in the mempool and when selecting
+// transactions for a block.
+func (s *GrpcServer) PrioritiseTransaction(ctx context.Context, req *pb.PrioritiseTransactionRequest) (*pb.PrioritiseTransactionResponse, error) {
+	total, err := s.txMemPool.PrioritiseTransaction(types.NewID(req.Transaction_ID), req.FeeDelta)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.PrioritiseTransactionResponse{
+		FeeDelta: total,
+	}, nil
+}
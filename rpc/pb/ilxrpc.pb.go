@@ -77,7 +77,65 @@ func (x GetBlockchainInfoResponse_Network) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetBlockchainInfoResponse_Network.Descriptor instead.
 func (GetBlockchainInfoResponse_Network) EnumDescriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{5, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// The type of error which caused the transaction to be rejected
+type TestTransactionResponse_ErrorType int32
+
+const (
+	// The transaction is allowed
+	TestTransactionResponse_NONE TestTransactionResponse_ErrorType = 0
+	// The transaction violates the consensus rules
+	TestTransactionResponse_RULE TestTransactionResponse_ErrorType = 1
+	// The transaction violates the node's mempool policy
+	TestTransactionResponse_POLICY TestTransactionResponse_ErrorType = 2
+	// The transaction was rejected for another reason, such
+	// as being a duplicate of a transaction in the mempool
+	TestTransactionResponse_OTHER TestTransactionResponse_ErrorType = 3
+)
+
+// Enum value maps for TestTransactionResponse_ErrorType.
+var (
+	TestTransactionResponse_ErrorType_name = map[int32]string{
+		0: "NONE",
+		1: "RULE",
+		2: "POLICY",
+		3: "OTHER",
+	}
+	TestTransactionResponse_ErrorType_value = map[string]int32{
+		"NONE":   0,
+		"RULE":   1,
+		"POLICY": 2,
+		"OTHER":  3,
+	}
+)
+
+func (x TestTransactionResponse_ErrorType) Enum() *TestTransactionResponse_ErrorType {
+	p := new(TestTransactionResponse_ErrorType)
+	*p = x
+	return p
+}
+
+func (x TestTransactionResponse_ErrorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestTransactionResponse_ErrorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[1].Descriptor()
+}
+
+func (TestTransactionResponse_ErrorType) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[1]
+}
+
+func (x TestTransactionResponse_ErrorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestTransactionResponse_ErrorType.Descriptor instead.
+func (TestTransactionResponse_ErrorType) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{35, 0}
 }
 
 type SetLogLevelRequest_Level int32
@@ -122,11 +180,11 @@ func (x SetLogLevelRequest_Level) String() string {
 }
 
 func (SetLogLevelRequest_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_ilxrpc_proto_enumTypes[1].Descriptor()
+	return file_ilxrpc_proto_enumTypes[2].Descriptor()
 }
 
 func (SetLogLevelRequest_Level) Type() protoreflect.EnumType {
-	return &file_ilxrpc_proto_enumTypes[1]
+	return &file_ilxrpc_proto_enumTypes[2]
 }
 
 func (x SetLogLevelRequest_Level) Number() protoreflect.EnumNumber {
@@ -135,7 +193,239 @@ func (x SetLogLevelRequest_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SetLogLevelRequest_Level.Descriptor instead.
 func (SetLogLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{111, 0}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{143, 0}
+}
+
+type FinalityNotification_Event int32
+
+const (
+	// The block was seen by the consensus engine for the first time
+	FinalityNotification_SEEN FinalityNotification_Event = 0
+	// The block's preference or confidence changed
+	FinalityNotification_CONFIDENCE FinalityNotification_Event = 1
+	// The block was finalized
+	FinalityNotification_FINALIZED FinalityNotification_Event = 2
+	// The block was rejected because a conflicting block finalized
+	FinalityNotification_REJECTED FinalityNotification_Event = 3
+)
+
+// Enum value maps for FinalityNotification_Event.
+var (
+	FinalityNotification_Event_name = map[int32]string{
+		0: "SEEN",
+		1: "CONFIDENCE",
+		2: "FINALIZED",
+		3: "REJECTED",
+	}
+	FinalityNotification_Event_value = map[string]int32{
+		"SEEN":       0,
+		"CONFIDENCE": 1,
+		"FINALIZED":  2,
+		"REJECTED":   3,
+	}
+)
+
+func (x FinalityNotification_Event) Enum() *FinalityNotification_Event {
+	p := new(FinalityNotification_Event)
+	*p = x
+	return p
+}
+
+func (x FinalityNotification_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FinalityNotification_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[3].Descriptor()
+}
+
+func (FinalityNotification_Event) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[3]
+}
+
+func (x FinalityNotification_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FinalityNotification_Event.Descriptor instead.
+func (FinalityNotification_Event) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{176, 0}
+}
+
+// The kind of mempool event
+type MempoolNotification_Event int32
+
+const (
+	// The transaction was added to the mempool. A transaction held
+	// until its locktime window opens is sent again when it is released.
+	MempoolNotification_TX_ADDED MempoolNotification_Event = 0
+	// The transaction, or another transaction spending the same
+	// nullifiers, was included in a block
+	MempoolNotification_TX_REMOVED_BY_BLOCK MempoolNotification_Event = 1
+	// The transaction was in the mempool longer than the
+	// transaction TTL or its locktime window passed
+	MempoolNotification_TX_EXPIRED MempoolNotification_Event = 2
+	// The transaction was evicted from a full mempool, replaced by
+	// a transaction paying a higher fee, or lost a conflict vote
+	MempoolNotification_TX_EVICTED MempoolNotification_Event = 3
+	// The transaction was a contested spend which won the conflict
+	// vote and moved into the pool, or was a spend in the pool which
+	// a replacement paying a higher fee moved out of it while they
+	// are voted on. The entry has the new status.
+	MempoolNotification_TX_STATUS_CHANGED MempoolNotification_Event = 4
+)
+
+// Enum value maps for MempoolNotification_Event.
+var (
+	MempoolNotification_Event_name = map[int32]string{
+		0: "TX_ADDED",
+		1: "TX_REMOVED_BY_BLOCK",
+		2: "TX_EXPIRED",
+		3: "TX_EVICTED",
+		4: "TX_STATUS_CHANGED",
+	}
+	MempoolNotification_Event_value = map[string]int32{
+		"TX_ADDED":            0,
+		"TX_REMOVED_BY_BLOCK": 1,
+		"TX_EXPIRED":          2,
+		"TX_EVICTED":          3,
+		"TX_STATUS_CHANGED":   4,
+	}
+)
+
+func (x MempoolNotification_Event) Enum() *MempoolNotification_Event {
+	p := new(MempoolNotification_Event)
+	*p = x
+	return p
+}
+
+func (x MempoolNotification_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MempoolNotification_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[4].Descriptor()
+}
+
+func (MempoolNotification_Event) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[4]
+}
+
+func (x MempoolNotification_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MempoolNotification_Event.Descriptor instead.
+func (MempoolNotification_Event) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{179, 0}
+}
+
+// Where the transaction is held in the mempool
+type MempoolEntry_Status int32
+
+const (
+	// The transaction is eligible for inclusion in a block
+	MempoolEntry_PENDING MempoolEntry_Status = 0
+	// The transaction spends the same nullifier as another
+	// transaction and is being voted on by the consensus engine
+	MempoolEntry_CONTESTED MempoolEntry_Status = 1
+	// The transaction's locktime window has not yet opened. It
+	// will be released into the pool when it does.
+	MempoolEntry_HELD MempoolEntry_Status = 2
+)
+
+// Enum value maps for MempoolEntry_Status.
+var (
+	MempoolEntry_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "CONTESTED",
+		2: "HELD",
+	}
+	MempoolEntry_Status_value = map[string]int32{
+		"PENDING":   0,
+		"CONTESTED": 1,
+		"HELD":      2,
+	}
+)
+
+func (x MempoolEntry_Status) Enum() *MempoolEntry_Status {
+	p := new(MempoolEntry_Status)
+	*p = x
+	return p
+}
+
+func (x MempoolEntry_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MempoolEntry_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[5].Descriptor()
+}
+
+func (MempoolEntry_Status) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[5]
+}
+
+func (x MempoolEntry_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MempoolEntry_Status.Descriptor instead.
+func (MempoolEntry_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{180, 0}
+}
+
+type ChainTip_Status int32
+
+const (
+	// The tip of the node's best chain
+	ChainTip_ACTIVE ChainTip_Status = 0
+	// The tip of a chain that won a fork but has not
+	// yet been connected
+	ChainTip_SELECTED ChainTip_Status = 1
+	// The tip of a chain that lost a fork
+	ChainTip_REJECTED ChainTip_Status = 2
+)
+
+// Enum value maps for ChainTip_Status.
+var (
+	ChainTip_Status_name = map[int32]string{
+		0: "ACTIVE",
+		1: "SELECTED",
+		2: "REJECTED",
+	}
+	ChainTip_Status_value = map[string]int32{
+		"ACTIVE":   0,
+		"SELECTED": 1,
+		"REJECTED": 2,
+	}
+)
+
+func (x ChainTip_Status) Enum() *ChainTip_Status {
+	p := new(ChainTip_Status)
+	*p = x
+	return p
+}
+
+func (x ChainTip_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChainTip_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_ilxrpc_proto_enumTypes[6].Descriptor()
+}
+
+func (ChainTip_Status) Type() protoreflect.EnumType {
+	return &file_ilxrpc_proto_enumTypes[6]
+}
+
+func (x ChainTip_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChainTip_Status.Descriptor instead.
+func (ChainTip_Status) EnumDescriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{192, 0}
 }
 
 // BlockchainService
@@ -186,6 +476,17 @@ type GetMempoolInfoResponse struct {
 	Size uint32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
 	// The size in bytes of all transactions in the mempool
 	Bytes uint32 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// The maximum size in bytes of the mempool. When it is exceeded
+	// the lowest fee rate transactions are evicted. Zero means the
+	// mempool is unbounded.
+	MaxBytes uint64 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// The minimum fee per kilobyte a transaction must currently pay
+	// to enter the mempool. This rises above the node's policy minimum
+	// when transactions are evicted from a full mempool.
+	MinFeePerKilobyte uint64 `protobuf:"varint,4,opt,name=min_fee_per_kilobyte,json=minFeePerKilobyte,proto3" json:"min_fee_per_kilobyte,omitempty"`
+	// The count of transactions held until their locktime window
+	// opens. These are not included in size.
+	Held uint32 `protobuf:"varint,5,opt,name=held,proto3" json:"held,omitempty"`
 }
 
 func (x *GetMempoolInfoResponse) Reset() {
@@ -234,6 +535,27 @@ func (x *GetMempoolInfoResponse) GetBytes() uint32 {
 	return 0
 }
 
+func (x *GetMempoolInfoResponse) GetMaxBytes() uint64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *GetMempoolInfoResponse) GetMinFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.MinFeePerKilobyte
+	}
+	return 0
+}
+
+func (x *GetMempoolInfoResponse) GetHeld() uint32 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
 type GetMempoolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -331,14 +653,17 @@ func (x *GetMempoolResponse) GetTransactionData() []*TransactionData {
 	return nil
 }
 
-type GetBlockchainInfoRequest struct {
+type GetMempoolEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// A transaction hash
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
 }
 
-func (x *GetBlockchainInfoRequest) Reset() {
-	*x = GetBlockchainInfoRequest{}
+func (x *GetMempoolEntryRequest) Reset() {
+	*x = GetMempoolEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ilxrpc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,13 +671,13 @@ func (x *GetBlockchainInfoRequest) Reset() {
 	}
 }
 
-func (x *GetBlockchainInfoRequest) String() string {
+func (x *GetMempoolEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockchainInfoRequest) ProtoMessage() {}
+func (*GetMempoolEntryRequest) ProtoMessage() {}
 
-func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMempoolEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ilxrpc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -364,36 +689,29 @@ func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockchainInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMempoolEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetMempoolEntryRequest) Descriptor() ([]byte, []int) {
 	return file_ilxrpc_proto_rawDescGZIP(), []int{4}
 }
 
-type GetBlockchainInfoResponse struct {
+func (x *GetMempoolEntryRequest) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+type GetMempoolEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Which network the node is operating on
-	Network GetBlockchainInfoResponse_Network `protobuf:"varint,1,opt,name=network,proto3,enum=pb.GetBlockchainInfoResponse_Network" json:"network,omitempty"`
-	// The current number of blocks in the chain
-	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
-	// The hash of the best (tip) block in the chain
-	BestBlock_ID []byte `protobuf:"bytes,3,opt,name=best_block_ID,json=bestBlockID,proto3" json:"best_block_ID,omitempty"`
-	// The timestamp of the best block
-	BlockTime int64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	// When `tx_index` is true, the node has full transaction index enabled
-	TxIndex bool `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
-	// The total number of coins in circulation
-	CirculatingSupply uint64 `protobuf:"varint,6,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
-	// The total number of coins staked
-	TotalStaked uint64 `protobuf:"varint,7,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
-	// The balance of the treasury
-	TreasuryBalance uint64 `protobuf:"varint,8,opt,name=treasury_balance,json=treasuryBalance,proto3" json:"treasury_balance,omitempty"`
+	// The mempool entry for the transaction
+	Entry *MempoolEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *GetBlockchainInfoResponse) Reset() {
-	*x = GetBlockchainInfoResponse{}
+func (x *GetMempoolEntryResponse) Reset() {
+	*x = GetMempoolEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ilxrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,13 +719,13 @@ func (x *GetBlockchainInfoResponse) Reset() {
 	}
 }
 
-func (x *GetBlockchainInfoResponse) String() string {
+func (x *GetMempoolEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockchainInfoResponse) ProtoMessage() {}
+func (*GetMempoolEntryResponse) ProtoMessage() {}
 
-func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMempoolEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ilxrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -419,96 +737,93 @@ func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMempoolEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetMempoolEntryResponse) Descriptor() ([]byte, []int) {
 	return file_ilxrpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBlockchainInfoResponse) GetNetwork() GetBlockchainInfoResponse_Network {
+func (x *GetMempoolEntryResponse) GetEntry() *MempoolEntry {
 	if x != nil {
-		return x.Network
+		return x.Entry
 	}
-	return GetBlockchainInfoResponse_MAINNET
+	return nil
 }
 
-func (x *GetBlockchainInfoResponse) GetBestHeight() uint32 {
-	if x != nil {
-		return x.BestHeight
-	}
-	return 0
-}
+type EstimateFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetBlockchainInfoResponse) GetBestBlock_ID() []byte {
-	if x != nil {
-		return x.BestBlock_ID
-	}
-	return nil
+	// The number of blocks within which the transaction should
+	// be confirmed. Must be between 1 and 25.
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
 }
 
-func (x *GetBlockchainInfoResponse) GetBlockTime() int64 {
-	if x != nil {
-		return x.BlockTime
+func (x *EstimateFeeRequest) Reset() {
+	*x = EstimateFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetBlockchainInfoResponse) GetTxIndex() bool {
-	if x != nil {
-		return x.TxIndex
-	}
-	return false
+func (x *EstimateFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetBlockchainInfoResponse) GetCirculatingSupply() uint64 {
-	if x != nil {
-		return x.CirculatingSupply
+func (*EstimateFeeRequest) ProtoMessage() {}
+
+func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetBlockchainInfoResponse) GetTotalStaked() uint64 {
-	if x != nil {
-		return x.TotalStaked
-	}
-	return 0
+// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetBlockchainInfoResponse) GetTreasuryBalance() uint64 {
+func (x *EstimateFeeRequest) GetTargetBlocks() uint32 {
 	if x != nil {
-		return x.TreasuryBalance
+		return x.TargetBlocks
 	}
 	return 0
 }
 
-type GetBlockInfoRequest struct {
+type EstimateFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to IdOrHeight:
-	//
-	//	*GetBlockInfoRequest_Block_ID
-	//	*GetBlockInfoRequest_Height
-	IdOrHeight isGetBlockInfoRequest_IdOrHeight `protobuf_oneof:"id_or_height"`
+	// The estimated fee per kilobyte.
+	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *GetBlockInfoRequest) Reset() {
-	*x = GetBlockInfoRequest{}
+func (x *EstimateFeeResponse) Reset() {
+	*x = EstimateFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[6]
+		mi := &file_ilxrpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockInfoRequest) String() string {
+func (x *EstimateFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockInfoRequest) ProtoMessage() {}
+func (*EstimateFeeResponse) ProtoMessage() {}
 
-func (x *GetBlockInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[6]
+func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,12 +834,212 @@ func (x *GetBlockInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EstimateFeeResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{7}
 }
 
-func (m *GetBlockInfoRequest) GetIdOrHeight() isGetBlockInfoRequest_IdOrHeight {
+func (x *EstimateFeeResponse) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+type GetBlockchainInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBlockchainInfoRequest) Reset() {
+	*x = GetBlockchainInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockchainInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockchainInfoRequest) ProtoMessage() {}
+
+func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockchainInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{8}
+}
+
+type GetBlockchainInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Which network the node is operating on
+	Network GetBlockchainInfoResponse_Network `protobuf:"varint,1,opt,name=network,proto3,enum=pb.GetBlockchainInfoResponse_Network" json:"network,omitempty"`
+	// The current number of blocks in the chain
+	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// The hash of the best (tip) block in the chain
+	BestBlock_ID []byte `protobuf:"bytes,3,opt,name=best_block_ID,json=bestBlockID,proto3" json:"best_block_ID,omitempty"`
+	// The timestamp of the best block
+	BlockTime int64 `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// When `tx_index` is true, the node has full transaction index enabled
+	TxIndex bool `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// The total number of coins in circulation
+	CirculatingSupply uint64 `protobuf:"varint,6,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"`
+	// The total number of coins staked
+	TotalStaked uint64 `protobuf:"varint,7,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	// The balance of the treasury
+	TreasuryBalance uint64 `protobuf:"varint,8,opt,name=treasury_balance,json=treasuryBalance,proto3" json:"treasury_balance,omitempty"`
+}
+
+func (x *GetBlockchainInfoResponse) Reset() {
+	*x = GetBlockchainInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockchainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockchainInfoResponse) ProtoMessage() {}
+
+func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBlockchainInfoResponse) GetNetwork() GetBlockchainInfoResponse_Network {
+	if x != nil {
+		return x.Network
+	}
+	return GetBlockchainInfoResponse_MAINNET
+}
+
+func (x *GetBlockchainInfoResponse) GetBestHeight() uint32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetBestBlock_ID() []byte {
+	if x != nil {
+		return x.BestBlock_ID
+	}
+	return nil
+}
+
+func (x *GetBlockchainInfoResponse) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetTxIndex() bool {
+	if x != nil {
+		return x.TxIndex
+	}
+	return false
+}
+
+func (x *GetBlockchainInfoResponse) GetCirculatingSupply() uint64 {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetTotalStaked() uint64 {
+	if x != nil {
+		return x.TotalStaked
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetTreasuryBalance() uint64 {
+	if x != nil {
+		return x.TreasuryBalance
+	}
+	return 0
+}
+
+type GetBlockInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to IdOrHeight:
+	//
+	//	*GetBlockInfoRequest_Block_ID
+	//	*GetBlockInfoRequest_Height
+	IdOrHeight isGetBlockInfoRequest_IdOrHeight `protobuf_oneof:"id_or_height"`
+}
+
+func (x *GetBlockInfoRequest) Reset() {
+	*x = GetBlockInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockInfoRequest) ProtoMessage() {}
+
+func (x *GetBlockInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockInfoRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *GetBlockInfoRequest) GetIdOrHeight() isGetBlockInfoRequest_IdOrHeight {
 	if m != nil {
 		return m.IdOrHeight
 	}
@@ -575,7 +1090,7 @@ type GetBlockInfoResponse struct {
 func (x *GetBlockInfoResponse) Reset() {
 	*x = GetBlockInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[7]
+		mi := &file_ilxrpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +1103,7 @@ func (x *GetBlockInfoResponse) String() string {
 func (*GetBlockInfoResponse) ProtoMessage() {}
 
 func (x *GetBlockInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[7]
+	mi := &file_ilxrpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +1116,7 @@ func (x *GetBlockInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{7}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetBlockInfoResponse) GetInfo() *BlockInfo {
@@ -626,7 +1141,7 @@ type GetBlockRequest struct {
 func (x *GetBlockRequest) Reset() {
 	*x = GetBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[8]
+		mi := &file_ilxrpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -639,7 +1154,7 @@ func (x *GetBlockRequest) String() string {
 func (*GetBlockRequest) ProtoMessage() {}
 
 func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[8]
+	mi := &file_ilxrpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +1167,7 @@ func (x *GetBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{8}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *GetBlockRequest) GetIdOrHeight() isGetBlockRequest_IdOrHeight {
@@ -706,7 +1221,7 @@ type GetBlockResponse struct {
 func (x *GetBlockResponse) Reset() {
 	*x = GetBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[9]
+		mi := &file_ilxrpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -719,7 +1234,7 @@ func (x *GetBlockResponse) String() string {
 func (*GetBlockResponse) ProtoMessage() {}
 
 func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[9]
+	mi := &file_ilxrpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +1247,7 @@ func (x *GetBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{9}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBlockResponse) GetBlock() *blocks.Block {
@@ -757,7 +1272,7 @@ type GetCompressedBlockRequest struct {
 func (x *GetCompressedBlockRequest) Reset() {
 	*x = GetCompressedBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[10]
+		mi := &file_ilxrpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -770,7 +1285,7 @@ func (x *GetCompressedBlockRequest) String() string {
 func (*GetCompressedBlockRequest) ProtoMessage() {}
 
 func (x *GetCompressedBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[10]
+	mi := &file_ilxrpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +1298,7 @@ func (x *GetCompressedBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompressedBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetCompressedBlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{10}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *GetCompressedBlockRequest) GetIdOrHeight() isGetCompressedBlockRequest_IdOrHeight {
@@ -837,7 +1352,7 @@ type GetCompressedBlockResponse struct {
 func (x *GetCompressedBlockResponse) Reset() {
 	*x = GetCompressedBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[11]
+		mi := &file_ilxrpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -850,7 +1365,7 @@ func (x *GetCompressedBlockResponse) String() string {
 func (*GetCompressedBlockResponse) ProtoMessage() {}
 
 func (x *GetCompressedBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[11]
+	mi := &file_ilxrpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,7 +1378,7 @@ func (x *GetCompressedBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompressedBlockResponse.ProtoReflect.Descriptor instead.
 func (*GetCompressedBlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{11}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetCompressedBlockResponse) GetBlock() *blocks.CompressedBlock {
@@ -893,7 +1408,7 @@ type GetHeadersRequest struct {
 func (x *GetHeadersRequest) Reset() {
 	*x = GetHeadersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[12]
+		mi := &file_ilxrpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -906,7 +1421,7 @@ func (x *GetHeadersRequest) String() string {
 func (*GetHeadersRequest) ProtoMessage() {}
 
 func (x *GetHeadersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[12]
+	mi := &file_ilxrpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1434,7 @@ func (x *GetHeadersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHeadersRequest.ProtoReflect.Descriptor instead.
 func (*GetHeadersRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{12}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetHeadersRequest) GetStartHeight() uint32 {
@@ -947,7 +1462,7 @@ type GetHeadersResponse struct {
 func (x *GetHeadersResponse) Reset() {
 	*x = GetHeadersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[13]
+		mi := &file_ilxrpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1475,7 @@ func (x *GetHeadersResponse) String() string {
 func (*GetHeadersResponse) ProtoMessage() {}
 
 func (x *GetHeadersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[13]
+	mi := &file_ilxrpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1488,7 @@ func (x *GetHeadersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHeadersResponse.ProtoReflect.Descriptor instead.
 func (*GetHeadersResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{13}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetHeadersResponse) GetHeaders() []*blocks.BlockHeader {
@@ -1002,7 +1517,7 @@ type GetCompressedBlocksRequest struct {
 func (x *GetCompressedBlocksRequest) Reset() {
 	*x = GetCompressedBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[14]
+		mi := &file_ilxrpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1015,7 +1530,7 @@ func (x *GetCompressedBlocksRequest) String() string {
 func (*GetCompressedBlocksRequest) ProtoMessage() {}
 
 func (x *GetCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[14]
+	mi := &file_ilxrpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1543,7 @@ func (x *GetCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompressedBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetCompressedBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{14}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetCompressedBlocksRequest) GetStartHeight() uint32 {
@@ -1057,7 +1572,7 @@ type GetCompressedBlocksResponse struct {
 func (x *GetCompressedBlocksResponse) Reset() {
 	*x = GetCompressedBlocksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[15]
+		mi := &file_ilxrpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1585,7 @@ func (x *GetCompressedBlocksResponse) String() string {
 func (*GetCompressedBlocksResponse) ProtoMessage() {}
 
 func (x *GetCompressedBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[15]
+	mi := &file_ilxrpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1598,7 @@ func (x *GetCompressedBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCompressedBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetCompressedBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{15}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetCompressedBlocksResponse) GetBlocks() []*blocks.CompressedBlock {
@@ -1105,7 +1620,7 @@ type GetTransactionRequest struct {
 func (x *GetTransactionRequest) Reset() {
 	*x = GetTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[16]
+		mi := &file_ilxrpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1633,7 @@ func (x *GetTransactionRequest) String() string {
 func (*GetTransactionRequest) ProtoMessage() {}
 
 func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[16]
+	mi := &file_ilxrpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1646,7 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{16}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetTransactionRequest) GetTransaction_ID() []byte {
@@ -1153,7 +1668,7 @@ type GetTransactionResponse struct {
 func (x *GetTransactionResponse) Reset() {
 	*x = GetTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[17]
+		mi := &file_ilxrpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1166,7 +1681,7 @@ func (x *GetTransactionResponse) String() string {
 func (*GetTransactionResponse) ProtoMessage() {}
 
 func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[17]
+	mi := &file_ilxrpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1694,7 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{17}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetTransactionResponse) GetTx() *transactions.Transaction {
@@ -1201,7 +1716,7 @@ type GetMerkleProofRequest struct {
 func (x *GetMerkleProofRequest) Reset() {
 	*x = GetMerkleProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[18]
+		mi := &file_ilxrpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1214,7 +1729,7 @@ func (x *GetMerkleProofRequest) String() string {
 func (*GetMerkleProofRequest) ProtoMessage() {}
 
 func (x *GetMerkleProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[18]
+	mi := &file_ilxrpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,7 +1742,7 @@ func (x *GetMerkleProofRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMerkleProofRequest.ProtoReflect.Descriptor instead.
 func (*GetMerkleProofRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{18}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetMerkleProofRequest) GetTransaction_ID() []byte {
@@ -1257,7 +1772,7 @@ type GetMerkleProofResponse struct {
 func (x *GetMerkleProofResponse) Reset() {
 	*x = GetMerkleProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[19]
+		mi := &file_ilxrpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1270,7 +1785,7 @@ func (x *GetMerkleProofResponse) String() string {
 func (*GetMerkleProofResponse) ProtoMessage() {}
 
 func (x *GetMerkleProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[19]
+	mi := &file_ilxrpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,7 +1798,7 @@ func (x *GetMerkleProofResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMerkleProofResponse.ProtoReflect.Descriptor instead.
 func (*GetMerkleProofResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{19}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetMerkleProofResponse) GetBlock() *BlockInfo {
@@ -1326,7 +1841,7 @@ type GetValidatorRequest struct {
 func (x *GetValidatorRequest) Reset() {
 	*x = GetValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[20]
+		mi := &file_ilxrpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1339,7 +1854,7 @@ func (x *GetValidatorRequest) String() string {
 func (*GetValidatorRequest) ProtoMessage() {}
 
 func (x *GetValidatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[20]
+	mi := &file_ilxrpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1867,7 @@ func (x *GetValidatorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorRequest.ProtoReflect.Descriptor instead.
 func (*GetValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{20}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetValidatorRequest) GetValidator_ID() []byte {
@@ -1374,7 +1889,7 @@ type GetValidatorResponse struct {
 func (x *GetValidatorResponse) Reset() {
 	*x = GetValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[21]
+		mi := &file_ilxrpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1387,7 +1902,7 @@ func (x *GetValidatorResponse) String() string {
 func (*GetValidatorResponse) ProtoMessage() {}
 
 func (x *GetValidatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[21]
+	mi := &file_ilxrpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1915,7 @@ func (x *GetValidatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorResponse.ProtoReflect.Descriptor instead.
 func (*GetValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{21}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetValidatorResponse) GetValidator() *Validator {
@@ -1419,7 +1934,7 @@ type GetValidatorSetInfoRequest struct {
 func (x *GetValidatorSetInfoRequest) Reset() {
 	*x = GetValidatorSetInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[22]
+		mi := &file_ilxrpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1432,7 +1947,7 @@ func (x *GetValidatorSetInfoRequest) String() string {
 func (*GetValidatorSetInfoRequest) ProtoMessage() {}
 
 func (x *GetValidatorSetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[22]
+	mi := &file_ilxrpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,7 +1960,7 @@ func (x *GetValidatorSetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorSetInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetValidatorSetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{22}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{26}
 }
 
 type GetValidatorSetInfoResponse struct {
@@ -1464,7 +1979,7 @@ type GetValidatorSetInfoResponse struct {
 func (x *GetValidatorSetInfoResponse) Reset() {
 	*x = GetValidatorSetInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[23]
+		mi := &file_ilxrpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1477,7 +1992,7 @@ func (x *GetValidatorSetInfoResponse) String() string {
 func (*GetValidatorSetInfoResponse) ProtoMessage() {}
 
 func (x *GetValidatorSetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[23]
+	mi := &file_ilxrpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +2005,7 @@ func (x *GetValidatorSetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorSetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetValidatorSetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{23}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetValidatorSetInfoResponse) GetTotalStaked() uint64 {
@@ -1523,7 +2038,7 @@ type GetValidatorSetRequest struct {
 func (x *GetValidatorSetRequest) Reset() {
 	*x = GetValidatorSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[24]
+		mi := &file_ilxrpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1536,7 +2051,7 @@ func (x *GetValidatorSetRequest) String() string {
 func (*GetValidatorSetRequest) ProtoMessage() {}
 
 func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[24]
+	mi := &file_ilxrpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,7 +2064,7 @@ func (x *GetValidatorSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorSetRequest.ProtoReflect.Descriptor instead.
 func (*GetValidatorSetRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{24}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{28}
 }
 
 type GetValidatorSetResponse struct {
@@ -1564,7 +2079,7 @@ type GetValidatorSetResponse struct {
 func (x *GetValidatorSetResponse) Reset() {
 	*x = GetValidatorSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[25]
+		mi := &file_ilxrpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +2092,7 @@ func (x *GetValidatorSetResponse) String() string {
 func (*GetValidatorSetResponse) ProtoMessage() {}
 
 func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[25]
+	mi := &file_ilxrpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +2105,7 @@ func (x *GetValidatorSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValidatorSetResponse.ProtoReflect.Descriptor instead.
 func (*GetValidatorSetResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{25}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetValidatorSetResponse) GetValidators() []*Validator {
@@ -1615,7 +2130,7 @@ type GetAccumulatorCheckpointRequest struct {
 func (x *GetAccumulatorCheckpointRequest) Reset() {
 	*x = GetAccumulatorCheckpointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[26]
+		mi := &file_ilxrpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +2143,7 @@ func (x *GetAccumulatorCheckpointRequest) String() string {
 func (*GetAccumulatorCheckpointRequest) ProtoMessage() {}
 
 func (x *GetAccumulatorCheckpointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[26]
+	mi := &file_ilxrpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +2156,7 @@ func (x *GetAccumulatorCheckpointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccumulatorCheckpointRequest.ProtoReflect.Descriptor instead.
 func (*GetAccumulatorCheckpointRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{26}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{30}
 }
 
 func (m *GetAccumulatorCheckpointRequest) GetHeightOrTimestamp() isGetAccumulatorCheckpointRequest_HeightOrTimestamp {
@@ -1711,7 +2226,7 @@ type GetAccumulatorCheckpointResponse struct {
 func (x *GetAccumulatorCheckpointResponse) Reset() {
 	*x = GetAccumulatorCheckpointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[27]
+		mi := &file_ilxrpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1724,7 +2239,7 @@ func (x *GetAccumulatorCheckpointResponse) String() string {
 func (*GetAccumulatorCheckpointResponse) ProtoMessage() {}
 
 func (x *GetAccumulatorCheckpointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[27]
+	mi := &file_ilxrpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1737,7 +2252,7 @@ func (x *GetAccumulatorCheckpointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAccumulatorCheckpointResponse.ProtoReflect.Descriptor instead.
 func (*GetAccumulatorCheckpointResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{27}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetAccumulatorCheckpointResponse) GetHeight() uint32 {
@@ -1773,7 +2288,7 @@ type SubmitTransactionRequest struct {
 func (x *SubmitTransactionRequest) Reset() {
 	*x = SubmitTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[28]
+		mi := &file_ilxrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1786,7 +2301,7 @@ func (x *SubmitTransactionRequest) String() string {
 func (*SubmitTransactionRequest) ProtoMessage() {}
 
 func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[28]
+	mi := &file_ilxrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +2314,7 @@ func (x *SubmitTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SubmitTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{28}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SubmitTransactionRequest) GetTransaction() *transactions.Transaction {
@@ -1823,7 +2338,7 @@ type SubmitTransactionResponse struct {
 func (x *SubmitTransactionResponse) Reset() {
 	*x = SubmitTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[29]
+		mi := &file_ilxrpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1836,7 +2351,7 @@ func (x *SubmitTransactionResponse) String() string {
 func (*SubmitTransactionResponse) ProtoMessage() {}
 
 func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[29]
+	mi := &file_ilxrpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1849,7 +2364,7 @@ func (x *SubmitTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTransactionResponse.ProtoReflect.Descriptor instead.
 func (*SubmitTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{29}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SubmitTransactionResponse) GetTransaction_ID() []byte {
@@ -1859,6 +2374,165 @@ func (x *SubmitTransactionResponse) GetTransaction_ID() []byte {
 	return nil
 }
 
+type TestTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction to test
+	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *TestTransactionRequest) Reset() {
+	*x = TestTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTransactionRequest) ProtoMessage() {}
+
+func (x *TestTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTransactionRequest.ProtoReflect.Descriptor instead.
+func (*TestTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TestTransactionRequest) GetTransaction() *transactions.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type TestTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID of the transaction.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// Whether the transaction would be accepted into the mempool.
+	Allowed bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// The reason the transaction would be rejected, if it is not allowed.
+	RejectReason string `protobuf:"bytes,3,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	// The type of error the transaction would be rejected with.
+	ErrorType TestTransactionResponse_ErrorType `protobuf:"varint,4,opt,name=error_type,json=errorType,proto3,enum=pb.TestTransactionResponse_ErrorType" json:"error_type,omitempty"`
+	// The name of the rule or policy error code, if any.
+	ErrorCode string `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// The fee paid by the transaction.
+	Fee uint64 `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee per kilobyte paid by the transaction.
+	FeePerKilobyte uint64 `protobuf:"varint,7,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// The serialized size of the transaction in bytes.
+	Size uint32 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *TestTransactionResponse) Reset() {
+	*x = TestTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTransactionResponse) ProtoMessage() {}
+
+func (x *TestTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTransactionResponse.ProtoReflect.Descriptor instead.
+func (*TestTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TestTransactionResponse) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *TestTransactionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *TestTransactionResponse) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *TestTransactionResponse) GetErrorType() TestTransactionResponse_ErrorType {
+	if x != nil {
+		return x.ErrorType
+	}
+	return TestTransactionResponse_NONE
+}
+
+func (x *TestTransactionResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *TestTransactionResponse) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TestTransactionResponse) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+func (x *TestTransactionResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 type SubscribeBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1878,7 +2552,7 @@ type SubscribeBlocksRequest struct {
 func (x *SubscribeBlocksRequest) Reset() {
 	*x = SubscribeBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[30]
+		mi := &file_ilxrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1891,7 +2565,7 @@ func (x *SubscribeBlocksRequest) String() string {
 func (*SubscribeBlocksRequest) ProtoMessage() {}
 
 func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[30]
+	mi := &file_ilxrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +2578,7 @@ func (x *SubscribeBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeBlocksRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{30}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SubscribeBlocksRequest) GetFullBlock() bool {
@@ -1930,7 +2604,7 @@ type SubscribeCompressedBlocksRequest struct {
 func (x *SubscribeCompressedBlocksRequest) Reset() {
 	*x = SubscribeCompressedBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[31]
+		mi := &file_ilxrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1943,7 +2617,7 @@ func (x *SubscribeCompressedBlocksRequest) String() string {
 func (*SubscribeCompressedBlocksRequest) ProtoMessage() {}
 
 func (x *SubscribeCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[31]
+	mi := &file_ilxrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2630,45 @@ func (x *SubscribeCompressedBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeCompressedBlocksRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeCompressedBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{31}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{37}
+}
+
+type SubscribeFinalityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeFinalityRequest) Reset() {
+	*x = SubscribeFinalityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeFinalityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeFinalityRequest) ProtoMessage() {}
+
+func (x *SubscribeFinalityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeFinalityRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeFinalityRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{38}
 }
 
 // WalletServerService
@@ -1984,7 +2696,7 @@ type RegisterViewKeyRequest struct {
 func (x *RegisterViewKeyRequest) Reset() {
 	*x = RegisterViewKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[32]
+		mi := &file_ilxrpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1997,7 +2709,7 @@ func (x *RegisterViewKeyRequest) String() string {
 func (*RegisterViewKeyRequest) ProtoMessage() {}
 
 func (x *RegisterViewKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[32]
+	mi := &file_ilxrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2010,7 +2722,7 @@ func (x *RegisterViewKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterViewKeyRequest.ProtoReflect.Descriptor instead.
 func (*RegisterViewKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{32}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *RegisterViewKeyRequest) GetViewKey() []byte {
@@ -2043,7 +2755,7 @@ type RegisterViewKeyResponse struct {
 func (x *RegisterViewKeyResponse) Reset() {
 	*x = RegisterViewKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[33]
+		mi := &file_ilxrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2056,7 +2768,7 @@ func (x *RegisterViewKeyResponse) String() string {
 func (*RegisterViewKeyResponse) ProtoMessage() {}
 
 func (x *RegisterViewKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[33]
+	mi := &file_ilxrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,7 +2781,7 @@ func (x *RegisterViewKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterViewKeyResponse.ProtoReflect.Descriptor instead.
 func (*RegisterViewKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{33}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{40}
 }
 
 type SubscribeTransactionsRequest struct {
@@ -2084,7 +2796,7 @@ type SubscribeTransactionsRequest struct {
 func (x *SubscribeTransactionsRequest) Reset() {
 	*x = SubscribeTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[34]
+		mi := &file_ilxrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2097,7 +2809,7 @@ func (x *SubscribeTransactionsRequest) String() string {
 func (*SubscribeTransactionsRequest) ProtoMessage() {}
 
 func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[34]
+	mi := &file_ilxrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,7 +2822,7 @@ func (x *SubscribeTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{34}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SubscribeTransactionsRequest) GetViewKeys() [][]byte {
@@ -2142,7 +2854,7 @@ type GetWalletTransactionsRequest struct {
 func (x *GetWalletTransactionsRequest) Reset() {
 	*x = GetWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[35]
+		mi := &file_ilxrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2155,7 +2867,7 @@ func (x *GetWalletTransactionsRequest) String() string {
 func (*GetWalletTransactionsRequest) ProtoMessage() {}
 
 func (x *GetWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[35]
+	mi := &file_ilxrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2880,7 @@ func (x *GetWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{35}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetWalletTransactionsRequest) GetViewKey() []byte {
@@ -2247,7 +2959,7 @@ type GetWalletTransactionsResponse struct {
 func (x *GetWalletTransactionsResponse) Reset() {
 	*x = GetWalletTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[36]
+		mi := &file_ilxrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2260,7 +2972,7 @@ func (x *GetWalletTransactionsResponse) String() string {
 func (*GetWalletTransactionsResponse) ProtoMessage() {}
 
 func (x *GetWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[36]
+	mi := &file_ilxrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2985,7 @@ func (x *GetWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetWalletTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{36}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetWalletTransactionsResponse) GetChainHeight() uint32 {
@@ -2307,7 +3019,7 @@ type GetTxoProofRequest struct {
 func (x *GetTxoProofRequest) Reset() {
 	*x = GetTxoProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[37]
+		mi := &file_ilxrpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2320,7 +3032,7 @@ func (x *GetTxoProofRequest) String() string {
 func (*GetTxoProofRequest) ProtoMessage() {}
 
 func (x *GetTxoProofRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[37]
+	mi := &file_ilxrpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,7 +3045,7 @@ func (x *GetTxoProofRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTxoProofRequest.ProtoReflect.Descriptor instead.
 func (*GetTxoProofRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{37}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetTxoProofRequest) GetCommitments() [][]byte {
@@ -2355,7 +3067,7 @@ type GetTxoProofResponse struct {
 func (x *GetTxoProofResponse) Reset() {
 	*x = GetTxoProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[38]
+		mi := &file_ilxrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2368,7 +3080,7 @@ func (x *GetTxoProofResponse) String() string {
 func (*GetTxoProofResponse) ProtoMessage() {}
 
 func (x *GetTxoProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[38]
+	mi := &file_ilxrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +3093,7 @@ func (x *GetTxoProofResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTxoProofResponse.ProtoReflect.Descriptor instead.
 func (*GetTxoProofResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{38}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetTxoProofResponse) GetProofs() []*TxoProof {
@@ -2401,7 +3113,7 @@ type GetBalanceRequest struct {
 func (x *GetBalanceRequest) Reset() {
 	*x = GetBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[39]
+		mi := &file_ilxrpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2414,7 +3126,7 @@ func (x *GetBalanceRequest) String() string {
 func (*GetBalanceRequest) ProtoMessage() {}
 
 func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[39]
+	mi := &file_ilxrpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +3139,7 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{39}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{46}
 }
 
 type GetBalanceResponse struct {
@@ -2442,7 +3154,7 @@ type GetBalanceResponse struct {
 func (x *GetBalanceResponse) Reset() {
 	*x = GetBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[40]
+		mi := &file_ilxrpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2455,7 +3167,7 @@ func (x *GetBalanceResponse) String() string {
 func (*GetBalanceResponse) ProtoMessage() {}
 
 func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[40]
+	mi := &file_ilxrpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,7 +3180,7 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{40}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetBalanceResponse) GetBalance() uint64 {
@@ -2487,7 +3199,7 @@ type GetWalletSeedRequest struct {
 func (x *GetWalletSeedRequest) Reset() {
 	*x = GetWalletSeedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[41]
+		mi := &file_ilxrpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +3212,7 @@ func (x *GetWalletSeedRequest) String() string {
 func (*GetWalletSeedRequest) ProtoMessage() {}
 
 func (x *GetWalletSeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[41]
+	mi := &file_ilxrpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +3225,7 @@ func (x *GetWalletSeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletSeedRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletSeedRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{41}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{48}
 }
 
 type GetWalletSeedResponse struct {
@@ -2528,7 +3240,7 @@ type GetWalletSeedResponse struct {
 func (x *GetWalletSeedResponse) Reset() {
 	*x = GetWalletSeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[42]
+		mi := &file_ilxrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2541,7 +3253,7 @@ func (x *GetWalletSeedResponse) String() string {
 func (*GetWalletSeedResponse) ProtoMessage() {}
 
 func (x *GetWalletSeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[42]
+	mi := &file_ilxrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2554,7 +3266,7 @@ func (x *GetWalletSeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletSeedResponse.ProtoReflect.Descriptor instead.
 func (*GetWalletSeedResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{42}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetWalletSeedResponse) GetMnemonicSeed() string {
@@ -2573,7 +3285,7 @@ type GetAddressRequest struct {
 func (x *GetAddressRequest) Reset() {
 	*x = GetAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[43]
+		mi := &file_ilxrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2586,7 +3298,7 @@ func (x *GetAddressRequest) String() string {
 func (*GetAddressRequest) ProtoMessage() {}
 
 func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[43]
+	mi := &file_ilxrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,7 +3311,7 @@ func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{43}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{50}
 }
 
 type GetAddressResponse struct {
@@ -2614,7 +3326,7 @@ type GetAddressResponse struct {
 func (x *GetAddressResponse) Reset() {
 	*x = GetAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[44]
+		mi := &file_ilxrpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2627,7 +3339,7 @@ func (x *GetAddressResponse) String() string {
 func (*GetAddressResponse) ProtoMessage() {}
 
 func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[44]
+	mi := &file_ilxrpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,7 +3352,7 @@ func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{44}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetAddressResponse) GetAddress() string {
@@ -2662,7 +3374,7 @@ type GetTimelockedAddressRequest struct {
 func (x *GetTimelockedAddressRequest) Reset() {
 	*x = GetTimelockedAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[45]
+		mi := &file_ilxrpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2675,7 +3387,7 @@ func (x *GetTimelockedAddressRequest) String() string {
 func (*GetTimelockedAddressRequest) ProtoMessage() {}
 
 func (x *GetTimelockedAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[45]
+	mi := &file_ilxrpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,7 +3400,7 @@ func (x *GetTimelockedAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTimelockedAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetTimelockedAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{45}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetTimelockedAddressRequest) GetLockUntil() int64 {
@@ -2710,7 +3422,7 @@ type GetTimelockedAddressResponse struct {
 func (x *GetTimelockedAddressResponse) Reset() {
 	*x = GetTimelockedAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[46]
+		mi := &file_ilxrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2723,7 +3435,7 @@ func (x *GetTimelockedAddressResponse) String() string {
 func (*GetTimelockedAddressResponse) ProtoMessage() {}
 
 func (x *GetTimelockedAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[46]
+	mi := &file_ilxrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,7 +3448,7 @@ func (x *GetTimelockedAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTimelockedAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetTimelockedAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{46}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetTimelockedAddressResponse) GetAddress() string {
@@ -2755,7 +3467,7 @@ type GetAddressesRequest struct {
 func (x *GetAddressesRequest) Reset() {
 	*x = GetAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[47]
+		mi := &file_ilxrpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2768,7 +3480,7 @@ func (x *GetAddressesRequest) String() string {
 func (*GetAddressesRequest) ProtoMessage() {}
 
 func (x *GetAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[47]
+	mi := &file_ilxrpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2781,7 +3493,7 @@ func (x *GetAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{47}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{54}
 }
 
 type GetAddressesResponse struct {
@@ -2796,7 +3508,7 @@ type GetAddressesResponse struct {
 func (x *GetAddressesResponse) Reset() {
 	*x = GetAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[48]
+		mi := &file_ilxrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2809,7 +3521,7 @@ func (x *GetAddressesResponse) String() string {
 func (*GetAddressesResponse) ProtoMessage() {}
 
 func (x *GetAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[48]
+	mi := &file_ilxrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2822,7 +3534,7 @@ func (x *GetAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{48}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetAddressesResponse) GetAddresses() []string {
@@ -2844,7 +3556,7 @@ type GetAddressInfoRequest struct {
 func (x *GetAddressInfoRequest) Reset() {
 	*x = GetAddressInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[49]
+		mi := &file_ilxrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2857,7 +3569,7 @@ func (x *GetAddressInfoRequest) String() string {
 func (*GetAddressInfoRequest) ProtoMessage() {}
 
 func (x *GetAddressInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[49]
+	mi := &file_ilxrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2870,7 +3582,7 @@ func (x *GetAddressInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetAddressInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{49}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetAddressInfoRequest) GetAddress() string {
@@ -2899,7 +3611,7 @@ type GetAddressInfoResponse struct {
 func (x *GetAddressInfoResponse) Reset() {
 	*x = GetAddressInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[50]
+		mi := &file_ilxrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2912,7 +3624,7 @@ func (x *GetAddressInfoResponse) String() string {
 func (*GetAddressInfoResponse) ProtoMessage() {}
 
 func (x *GetAddressInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[50]
+	mi := &file_ilxrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,7 +3637,7 @@ func (x *GetAddressInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAddressInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetAddressInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{50}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetAddressInfoResponse) GetAddress() string {
@@ -2965,7 +3677,7 @@ type GetNewAddressRequest struct {
 func (x *GetNewAddressRequest) Reset() {
 	*x = GetNewAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[51]
+		mi := &file_ilxrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2978,7 +3690,7 @@ func (x *GetNewAddressRequest) String() string {
 func (*GetNewAddressRequest) ProtoMessage() {}
 
 func (x *GetNewAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[51]
+	mi := &file_ilxrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2991,7 +3703,7 @@ func (x *GetNewAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetNewAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{51}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{58}
 }
 
 type GetNewAddressResponse struct {
@@ -3006,7 +3718,7 @@ type GetNewAddressResponse struct {
 func (x *GetNewAddressResponse) Reset() {
 	*x = GetNewAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[52]
+		mi := &file_ilxrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3019,7 +3731,7 @@ func (x *GetNewAddressResponse) String() string {
 func (*GetNewAddressResponse) ProtoMessage() {}
 
 func (x *GetNewAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[52]
+	mi := &file_ilxrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,7 +3744,7 @@ func (x *GetNewAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetNewAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{52}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetNewAddressResponse) GetAddress() string {
@@ -3051,7 +3763,7 @@ type GetTransactionsRequest struct {
 func (x *GetTransactionsRequest) Reset() {
 	*x = GetTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[53]
+		mi := &file_ilxrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3064,7 +3776,7 @@ func (x *GetTransactionsRequest) String() string {
 func (*GetTransactionsRequest) ProtoMessage() {}
 
 func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[53]
+	mi := &file_ilxrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3077,7 +3789,7 @@ func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{53}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{60}
 }
 
 type GetTransactionsResponse struct {
@@ -3092,7 +3804,7 @@ type GetTransactionsResponse struct {
 func (x *GetTransactionsResponse) Reset() {
 	*x = GetTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[54]
+		mi := &file_ilxrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3105,7 +3817,7 @@ func (x *GetTransactionsResponse) String() string {
 func (*GetTransactionsResponse) ProtoMessage() {}
 
 func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[54]
+	mi := &file_ilxrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,7 +3830,7 @@ func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{54}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetTransactionsResponse) GetTxs() []*WalletTransaction {
@@ -3137,7 +3849,7 @@ type GetUtxosRequest struct {
 func (x *GetUtxosRequest) Reset() {
 	*x = GetUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[55]
+		mi := &file_ilxrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3150,7 +3862,7 @@ func (x *GetUtxosRequest) String() string {
 func (*GetUtxosRequest) ProtoMessage() {}
 
 func (x *GetUtxosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[55]
+	mi := &file_ilxrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3163,7 +3875,7 @@ func (x *GetUtxosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosRequest.ProtoReflect.Descriptor instead.
 func (*GetUtxosRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{55}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{62}
 }
 
 type GetUtxosResponse struct {
@@ -3178,7 +3890,7 @@ type GetUtxosResponse struct {
 func (x *GetUtxosResponse) Reset() {
 	*x = GetUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[56]
+		mi := &file_ilxrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3191,7 +3903,7 @@ func (x *GetUtxosResponse) String() string {
 func (*GetUtxosResponse) ProtoMessage() {}
 
 func (x *GetUtxosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[56]
+	mi := &file_ilxrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3204,7 +3916,7 @@ func (x *GetUtxosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosResponse.ProtoReflect.Descriptor instead.
 func (*GetUtxosResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{56}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetUtxosResponse) GetUtxos() []*Utxo {
@@ -3226,7 +3938,7 @@ type GetPrivateKeyRequest struct {
 func (x *GetPrivateKeyRequest) Reset() {
 	*x = GetPrivateKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[57]
+		mi := &file_ilxrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3239,7 +3951,7 @@ func (x *GetPrivateKeyRequest) String() string {
 func (*GetPrivateKeyRequest) ProtoMessage() {}
 
 func (x *GetPrivateKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[57]
+	mi := &file_ilxrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3252,7 +3964,7 @@ func (x *GetPrivateKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPrivateKeyRequest.ProtoReflect.Descriptor instead.
 func (*GetPrivateKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{57}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetPrivateKeyRequest) GetAddress() string {
@@ -3273,7 +3985,7 @@ type GetPrivateKeyResponse struct {
 func (x *GetPrivateKeyResponse) Reset() {
 	*x = GetPrivateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[58]
+		mi := &file_ilxrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3286,7 +3998,7 @@ func (x *GetPrivateKeyResponse) String() string {
 func (*GetPrivateKeyResponse) ProtoMessage() {}
 
 func (x *GetPrivateKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[58]
+	mi := &file_ilxrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3299,7 +4011,7 @@ func (x *GetPrivateKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPrivateKeyResponse.ProtoReflect.Descriptor instead.
 func (*GetPrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{58}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetPrivateKeyResponse) GetSerializedKeys() []byte {
@@ -3336,7 +4048,7 @@ type ImportAddressRequest struct {
 func (x *ImportAddressRequest) Reset() {
 	*x = ImportAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[59]
+		mi := &file_ilxrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3349,7 +4061,7 @@ func (x *ImportAddressRequest) String() string {
 func (*ImportAddressRequest) ProtoMessage() {}
 
 func (x *ImportAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[59]
+	mi := &file_ilxrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,7 +4074,7 @@ func (x *ImportAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportAddressRequest.ProtoReflect.Descriptor instead.
 func (*ImportAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{59}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ImportAddressRequest) GetAddress() string {
@@ -3409,7 +4121,7 @@ type ImportAddressResponse struct {
 func (x *ImportAddressResponse) Reset() {
 	*x = ImportAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[60]
+		mi := &file_ilxrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3422,7 +4134,7 @@ func (x *ImportAddressResponse) String() string {
 func (*ImportAddressResponse) ProtoMessage() {}
 
 func (x *ImportAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[60]
+	mi := &file_ilxrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3435,7 +4147,7 @@ func (x *ImportAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportAddressResponse.ProtoReflect.Descriptor instead.
 func (*ImportAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{60}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{67}
 }
 
 type CreateMultisigSpendKeypairRequest struct {
@@ -3447,7 +4159,7 @@ type CreateMultisigSpendKeypairRequest struct {
 func (x *CreateMultisigSpendKeypairRequest) Reset() {
 	*x = CreateMultisigSpendKeypairRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[61]
+		mi := &file_ilxrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3460,7 +4172,7 @@ func (x *CreateMultisigSpendKeypairRequest) String() string {
 func (*CreateMultisigSpendKeypairRequest) ProtoMessage() {}
 
 func (x *CreateMultisigSpendKeypairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[61]
+	mi := &file_ilxrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3473,7 +4185,7 @@ func (x *CreateMultisigSpendKeypairRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigSpendKeypairRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigSpendKeypairRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{61}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{68}
 }
 
 type CreateMultisigSpendKeypairResponse struct {
@@ -3490,7 +4202,7 @@ type CreateMultisigSpendKeypairResponse struct {
 func (x *CreateMultisigSpendKeypairResponse) Reset() {
 	*x = CreateMultisigSpendKeypairResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[62]
+		mi := &file_ilxrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3503,7 +4215,7 @@ func (x *CreateMultisigSpendKeypairResponse) String() string {
 func (*CreateMultisigSpendKeypairResponse) ProtoMessage() {}
 
 func (x *CreateMultisigSpendKeypairResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[62]
+	mi := &file_ilxrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3516,7 +4228,7 @@ func (x *CreateMultisigSpendKeypairResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigSpendKeypairResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigSpendKeypairResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{62}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CreateMultisigSpendKeypairResponse) GetPrivkey() []byte {
@@ -3542,7 +4254,7 @@ type CreateMultisigViewKeypairRequest struct {
 func (x *CreateMultisigViewKeypairRequest) Reset() {
 	*x = CreateMultisigViewKeypairRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[63]
+		mi := &file_ilxrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3555,7 +4267,7 @@ func (x *CreateMultisigViewKeypairRequest) String() string {
 func (*CreateMultisigViewKeypairRequest) ProtoMessage() {}
 
 func (x *CreateMultisigViewKeypairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[63]
+	mi := &file_ilxrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3568,7 +4280,7 @@ func (x *CreateMultisigViewKeypairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigViewKeypairRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigViewKeypairRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{63}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{70}
 }
 
 type CreateMultisigViewKeypairResponse struct {
@@ -3585,7 +4297,7 @@ type CreateMultisigViewKeypairResponse struct {
 func (x *CreateMultisigViewKeypairResponse) Reset() {
 	*x = CreateMultisigViewKeypairResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[64]
+		mi := &file_ilxrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3598,7 +4310,7 @@ func (x *CreateMultisigViewKeypairResponse) String() string {
 func (*CreateMultisigViewKeypairResponse) ProtoMessage() {}
 
 func (x *CreateMultisigViewKeypairResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[64]
+	mi := &file_ilxrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3611,7 +4323,7 @@ func (x *CreateMultisigViewKeypairResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateMultisigViewKeypairResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigViewKeypairResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{64}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CreateMultisigViewKeypairResponse) GetPrivkey() []byte {
@@ -3645,7 +4357,7 @@ type CreateMultisigAddressRequest struct {
 func (x *CreateMultisigAddressRequest) Reset() {
 	*x = CreateMultisigAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[65]
+		mi := &file_ilxrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3658,7 +4370,7 @@ func (x *CreateMultisigAddressRequest) String() string {
 func (*CreateMultisigAddressRequest) ProtoMessage() {}
 
 func (x *CreateMultisigAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[65]
+	mi := &file_ilxrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3671,7 +4383,7 @@ func (x *CreateMultisigAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigAddressRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultisigAddressRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{65}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateMultisigAddressRequest) GetPubkeys() [][]byte {
@@ -3707,7 +4419,7 @@ type CreateMultisigAddressResponse struct {
 func (x *CreateMultisigAddressResponse) Reset() {
 	*x = CreateMultisigAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[66]
+		mi := &file_ilxrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3720,7 +4432,7 @@ func (x *CreateMultisigAddressResponse) String() string {
 func (*CreateMultisigAddressResponse) ProtoMessage() {}
 
 func (x *CreateMultisigAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[66]
+	mi := &file_ilxrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3733,7 +4445,7 @@ func (x *CreateMultisigAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultisigAddressResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultisigAddressResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{66}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CreateMultisigAddressResponse) GetAddress() string {
@@ -3760,7 +4472,7 @@ type CreateMultiSignatureRequest struct {
 func (x *CreateMultiSignatureRequest) Reset() {
 	*x = CreateMultiSignatureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[67]
+		mi := &file_ilxrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3773,7 +4485,7 @@ func (x *CreateMultiSignatureRequest) String() string {
 func (*CreateMultiSignatureRequest) ProtoMessage() {}
 
 func (x *CreateMultiSignatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[67]
+	mi := &file_ilxrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +4498,7 @@ func (x *CreateMultiSignatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultiSignatureRequest.ProtoReflect.Descriptor instead.
 func (*CreateMultiSignatureRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{67}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{74}
 }
 
 func (m *CreateMultiSignatureRequest) GetTxOrSighash() isCreateMultiSignatureRequest_TxOrSighash {
@@ -3847,7 +4559,7 @@ type CreateMultiSignatureResponse struct {
 func (x *CreateMultiSignatureResponse) Reset() {
 	*x = CreateMultiSignatureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[68]
+		mi := &file_ilxrpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3860,7 +4572,7 @@ func (x *CreateMultiSignatureResponse) String() string {
 func (*CreateMultiSignatureResponse) ProtoMessage() {}
 
 func (x *CreateMultiSignatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[68]
+	mi := &file_ilxrpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3873,7 +4585,7 @@ func (x *CreateMultiSignatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMultiSignatureResponse.ProtoReflect.Descriptor instead.
 func (*CreateMultiSignatureResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{68}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *CreateMultiSignatureResponse) GetSignature() []byte {
@@ -3898,7 +4610,7 @@ type ProveMultisigRequest struct {
 func (x *ProveMultisigRequest) Reset() {
 	*x = ProveMultisigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[69]
+		mi := &file_ilxrpc_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3911,7 +4623,7 @@ func (x *ProveMultisigRequest) String() string {
 func (*ProveMultisigRequest) ProtoMessage() {}
 
 func (x *ProveMultisigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[69]
+	mi := &file_ilxrpc_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3924,7 +4636,7 @@ func (x *ProveMultisigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveMultisigRequest.ProtoReflect.Descriptor instead.
 func (*ProveMultisigRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{69}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ProveMultisigRequest) GetRawTx() *RawTransaction {
@@ -3953,7 +4665,7 @@ type ProveMultisigResponse struct {
 func (x *ProveMultisigResponse) Reset() {
 	*x = ProveMultisigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[70]
+		mi := &file_ilxrpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3966,7 +4678,7 @@ func (x *ProveMultisigResponse) String() string {
 func (*ProveMultisigResponse) ProtoMessage() {}
 
 func (x *ProveMultisigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[70]
+	mi := &file_ilxrpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3979,7 +4691,7 @@ func (x *ProveMultisigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveMultisigResponse.ProtoReflect.Descriptor instead.
 func (*ProveMultisigResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{70}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ProveMultisigResponse) GetProvedTx() *transactions.Transaction {
@@ -3998,7 +4710,7 @@ type WalletLockRequest struct {
 func (x *WalletLockRequest) Reset() {
 	*x = WalletLockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[71]
+		mi := &file_ilxrpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4011,7 +4723,7 @@ func (x *WalletLockRequest) String() string {
 func (*WalletLockRequest) ProtoMessage() {}
 
 func (x *WalletLockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[71]
+	mi := &file_ilxrpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4024,7 +4736,7 @@ func (x *WalletLockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletLockRequest.ProtoReflect.Descriptor instead.
 func (*WalletLockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{71}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{78}
 }
 
 type WalletLockResponse struct {
@@ -4036,7 +4748,7 @@ type WalletLockResponse struct {
 func (x *WalletLockResponse) Reset() {
 	*x = WalletLockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[72]
+		mi := &file_ilxrpc_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4049,7 +4761,7 @@ func (x *WalletLockResponse) String() string {
 func (*WalletLockResponse) ProtoMessage() {}
 
 func (x *WalletLockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[72]
+	mi := &file_ilxrpc_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4062,7 +4774,7 @@ func (x *WalletLockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletLockResponse.ProtoReflect.Descriptor instead.
 func (*WalletLockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{72}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{79}
 }
 
 type WalletUnlockRequest struct {
@@ -4080,7 +4792,7 @@ type WalletUnlockRequest struct {
 func (x *WalletUnlockRequest) Reset() {
 	*x = WalletUnlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[73]
+		mi := &file_ilxrpc_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4093,7 +4805,7 @@ func (x *WalletUnlockRequest) String() string {
 func (*WalletUnlockRequest) ProtoMessage() {}
 
 func (x *WalletUnlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[73]
+	mi := &file_ilxrpc_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4106,7 +4818,7 @@ func (x *WalletUnlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletUnlockRequest.ProtoReflect.Descriptor instead.
 func (*WalletUnlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{73}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *WalletUnlockRequest) GetPassphrase() string {
@@ -4132,7 +4844,7 @@ type WalletUnlockResponse struct {
 func (x *WalletUnlockResponse) Reset() {
 	*x = WalletUnlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[74]
+		mi := &file_ilxrpc_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4145,7 +4857,7 @@ func (x *WalletUnlockResponse) String() string {
 func (*WalletUnlockResponse) ProtoMessage() {}
 
 func (x *WalletUnlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[74]
+	mi := &file_ilxrpc_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4158,7 +4870,7 @@ func (x *WalletUnlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletUnlockResponse.ProtoReflect.Descriptor instead.
 func (*WalletUnlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{74}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{81}
 }
 
 type SetWalletPassphraseRequest struct {
@@ -4173,7 +4885,7 @@ type SetWalletPassphraseRequest struct {
 func (x *SetWalletPassphraseRequest) Reset() {
 	*x = SetWalletPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[75]
+		mi := &file_ilxrpc_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4186,7 +4898,7 @@ func (x *SetWalletPassphraseRequest) String() string {
 func (*SetWalletPassphraseRequest) ProtoMessage() {}
 
 func (x *SetWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[75]
+	mi := &file_ilxrpc_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4199,7 +4911,7 @@ func (x *SetWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetWalletPassphraseRequest.ProtoReflect.Descriptor instead.
 func (*SetWalletPassphraseRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{75}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *SetWalletPassphraseRequest) GetPassphrase() string {
@@ -4218,7 +4930,7 @@ type SetWalletPassphraseResponse struct {
 func (x *SetWalletPassphraseResponse) Reset() {
 	*x = SetWalletPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[76]
+		mi := &file_ilxrpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4231,7 +4943,7 @@ func (x *SetWalletPassphraseResponse) String() string {
 func (*SetWalletPassphraseResponse) ProtoMessage() {}
 
 func (x *SetWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[76]
+	mi := &file_ilxrpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4244,7 +4956,7 @@ func (x *SetWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetWalletPassphraseResponse.ProtoReflect.Descriptor instead.
 func (*SetWalletPassphraseResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{76}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{83}
 }
 
 type ChangeWalletPassphraseRequest struct {
@@ -4261,7 +4973,7 @@ type ChangeWalletPassphraseRequest struct {
 func (x *ChangeWalletPassphraseRequest) Reset() {
 	*x = ChangeWalletPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[77]
+		mi := &file_ilxrpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4274,7 +4986,7 @@ func (x *ChangeWalletPassphraseRequest) String() string {
 func (*ChangeWalletPassphraseRequest) ProtoMessage() {}
 
 func (x *ChangeWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[77]
+	mi := &file_ilxrpc_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4287,7 +4999,7 @@ func (x *ChangeWalletPassphraseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeWalletPassphraseRequest.ProtoReflect.Descriptor instead.
 func (*ChangeWalletPassphraseRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{77}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *ChangeWalletPassphraseRequest) GetCurrentPassphrase() string {
@@ -4313,7 +5025,7 @@ type ChangeWalletPassphraseResponse struct {
 func (x *ChangeWalletPassphraseResponse) Reset() {
 	*x = ChangeWalletPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[78]
+		mi := &file_ilxrpc_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4326,7 +5038,7 @@ func (x *ChangeWalletPassphraseResponse) String() string {
 func (*ChangeWalletPassphraseResponse) ProtoMessage() {}
 
 func (x *ChangeWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[78]
+	mi := &file_ilxrpc_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,7 +5051,7 @@ func (x *ChangeWalletPassphraseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeWalletPassphraseResponse.ProtoReflect.Descriptor instead.
 func (*ChangeWalletPassphraseResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{78}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{85}
 }
 
 type DeletePrivateKeysRequest struct {
@@ -4351,7 +5063,7 @@ type DeletePrivateKeysRequest struct {
 func (x *DeletePrivateKeysRequest) Reset() {
 	*x = DeletePrivateKeysRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[79]
+		mi := &file_ilxrpc_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4364,7 +5076,7 @@ func (x *DeletePrivateKeysRequest) String() string {
 func (*DeletePrivateKeysRequest) ProtoMessage() {}
 
 func (x *DeletePrivateKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[79]
+	mi := &file_ilxrpc_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4377,7 +5089,7 @@ func (x *DeletePrivateKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateKeysRequest.ProtoReflect.Descriptor instead.
 func (*DeletePrivateKeysRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{79}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{86}
 }
 
 type DeletePrivateKeysResponse struct {
@@ -4389,7 +5101,7 @@ type DeletePrivateKeysResponse struct {
 func (x *DeletePrivateKeysResponse) Reset() {
 	*x = DeletePrivateKeysResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[80]
+		mi := &file_ilxrpc_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4402,7 +5114,7 @@ func (x *DeletePrivateKeysResponse) String() string {
 func (*DeletePrivateKeysResponse) ProtoMessage() {}
 
 func (x *DeletePrivateKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[80]
+	mi := &file_ilxrpc_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,7 +5127,7 @@ func (x *DeletePrivateKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePrivateKeysResponse.ProtoReflect.Descriptor instead.
 func (*DeletePrivateKeysResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{80}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{87}
 }
 
 type CreateRawTransactionRequest struct {
@@ -4432,14 +5144,14 @@ type CreateRawTransactionRequest struct {
 	// to the change output.
 	AppendChangeOutput bool `protobuf:"varint,3,opt,name=append_change_output,json=appendChangeOutput,proto3" json:"append_change_output,omitempty"`
 	// If append_change_output is true you can specify the fee amount to use.
-	// If zero the wallet will use its internal fee policy.
+	// If zero the node's fee estimate is used.
 	FeePerKilobyte uint64 `protobuf:"varint,4,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
 func (x *CreateRawTransactionRequest) Reset() {
 	*x = CreateRawTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[81]
+		mi := &file_ilxrpc_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4452,7 +5164,7 @@ func (x *CreateRawTransactionRequest) String() string {
 func (*CreateRawTransactionRequest) ProtoMessage() {}
 
 func (x *CreateRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[81]
+	mi := &file_ilxrpc_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4465,7 +5177,7 @@ func (x *CreateRawTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *CreateRawTransactionRequest) GetInputs() []*CreateRawTransactionRequest_Input {
@@ -4508,7 +5220,7 @@ type CreateRawTransactionResponse struct {
 func (x *CreateRawTransactionResponse) Reset() {
 	*x = CreateRawTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[82]
+		mi := &file_ilxrpc_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4521,7 +5233,7 @@ func (x *CreateRawTransactionResponse) String() string {
 func (*CreateRawTransactionResponse) ProtoMessage() {}
 
 func (x *CreateRawTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[82]
+	mi := &file_ilxrpc_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4534,7 +5246,7 @@ func (x *CreateRawTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{82}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateRawTransactionResponse) GetRawTx() *RawTransaction {
@@ -4556,7 +5268,7 @@ type CreateRawStakeTransactionRequest struct {
 func (x *CreateRawStakeTransactionRequest) Reset() {
 	*x = CreateRawStakeTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[83]
+		mi := &file_ilxrpc_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4569,7 +5281,7 @@ func (x *CreateRawStakeTransactionRequest) String() string {
 func (*CreateRawStakeTransactionRequest) ProtoMessage() {}
 
 func (x *CreateRawStakeTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[83]
+	mi := &file_ilxrpc_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4582,7 +5294,7 @@ func (x *CreateRawStakeTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRawStakeTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateRawStakeTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{83}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CreateRawStakeTransactionRequest) GetInput() *CreateRawStakeTransactionRequest_Input {
@@ -4604,7 +5316,7 @@ type CreateRawStakeTransactionResponse struct {
 func (x *CreateRawStakeTransactionResponse) Reset() {
 	*x = CreateRawStakeTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[84]
+		mi := &file_ilxrpc_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4617,7 +5329,7 @@ func (x *CreateRawStakeTransactionResponse) String() string {
 func (*CreateRawStakeTransactionResponse) ProtoMessage() {}
 
 func (x *CreateRawStakeTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[84]
+	mi := &file_ilxrpc_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4630,7 +5342,7 @@ func (x *CreateRawStakeTransactionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateRawStakeTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateRawStakeTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{84}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *CreateRawStakeTransactionResponse) GetRawTx() *RawTransaction {
@@ -4652,7 +5364,7 @@ type ProveRawTransactionRequest struct {
 func (x *ProveRawTransactionRequest) Reset() {
 	*x = ProveRawTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[85]
+		mi := &file_ilxrpc_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4665,7 +5377,7 @@ func (x *ProveRawTransactionRequest) String() string {
 func (*ProveRawTransactionRequest) ProtoMessage() {}
 
 func (x *ProveRawTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[85]
+	mi := &file_ilxrpc_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4678,7 +5390,7 @@ func (x *ProveRawTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRawTransactionRequest.ProtoReflect.Descriptor instead.
 func (*ProveRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{85}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ProveRawTransactionRequest) GetRawTx() *RawTransaction {
@@ -4700,7 +5412,7 @@ type ProveRawTransactionResponse struct {
 func (x *ProveRawTransactionResponse) Reset() {
 	*x = ProveRawTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[86]
+		mi := &file_ilxrpc_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4713,7 +5425,7 @@ func (x *ProveRawTransactionResponse) String() string {
 func (*ProveRawTransactionResponse) ProtoMessage() {}
 
 func (x *ProveRawTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[86]
+	mi := &file_ilxrpc_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,7 +5438,7 @@ func (x *ProveRawTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProveRawTransactionResponse.ProtoReflect.Descriptor instead.
 func (*ProveRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{86}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ProveRawTransactionResponse) GetProvedTx() *transactions.Transaction {
@@ -4748,7 +5460,7 @@ type StakeRequest struct {
 func (x *StakeRequest) Reset() {
 	*x = StakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[87]
+		mi := &file_ilxrpc_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4761,7 +5473,7 @@ func (x *StakeRequest) String() string {
 func (*StakeRequest) ProtoMessage() {}
 
 func (x *StakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[87]
+	mi := &file_ilxrpc_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4774,7 +5486,7 @@ func (x *StakeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeRequest.ProtoReflect.Descriptor instead.
 func (*StakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{87}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *StakeRequest) GetCommitments() [][]byte {
@@ -4793,7 +5505,7 @@ type StakeResponse struct {
 func (x *StakeResponse) Reset() {
 	*x = StakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[88]
+		mi := &file_ilxrpc_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4806,7 +5518,7 @@ func (x *StakeResponse) String() string {
 func (*StakeResponse) ProtoMessage() {}
 
 func (x *StakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[88]
+	mi := &file_ilxrpc_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4819,7 +5531,7 @@ func (x *StakeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeResponse.ProtoReflect.Descriptor instead.
 func (*StakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{88}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{95}
 }
 
 type SetAutoStakeRewardsRequest struct {
@@ -4834,7 +5546,7 @@ type SetAutoStakeRewardsRequest struct {
 func (x *SetAutoStakeRewardsRequest) Reset() {
 	*x = SetAutoStakeRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[89]
+		mi := &file_ilxrpc_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4847,7 +5559,7 @@ func (x *SetAutoStakeRewardsRequest) String() string {
 func (*SetAutoStakeRewardsRequest) ProtoMessage() {}
 
 func (x *SetAutoStakeRewardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[89]
+	mi := &file_ilxrpc_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4860,7 +5572,7 @@ func (x *SetAutoStakeRewardsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAutoStakeRewardsRequest.ProtoReflect.Descriptor instead.
 func (*SetAutoStakeRewardsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{89}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *SetAutoStakeRewardsRequest) GetAutostake() bool {
@@ -4879,7 +5591,7 @@ type SetAutoStakeRewardsResponse struct {
 func (x *SetAutoStakeRewardsResponse) Reset() {
 	*x = SetAutoStakeRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[90]
+		mi := &file_ilxrpc_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4892,7 +5604,7 @@ func (x *SetAutoStakeRewardsResponse) String() string {
 func (*SetAutoStakeRewardsResponse) ProtoMessage() {}
 
 func (x *SetAutoStakeRewardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[90]
+	mi := &file_ilxrpc_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +5617,7 @@ func (x *SetAutoStakeRewardsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAutoStakeRewardsResponse.ProtoReflect.Descriptor instead.
 func (*SetAutoStakeRewardsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{90}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{97}
 }
 
 type SpendRequest struct {
@@ -4918,7 +5630,7 @@ type SpendRequest struct {
 	// Amount to send
 	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
+	// If zero the node's fee estimate is used.
 	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 	// An optional list of input commitments to spend. If this
 	// is empty the wallet will select its own inputs.
@@ -4932,7 +5644,7 @@ type SpendRequest struct {
 func (x *SpendRequest) Reset() {
 	*x = SpendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[91]
+		mi := &file_ilxrpc_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4945,7 +5657,7 @@ func (x *SpendRequest) String() string {
 func (*SpendRequest) ProtoMessage() {}
 
 func (x *SpendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[91]
+	mi := &file_ilxrpc_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4958,7 +5670,7 @@ func (x *SpendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpendRequest.ProtoReflect.Descriptor instead.
 func (*SpendRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{91}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *SpendRequest) GetToAddress() string {
@@ -5003,7 +5715,7 @@ type SpendResponse struct {
 func (x *SpendResponse) Reset() {
 	*x = SpendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[92]
+		mi := &file_ilxrpc_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5016,7 +5728,7 @@ func (x *SpendResponse) String() string {
 func (*SpendResponse) ProtoMessage() {}
 
 func (x *SpendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[92]
+	mi := &file_ilxrpc_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5029,7 +5741,7 @@ func (x *SpendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpendResponse.ProtoReflect.Descriptor instead.
 func (*SpendResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{92}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *SpendResponse) GetTransaction_ID() []byte {
@@ -5049,7 +5761,7 @@ type TimelockCoinsRequest struct {
 	// The unix time (in seconds) to lock the coins until
 	LockUntil int64 `protobuf:"varint,2,opt,name=lock_until,json=lockUntil,proto3" json:"lock_until,omitempty"`
 	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
+	// If zero the node's fee estimate is used.
 	FeePerKilobyte uint64 `protobuf:"varint,3,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 	// An optional list of input commitments to spend. If this
 	// is empty the wallet will select its own inputs.
@@ -5063,7 +5775,7 @@ type TimelockCoinsRequest struct {
 func (x *TimelockCoinsRequest) Reset() {
 	*x = TimelockCoinsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[93]
+		mi := &file_ilxrpc_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5076,7 +5788,7 @@ func (x *TimelockCoinsRequest) String() string {
 func (*TimelockCoinsRequest) ProtoMessage() {}
 
 func (x *TimelockCoinsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[93]
+	mi := &file_ilxrpc_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5089,7 +5801,7 @@ func (x *TimelockCoinsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimelockCoinsRequest.ProtoReflect.Descriptor instead.
 func (*TimelockCoinsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{93}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *TimelockCoinsRequest) GetAmount() uint64 {
@@ -5134,7 +5846,7 @@ type TimelockCoinsResponse struct {
 func (x *TimelockCoinsResponse) Reset() {
 	*x = TimelockCoinsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[94]
+		mi := &file_ilxrpc_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5147,7 +5859,7 @@ func (x *TimelockCoinsResponse) String() string {
 func (*TimelockCoinsResponse) ProtoMessage() {}
 
 func (x *TimelockCoinsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[94]
+	mi := &file_ilxrpc_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5160,7 +5872,7 @@ func (x *TimelockCoinsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimelockCoinsResponse.ProtoReflect.Descriptor instead.
 func (*TimelockCoinsResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{94}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *TimelockCoinsResponse) GetTransaction_ID() []byte {
@@ -5178,7 +5890,7 @@ type SweepWalletRequest struct {
 	// Address to send funds to
 	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	// The fee to use for the transaction.
-	// If zero the wallet will use its internal fee policy.
+	// If zero the node's fee estimate is used.
 	FeePerKilobyte uint64 `protobuf:"varint,2,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 	// An optional list of input commitments to sweep. If this
 	// is empty the entire wallet will be swept.
@@ -5192,7 +5904,7 @@ type SweepWalletRequest struct {
 func (x *SweepWalletRequest) Reset() {
 	*x = SweepWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[95]
+		mi := &file_ilxrpc_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5205,7 +5917,7 @@ func (x *SweepWalletRequest) String() string {
 func (*SweepWalletRequest) ProtoMessage() {}
 
 func (x *SweepWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[95]
+	mi := &file_ilxrpc_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5218,7 +5930,7 @@ func (x *SweepWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SweepWalletRequest.ProtoReflect.Descriptor instead.
 func (*SweepWalletRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{95}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SweepWalletRequest) GetToAddress() string {
@@ -5256,7 +5968,7 @@ type SweepWalletResponse struct {
 func (x *SweepWalletResponse) Reset() {
 	*x = SweepWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[96]
+		mi := &file_ilxrpc_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5269,7 +5981,7 @@ func (x *SweepWalletResponse) String() string {
 func (*SweepWalletResponse) ProtoMessage() {}
 
 func (x *SweepWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[96]
+	mi := &file_ilxrpc_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5282,7 +5994,7 @@ func (x *SweepWalletResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SweepWalletResponse.ProtoReflect.Descriptor instead.
 func (*SweepWalletResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{96}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *SweepWalletResponse) GetTransaction_ID() []byte {
@@ -5292,6 +6004,126 @@ func (x *SweepWalletResponse) GetTransaction_ID() []byte {
 	return nil
 }
 
+type BumpFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the transaction to replace.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The fee per kilobyte to pay for the replacement. If zero
+	// the original fee per kilobyte plus the mempool's minimum
+	// fee per kilobyte is used.
+	FeePerKilobyte uint64 `protobuf:"varint,2,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// An optional list of the input commitments spent by the
+	// original transaction. If this is empty the wallet will
+	// try to find the inputs itself.
+	InputCommitments [][]byte `protobuf:"bytes,3,rep,name=input_commitments,json=inputCommitments,proto3" json:"input_commitments,omitempty"`
+}
+
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeRequest) ProtoMessage() {}
+
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *BumpFeeRequest) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *BumpFeeRequest) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+func (x *BumpFeeRequest) GetInputCommitments() [][]byte {
+	if x != nil {
+		return x.InputCommitments
+	}
+	return nil
+}
+
+type BumpFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID of the replacement transaction.
+	//
+	// If submission was unsuccessful and error will be returned.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+}
+
+func (x *BumpFeeResponse) Reset() {
+	*x = BumpFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeResponse) ProtoMessage() {}
+
+func (x *BumpFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BumpFeeResponse) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
 type SubscribeWalletTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5301,7 +6133,7 @@ type SubscribeWalletTransactionsRequest struct {
 func (x *SubscribeWalletTransactionsRequest) Reset() {
 	*x = SubscribeWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[97]
+		mi := &file_ilxrpc_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5314,7 +6146,7 @@ func (x *SubscribeWalletTransactionsRequest) String() string {
 func (*SubscribeWalletTransactionsRequest) ProtoMessage() {}
 
 func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[97]
+	mi := &file_ilxrpc_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5327,7 +6159,7 @@ func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeWalletTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeWalletTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{97}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{106}
 }
 
 type SubscribeWalletSyncNotificationsRequest struct {
@@ -5339,7 +6171,7 @@ type SubscribeWalletSyncNotificationsRequest struct {
 func (x *SubscribeWalletSyncNotificationsRequest) Reset() {
 	*x = SubscribeWalletSyncNotificationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[98]
+		mi := &file_ilxrpc_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5352,7 +6184,7 @@ func (x *SubscribeWalletSyncNotificationsRequest) String() string {
 func (*SubscribeWalletSyncNotificationsRequest) ProtoMessage() {}
 
 func (x *SubscribeWalletSyncNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[98]
+	mi := &file_ilxrpc_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5365,7 +6197,7 @@ func (x *SubscribeWalletSyncNotificationsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use SubscribeWalletSyncNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeWalletSyncNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{98}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{107}
 }
 
 // NodeService
@@ -5378,7 +6210,7 @@ type GetHostInfoRequest struct {
 func (x *GetHostInfoRequest) Reset() {
 	*x = GetHostInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[99]
+		mi := &file_ilxrpc_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5391,7 +6223,7 @@ func (x *GetHostInfoRequest) String() string {
 func (*GetHostInfoRequest) ProtoMessage() {}
 
 func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[99]
+	mi := &file_ilxrpc_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5404,7 +6236,7 @@ func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHostInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetHostInfoRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{99}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{108}
 }
 
 type GetHostInfoResponse struct {
@@ -5427,7 +6259,7 @@ type GetHostInfoResponse struct {
 func (x *GetHostInfoResponse) Reset() {
 	*x = GetHostInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[100]
+		mi := &file_ilxrpc_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5440,7 +6272,7 @@ func (x *GetHostInfoResponse) String() string {
 func (*GetHostInfoResponse) ProtoMessage() {}
 
 func (x *GetHostInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[100]
+	mi := &file_ilxrpc_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5453,7 +6285,7 @@ func (x *GetHostInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHostInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetHostInfoResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{100}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetHostInfoResponse) GetPeer_ID() string {
@@ -5500,7 +6332,7 @@ type GetNetworkKeyRequest struct {
 func (x *GetNetworkKeyRequest) Reset() {
 	*x = GetNetworkKeyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[101]
+		mi := &file_ilxrpc_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5513,7 +6345,7 @@ func (x *GetNetworkKeyRequest) String() string {
 func (*GetNetworkKeyRequest) ProtoMessage() {}
 
 func (x *GetNetworkKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[101]
+	mi := &file_ilxrpc_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +6358,7 @@ func (x *GetNetworkKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkKeyRequest.ProtoReflect.Descriptor instead.
 func (*GetNetworkKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{101}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{110}
 }
 
 type GetNetworkKeyResponse struct {
@@ -5541,7 +6373,7 @@ type GetNetworkKeyResponse struct {
 func (x *GetNetworkKeyResponse) Reset() {
 	*x = GetNetworkKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[102]
+		mi := &file_ilxrpc_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5554,7 +6386,7 @@ func (x *GetNetworkKeyResponse) String() string {
 func (*GetNetworkKeyResponse) ProtoMessage() {}
 
 func (x *GetNetworkKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[102]
+	mi := &file_ilxrpc_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5567,7 +6399,7 @@ func (x *GetNetworkKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetworkKeyResponse.ProtoReflect.Descriptor instead.
 func (*GetNetworkKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{102}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetNetworkKeyResponse) GetNetworkPrivateKey() []byte {
@@ -5586,7 +6418,7 @@ type GetPeersRequest struct {
 func (x *GetPeersRequest) Reset() {
 	*x = GetPeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[103]
+		mi := &file_ilxrpc_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5599,7 +6431,7 @@ func (x *GetPeersRequest) String() string {
 func (*GetPeersRequest) ProtoMessage() {}
 
 func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[103]
+	mi := &file_ilxrpc_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5612,7 +6444,7 @@ func (x *GetPeersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeersRequest.ProtoReflect.Descriptor instead.
 func (*GetPeersRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{103}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{112}
 }
 
 type GetPeersResponse struct {
@@ -5627,7 +6459,7 @@ type GetPeersResponse struct {
 func (x *GetPeersResponse) Reset() {
 	*x = GetPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[104]
+		mi := &file_ilxrpc_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5640,7 +6472,7 @@ func (x *GetPeersResponse) String() string {
 func (*GetPeersResponse) ProtoMessage() {}
 
 func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[104]
+	mi := &file_ilxrpc_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5653,7 +6485,7 @@ func (x *GetPeersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeersResponse.ProtoReflect.Descriptor instead.
 func (*GetPeersResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{104}
+	return file_ilxrpc_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetPeersResponse) GetPeers() []*Peer {
@@ -5663,33 +6495,29 @@ func (x *GetPeersResponse) GetPeers() []*Peer {
 	return nil
 }
 
-type AddPeerRequest struct {
+type GetNetTotalsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// The peer addr to add. The IP addresses for the peer
-	// will be looked up in the DHT if necessary.
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
-}
-
-func (x *AddPeerRequest) Reset() {
-	*x = AddPeerRequest{}
+func (x *GetNetTotalsRequest) Reset() {
+	*x = GetNetTotalsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[105]
+		mi := &file_ilxrpc_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerRequest) String() string {
+func (x *GetNetTotalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerRequest) ProtoMessage() {}
+func (*GetNetTotalsRequest) ProtoMessage() {}
 
-func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[105]
+func (x *GetNetTotalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5700,41 +6528,45 @@ func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
-func (*AddPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{105}
-}
-
-func (x *AddPeerRequest) GetPeer_ID() string {
-	if x != nil {
-		return x.Peer_ID
-	}
-	return ""
+// Deprecated: Use GetNetTotalsRequest.ProtoReflect.Descriptor instead.
+func (*GetNetTotalsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{114}
 }
 
-type AddPeerResponse struct {
+type GetNetTotalsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Total bytes received
+	BytesIn uint64 `protobuf:"varint,1,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	// Total bytes sent
+	BytesOut uint64 `protobuf:"varint,2,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	// Current receive rate in bytes per second
+	RateIn uint64 `protobuf:"varint,3,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	// Current send rate in bytes per second
+	RateOut uint64 `protobuf:"varint,4,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+	// Bandwidth usage broken down by protocol
+	Protocols []*GetNetTotalsResponse_ProtocolBandwidth `protobuf:"bytes,5,rep,name=protocols,proto3" json:"protocols,omitempty"`
 }
 
-func (x *AddPeerResponse) Reset() {
-	*x = AddPeerResponse{}
+func (x *GetNetTotalsResponse) Reset() {
+	*x = GetNetTotalsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[106]
+		mi := &file_ilxrpc_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddPeerResponse) String() string {
+func (x *GetNetTotalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerResponse) ProtoMessage() {}
+func (*GetNetTotalsResponse) ProtoMessage() {}
 
-func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[106]
+func (x *GetNetTotalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5745,82 +6577,69 @@ func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
-func (*AddPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{106}
-}
-
-type BlockPeerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Peer ID to block
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+// Deprecated: Use GetNetTotalsResponse.ProtoReflect.Descriptor instead.
+func (*GetNetTotalsResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *BlockPeerRequest) Reset() {
-	*x = BlockPeerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[107]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetNetTotalsResponse) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
 	}
+	return 0
 }
 
-func (x *BlockPeerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetNetTotalsResponse) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
 }
 
-func (*BlockPeerRequest) ProtoMessage() {}
-
-func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[107]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetNetTotalsResponse) GetRateIn() uint64 {
+	if x != nil {
+		return x.RateIn
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use BlockPeerRequest.ProtoReflect.Descriptor instead.
-func (*BlockPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{107}
+func (x *GetNetTotalsResponse) GetRateOut() uint64 {
+	if x != nil {
+		return x.RateOut
+	}
+	return 0
 }
 
-func (x *BlockPeerRequest) GetPeer_ID() string {
+func (x *GetNetTotalsResponse) GetProtocols() []*GetNetTotalsResponse_ProtocolBandwidth {
 	if x != nil {
-		return x.Peer_ID
+		return x.Protocols
 	}
-	return ""
+	return nil
 }
 
-type BlockPeerResponse struct {
+type GetSyncStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BlockPeerResponse) Reset() {
-	*x = BlockPeerResponse{}
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[108]
+		mi := &file_ilxrpc_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockPeerResponse) String() string {
+func (x *GetSyncStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockPeerResponse) ProtoMessage() {}
+func (*GetSyncStatusRequest) ProtoMessage() {}
 
-func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[108]
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5831,37 +6650,37 @@ func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockPeerResponse.ProtoReflect.Descriptor instead.
-func (*BlockPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{116}
 }
 
-type UnblockPeerRequest struct {
+type GetSyncStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Peer ID to unblock
-	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+	// The current sync status
+	Status *SyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UnblockPeerRequest) Reset() {
-	*x = UnblockPeerRequest{}
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[109]
+		mi := &file_ilxrpc_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnblockPeerRequest) String() string {
+func (x *GetSyncStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockPeerRequest) ProtoMessage() {}
+func (*GetSyncStatusResponse) ProtoMessage() {}
 
-func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[109]
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5872,41 +6691,45 @@ func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockPeerRequest.ProtoReflect.Descriptor instead.
-func (*UnblockPeerRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *UnblockPeerRequest) GetPeer_ID() string {
+func (x *GetSyncStatusResponse) GetStatus() *SyncStatus {
 	if x != nil {
-		return x.Peer_ID
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type UnblockPeerResponse struct {
+type SubscribeSyncStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// How often, in seconds, the node checks for a change in status.
+	// Defaults to five seconds.
+	IntervalSeconds uint32 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
-func (x *UnblockPeerResponse) Reset() {
-	*x = UnblockPeerResponse{}
+func (x *SubscribeSyncStatusRequest) Reset() {
+	*x = SubscribeSyncStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[110]
+		mi := &file_ilxrpc_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnblockPeerResponse) String() string {
+func (x *SubscribeSyncStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockPeerResponse) ProtoMessage() {}
+func (*SubscribeSyncStatusRequest) ProtoMessage() {}
 
-func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[110]
+func (x *SubscribeSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5917,37 +6740,41 @@ func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockPeerResponse.ProtoReflect.Descriptor instead.
-func (*UnblockPeerResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use SubscribeSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{118}
 }
 
-type SetLogLevelRequest struct {
+func (x *SubscribeSyncStatusRequest) GetIntervalSeconds() uint32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type GetChainTipsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The debug level to set the logging to
-	Level SetLogLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=pb.SetLogLevelRequest_Level" json:"level,omitempty"`
 }
 
-func (x *SetLogLevelRequest) Reset() {
-	*x = SetLogLevelRequest{}
+func (x *GetChainTipsRequest) Reset() {
+	*x = GetChainTipsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[111]
+		mi := &file_ilxrpc_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLogLevelRequest) String() string {
+func (x *GetChainTipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLogLevelRequest) ProtoMessage() {}
+func (*GetChainTipsRequest) ProtoMessage() {}
 
-func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[111]
+func (x *GetChainTipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5958,41 +6785,38 @@ func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
-func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{111}
-}
-
-func (x *SetLogLevelRequest) GetLevel() SetLogLevelRequest_Level {
-	if x != nil {
-		return x.Level
-	}
-	return SetLogLevelRequest_TRACE
+// Deprecated: Use GetChainTipsRequest.ProtoReflect.Descriptor instead.
+func (*GetChainTipsRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{119}
 }
 
-type SetLogLevelResponse struct {
+type GetChainTipsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The known chain tips. The first is always the tip
+	// of the node's best chain.
+	Tips []*ChainTip `protobuf:"bytes,1,rep,name=tips,proto3" json:"tips,omitempty"`
 }
 
-func (x *SetLogLevelResponse) Reset() {
-	*x = SetLogLevelResponse{}
+func (x *GetChainTipsResponse) Reset() {
+	*x = GetChainTipsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[112]
+		mi := &file_ilxrpc_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetLogLevelResponse) String() string {
+func (x *GetChainTipsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLogLevelResponse) ProtoMessage() {}
+func (*GetChainTipsResponse) ProtoMessage() {}
 
-func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[112]
+func (x *GetChainTipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6003,34 +6827,41 @@ func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
-func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use GetChainTipsResponse.ProtoReflect.Descriptor instead.
+func (*GetChainTipsResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{120}
 }
 
-type GetMinFeePerKilobyteRequest struct {
+func (x *GetChainTipsResponse) GetTips() []*ChainTip {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+type GetForksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetMinFeePerKilobyteRequest) Reset() {
-	*x = GetMinFeePerKilobyteRequest{}
+func (x *GetForksRequest) Reset() {
+	*x = GetForksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[113]
+		mi := &file_ilxrpc_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinFeePerKilobyteRequest) String() string {
+func (x *GetForksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinFeePerKilobyteRequest) ProtoMessage() {}
+func (*GetForksRequest) ProtoMessage() {}
 
-func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[113]
+func (x *GetForksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6041,37 +6872,37 @@ func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
-func (*GetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use GetForksRequest.ProtoReflect.Descriptor instead.
+func (*GetForksRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{121}
 }
 
-type GetMinFeePerKilobyteResponse struct {
+type GetForksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Fee per kilobyte response
-	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// The observed forks, most recent first
+	Forks []*Fork `protobuf:"bytes,1,rep,name=forks,proto3" json:"forks,omitempty"`
 }
 
-func (x *GetMinFeePerKilobyteResponse) Reset() {
-	*x = GetMinFeePerKilobyteResponse{}
+func (x *GetForksResponse) Reset() {
+	*x = GetForksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[114]
+		mi := &file_ilxrpc_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinFeePerKilobyteResponse) String() string {
+func (x *GetForksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinFeePerKilobyteResponse) ProtoMessage() {}
+func (*GetForksResponse) ProtoMessage() {}
 
-func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[114]
+func (x *GetForksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6082,44 +6913,41 @@ func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
-func (*GetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use GetForksResponse.ProtoReflect.Descriptor instead.
+func (*GetForksResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetMinFeePerKilobyteResponse) GetFeePerKilobyte() uint64 {
+func (x *GetForksResponse) GetForks() []*Fork {
 	if x != nil {
-		return x.FeePerKilobyte
+		return x.Forks
 	}
-	return 0
+	return nil
 }
 
-type SetMinFeePerKilobyteRequest struct {
+type SubscribeForksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Fee per byte to set
-	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *SetMinFeePerKilobyteRequest) Reset() {
-	*x = SetMinFeePerKilobyteRequest{}
+func (x *SubscribeForksRequest) Reset() {
+	*x = SubscribeForksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[115]
+		mi := &file_ilxrpc_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinFeePerKilobyteRequest) String() string {
+func (x *SubscribeForksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinFeePerKilobyteRequest) ProtoMessage() {}
+func (*SubscribeForksRequest) ProtoMessage() {}
 
-func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[115]
+func (x *SubscribeForksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6130,41 +6958,34 @@ func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
-func (*SetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *SetMinFeePerKilobyteRequest) GetFeePerKilobyte() uint64 {
-	if x != nil {
-		return x.FeePerKilobyte
-	}
-	return 0
+// Deprecated: Use SubscribeForksRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeForksRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{123}
 }
 
-type SetMinFeePerKilobyteResponse struct {
+type SubscribeMempoolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetMinFeePerKilobyteResponse) Reset() {
-	*x = SetMinFeePerKilobyteResponse{}
+func (x *SubscribeMempoolRequest) Reset() {
+	*x = SubscribeMempoolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[116]
+		mi := &file_ilxrpc_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinFeePerKilobyteResponse) String() string {
+func (x *SubscribeMempoolRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinFeePerKilobyteResponse) ProtoMessage() {}
+func (*SubscribeMempoolRequest) ProtoMessage() {}
 
-func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[116]
+func (x *SubscribeMempoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6175,34 +6996,38 @@ func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
-func (*SetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use SubscribeMempoolRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMempoolRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{124}
 }
 
-type GetMinStakeRequest struct {
+type AddPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The peer addr to add. The IP addresses for the peer
+	// will be looked up in the DHT if necessary.
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *GetMinStakeRequest) Reset() {
-	*x = GetMinStakeRequest{}
+func (x *AddPeerRequest) Reset() {
+	*x = AddPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[117]
+		mi := &file_ilxrpc_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinStakeRequest) String() string {
+func (x *AddPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinStakeRequest) ProtoMessage() {}
+func (*AddPeerRequest) ProtoMessage() {}
 
-func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[117]
+func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6213,37 +7038,41 @@ func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinStakeRequest.ProtoReflect.Descriptor instead.
-func (*GetMinStakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
+func (*AddPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{125}
 }
 
-type GetMinStakeResponse struct {
+func (x *AddPeerRequest) GetPeer_ID() string {
+	if x != nil {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+type AddPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Minimum stake response
-	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *GetMinStakeResponse) Reset() {
-	*x = GetMinStakeResponse{}
+func (x *AddPeerResponse) Reset() {
+	*x = AddPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[118]
+		mi := &file_ilxrpc_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMinStakeResponse) String() string {
+func (x *AddPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinStakeResponse) ProtoMessage() {}
+func (*AddPeerResponse) ProtoMessage() {}
 
-func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[118]
+func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6254,44 +7083,37 @@ func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinStakeResponse.ProtoReflect.Descriptor instead.
-func (*GetMinStakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{118}
-}
-
-func (x *GetMinStakeResponse) GetMinStakeAmount() uint64 {
-	if x != nil {
-		return x.MinStakeAmount
-	}
-	return 0
+// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
+func (*AddPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{126}
 }
 
-type SetMinStakeRequest struct {
+type BlockPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Minimum stake amount to set
-	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
+	// Peer ID to block
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *SetMinStakeRequest) Reset() {
-	*x = SetMinStakeRequest{}
+func (x *BlockPeerRequest) Reset() {
+	*x = BlockPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[119]
+		mi := &file_ilxrpc_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinStakeRequest) String() string {
+func (x *BlockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinStakeRequest) ProtoMessage() {}
+func (*BlockPeerRequest) ProtoMessage() {}
 
-func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[119]
+func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6302,41 +7124,41 @@ func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinStakeRequest.ProtoReflect.Descriptor instead.
-func (*SetMinStakeRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use BlockPeerRequest.ProtoReflect.Descriptor instead.
+func (*BlockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *SetMinStakeRequest) GetMinStakeAmount() uint64 {
+func (x *BlockPeerRequest) GetPeer_ID() string {
 	if x != nil {
-		return x.MinStakeAmount
+		return x.Peer_ID
 	}
-	return 0
+	return ""
 }
 
-type SetMinStakeResponse struct {
+type BlockPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetMinStakeResponse) Reset() {
-	*x = SetMinStakeResponse{}
+func (x *BlockPeerResponse) Reset() {
+	*x = BlockPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[120]
+		mi := &file_ilxrpc_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetMinStakeResponse) String() string {
+func (x *BlockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMinStakeResponse) ProtoMessage() {}
+func (*BlockPeerResponse) ProtoMessage() {}
 
-func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[120]
+func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6347,34 +7169,37 @@ func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMinStakeResponse.ProtoReflect.Descriptor instead.
-func (*SetMinStakeResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use BlockPeerResponse.ProtoReflect.Descriptor instead.
+func (*BlockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{128}
 }
 
-type GetBlockSizeSoftLimitRequest struct {
+type UnblockPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Peer ID to unblock
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
 }
 
-func (x *GetBlockSizeSoftLimitRequest) Reset() {
-	*x = GetBlockSizeSoftLimitRequest{}
+func (x *UnblockPeerRequest) Reset() {
+	*x = UnblockPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[121]
+		mi := &file_ilxrpc_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockSizeSoftLimitRequest) String() string {
+func (x *UnblockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockSizeSoftLimitRequest) ProtoMessage() {}
+func (*UnblockPeerRequest) ProtoMessage() {}
 
-func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[121]
+func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6385,37 +7210,41 @@ func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use UnblockPeerRequest.ProtoReflect.Descriptor instead.
+func (*UnblockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{129}
 }
 
-type GetBlockSizeSoftLimitResponse struct {
+func (x *UnblockPeerRequest) GetPeer_ID() string {
+	if x != nil {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+type UnblockPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Block size response
-	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *GetBlockSizeSoftLimitResponse) Reset() {
-	*x = GetBlockSizeSoftLimitResponse{}
+func (x *UnblockPeerResponse) Reset() {
+	*x = UnblockPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[122]
+		mi := &file_ilxrpc_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBlockSizeSoftLimitResponse) String() string {
+func (x *UnblockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockSizeSoftLimitResponse) ProtoMessage() {}
+func (*UnblockPeerResponse) ProtoMessage() {}
 
-func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[122]
+func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6426,44 +7255,43 @@ func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{122}
-}
-
-func (x *GetBlockSizeSoftLimitResponse) GetBlockSize() uint32 {
-	if x != nil {
-		return x.BlockSize
-	}
-	return 0
+// Deprecated: Use UnblockPeerResponse.ProtoReflect.Descriptor instead.
+func (*UnblockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{130}
 }
 
-type SetBlockSizeSoftLimitRequest struct {
+type BlockSubnetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Blocksize to set
-	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	// The subnet to block in CIDR notation.
+	// For example: 1.2.3.0/24
+	Subnet string `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// The number of seconds to block the subnet for.
+	// If zero the node's default ban duration is used.
+	Duration uint32 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// An optional reason for the ban
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *SetBlockSizeSoftLimitRequest) Reset() {
-	*x = SetBlockSizeSoftLimitRequest{}
+func (x *BlockSubnetRequest) Reset() {
+	*x = BlockSubnetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[123]
+		mi := &file_ilxrpc_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetBlockSizeSoftLimitRequest) String() string {
+func (x *BlockSubnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockSizeSoftLimitRequest) ProtoMessage() {}
+func (*BlockSubnetRequest) ProtoMessage() {}
 
-func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[123]
+func (x *BlockSubnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6474,41 +7302,55 @@ func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
-func (*SetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use BlockSubnetRequest.ProtoReflect.Descriptor instead.
+func (*BlockSubnetRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *SetBlockSizeSoftLimitRequest) GetBlockSize() uint32 {
+func (x *BlockSubnetRequest) GetSubnet() string {
 	if x != nil {
-		return x.BlockSize
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *BlockSubnetRequest) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
 	}
 	return 0
 }
 
-type SetBlockSizeSoftLimitResponse struct {
+func (x *BlockSubnetRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BlockSubnetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetBlockSizeSoftLimitResponse) Reset() {
-	*x = SetBlockSizeSoftLimitResponse{}
+func (x *BlockSubnetResponse) Reset() {
+	*x = BlockSubnetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[124]
+		mi := &file_ilxrpc_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetBlockSizeSoftLimitResponse) String() string {
+func (x *BlockSubnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBlockSizeSoftLimitResponse) ProtoMessage() {}
+func (*BlockSubnetResponse) ProtoMessage() {}
 
-func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[124]
+func (x *BlockSubnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6519,34 +7361,37 @@ func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
-func (*SetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use BlockSubnetResponse.ProtoReflect.Descriptor instead.
+func (*BlockSubnetResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{132}
 }
 
-type GetTreasuryWhitelistRequest struct {
+type UnblockSubnetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The subnet to unblock in CIDR notation
+	Subnet string `protobuf:"bytes,1,opt,name=subnet,proto3" json:"subnet,omitempty"`
 }
 
-func (x *GetTreasuryWhitelistRequest) Reset() {
-	*x = GetTreasuryWhitelistRequest{}
+func (x *UnblockSubnetRequest) Reset() {
+	*x = UnblockSubnetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[125]
+		mi := &file_ilxrpc_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTreasuryWhitelistRequest) String() string {
+func (x *UnblockSubnetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTreasuryWhitelistRequest) ProtoMessage() {}
+func (*UnblockSubnetRequest) ProtoMessage() {}
 
-func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[125]
+func (x *UnblockSubnetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6557,37 +7402,41 @@ func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
-func (*GetTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use UnblockSubnetRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSubnetRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{133}
 }
 
-type GetTreasuryWhitelistResponse struct {
+func (x *UnblockSubnetRequest) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+type UnblockSubnetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Whitelisted txids
-	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
 }
 
-func (x *GetTreasuryWhitelistResponse) Reset() {
-	*x = GetTreasuryWhitelistResponse{}
+func (x *UnblockSubnetResponse) Reset() {
+	*x = UnblockSubnetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[126]
+		mi := &file_ilxrpc_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTreasuryWhitelistResponse) String() string {
+func (x *UnblockSubnetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTreasuryWhitelistResponse) ProtoMessage() {}
+func (*UnblockSubnetResponse) ProtoMessage() {}
 
-func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[126]
+func (x *UnblockSubnetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6598,46 +7447,34 @@ func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
-func (*GetTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{126}
-}
-
-func (x *GetTreasuryWhitelistResponse) GetTxids() [][]byte {
-	if x != nil {
-		return x.Txids
-	}
-	return nil
+// Deprecated: Use UnblockSubnetResponse.ProtoReflect.Descriptor instead.
+func (*UnblockSubnetResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{134}
 }
 
-type UpdateTreasuryWhitelistRequest struct {
+type ListBansRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Txids to add to the whitelist
-	Add [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
-	// Txids to remove from the whitelist
-	Remove [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *UpdateTreasuryWhitelistRequest) Reset() {
-	*x = UpdateTreasuryWhitelistRequest{}
+func (x *ListBansRequest) Reset() {
+	*x = ListBansRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[127]
+		mi := &file_ilxrpc_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTreasuryWhitelistRequest) String() string {
+func (x *ListBansRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTreasuryWhitelistRequest) ProtoMessage() {}
+func (*ListBansRequest) ProtoMessage() {}
 
-func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[127]
+func (x *ListBansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6648,48 +7485,37 @@ func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{127}
-}
-
-func (x *UpdateTreasuryWhitelistRequest) GetAdd() [][]byte {
-	if x != nil {
-		return x.Add
-	}
-	return nil
-}
-
-func (x *UpdateTreasuryWhitelistRequest) GetRemove() [][]byte {
-	if x != nil {
-		return x.Remove
-	}
-	return nil
+// Deprecated: Use ListBansRequest.ProtoReflect.Descriptor instead.
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{135}
 }
 
-type UpdateTreasuryWhitelistResponse struct {
+type ListBansResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The list of active bans
+	Bans []*ListBansResponse_Ban `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
 }
 
-func (x *UpdateTreasuryWhitelistResponse) Reset() {
-	*x = UpdateTreasuryWhitelistResponse{}
+func (x *ListBansResponse) Reset() {
+	*x = ListBansResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[128]
+		mi := &file_ilxrpc_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateTreasuryWhitelistResponse) String() string {
+func (x *ListBansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTreasuryWhitelistResponse) ProtoMessage() {}
+func (*ListBansResponse) ProtoMessage() {}
 
-func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[128]
+func (x *ListBansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6700,41 +7526,47 @@ func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use ListBansResponse.ProtoReflect.Descriptor instead.
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{136}
 }
 
-type ReconsiderBlockRequest struct {
+func (x *ListBansResponse) GetBans() []*ListBansResponse_Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type AddAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Block ID to reconsider.
-	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
-	// We likely don't have the block and will have to download it from
-	// another peer. You can set the peer here. If empty we will try to find
-	// it form a few random peers.
-	DownloadPeer string `protobuf:"bytes,2,opt,name=download_peer,json=downloadPeer,proto3" json:"download_peer,omitempty"`
+	// Types that are assignable to Entry:
+	//
+	//	*AddAllowlistRequest_Peer_ID
+	//	*AddAllowlistRequest_Subnet
+	Entry isAddAllowlistRequest_Entry `protobuf_oneof:"entry"`
 }
 
-func (x *ReconsiderBlockRequest) Reset() {
-	*x = ReconsiderBlockRequest{}
+func (x *AddAllowlistRequest) Reset() {
+	*x = AddAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[129]
+		mi := &file_ilxrpc_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconsiderBlockRequest) String() string {
+func (x *AddAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconsiderBlockRequest) ProtoMessage() {}
+func (*AddAllowlistRequest) ProtoMessage() {}
 
-func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[129]
+func (x *AddAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6745,48 +7577,73 @@ func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconsiderBlockRequest.ProtoReflect.Descriptor instead.
-func (*ReconsiderBlockRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use AddAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*AddAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *ReconsiderBlockRequest) GetBlock_ID() []byte {
-	if x != nil {
-		return x.Block_ID
+func (m *AddAllowlistRequest) GetEntry() isAddAllowlistRequest_Entry {
+	if m != nil {
+		return m.Entry
 	}
 	return nil
 }
 
-func (x *ReconsiderBlockRequest) GetDownloadPeer() string {
-	if x != nil {
-		return x.DownloadPeer
+func (x *AddAllowlistRequest) GetPeer_ID() string {
+	if x, ok := x.GetEntry().(*AddAllowlistRequest_Peer_ID); ok {
+		return x.Peer_ID
 	}
 	return ""
 }
 
-type ReconsiderBlockResponse struct {
+func (x *AddAllowlistRequest) GetSubnet() string {
+	if x, ok := x.GetEntry().(*AddAllowlistRequest_Subnet); ok {
+		return x.Subnet
+	}
+	return ""
+}
+
+type isAddAllowlistRequest_Entry interface {
+	isAddAllowlistRequest_Entry()
+}
+
+type AddAllowlistRequest_Peer_ID struct {
+	// The peer ID to allowlist
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3,oneof"`
+}
+
+type AddAllowlistRequest_Subnet struct {
+	// The subnet to allowlist in CIDR notation
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3,oneof"`
+}
+
+func (*AddAllowlistRequest_Peer_ID) isAddAllowlistRequest_Entry() {}
+
+func (*AddAllowlistRequest_Subnet) isAddAllowlistRequest_Entry() {}
+
+type AddAllowlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ReconsiderBlockResponse) Reset() {
-	*x = ReconsiderBlockResponse{}
+func (x *AddAllowlistResponse) Reset() {
+	*x = AddAllowlistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[130]
+		mi := &file_ilxrpc_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReconsiderBlockResponse) String() string {
+func (x *AddAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconsiderBlockResponse) ProtoMessage() {}
+func (*AddAllowlistResponse) ProtoMessage() {}
 
-func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[130]
+func (x *AddAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6797,34 +7654,40 @@ func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconsiderBlockResponse.ProtoReflect.Descriptor instead.
-func (*ReconsiderBlockResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use AddAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*AddAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{138}
 }
 
-type RecomputeChainStateRequest struct {
+type RemoveAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Entry:
+	//
+	//	*RemoveAllowlistRequest_Peer_ID
+	//	*RemoveAllowlistRequest_Subnet
+	Entry isRemoveAllowlistRequest_Entry `protobuf_oneof:"entry"`
 }
 
-func (x *RecomputeChainStateRequest) Reset() {
-	*x = RecomputeChainStateRequest{}
+func (x *RemoveAllowlistRequest) Reset() {
+	*x = RemoveAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[131]
+		mi := &file_ilxrpc_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecomputeChainStateRequest) String() string {
+func (x *RemoveAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecomputeChainStateRequest) ProtoMessage() {}
+func (*RemoveAllowlistRequest) ProtoMessage() {}
 
-func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[131]
+func (x *RemoveAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6835,34 +7698,73 @@ func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecomputeChainStateRequest.ProtoReflect.Descriptor instead.
-func (*RecomputeChainStateRequest) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use RemoveAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{139}
 }
 
-type RecomputeChainStateResponse struct {
+func (m *RemoveAllowlistRequest) GetEntry() isRemoveAllowlistRequest_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (x *RemoveAllowlistRequest) GetPeer_ID() string {
+	if x, ok := x.GetEntry().(*RemoveAllowlistRequest_Peer_ID); ok {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+func (x *RemoveAllowlistRequest) GetSubnet() string {
+	if x, ok := x.GetEntry().(*RemoveAllowlistRequest_Subnet); ok {
+		return x.Subnet
+	}
+	return ""
+}
+
+type isRemoveAllowlistRequest_Entry interface {
+	isRemoveAllowlistRequest_Entry()
+}
+
+type RemoveAllowlistRequest_Peer_ID struct {
+	// The peer ID to remove from the allowlist
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3,oneof"`
+}
+
+type RemoveAllowlistRequest_Subnet struct {
+	// The subnet to remove from the allowlist in CIDR notation
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3,oneof"`
+}
+
+func (*RemoveAllowlistRequest_Peer_ID) isRemoveAllowlistRequest_Entry() {}
+
+func (*RemoveAllowlistRequest_Subnet) isRemoveAllowlistRequest_Entry() {}
+
+type RemoveAllowlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RecomputeChainStateResponse) Reset() {
-	*x = RecomputeChainStateResponse{}
+func (x *RemoveAllowlistResponse) Reset() {
+	*x = RemoveAllowlistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[132]
+		mi := &file_ilxrpc_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecomputeChainStateResponse) String() string {
+func (x *RemoveAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecomputeChainStateResponse) ProtoMessage() {}
+func (*RemoveAllowlistResponse) ProtoMessage() {}
 
-func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[132]
+func (x *RemoveAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6873,39 +7775,34 @@ func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecomputeChainStateResponse.ProtoReflect.Descriptor instead.
-func (*RecomputeChainStateResponse) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use RemoveAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{140}
 }
 
-// NOTIFICATIONS
-type TransactionNotification struct {
+type ListAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The transaction in this notification has finalized and
-	// been added to the blockchain.
-	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *TransactionNotification) Reset() {
-	*x = TransactionNotification{}
+func (x *ListAllowlistRequest) Reset() {
+	*x = ListAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[133]
+		mi := &file_ilxrpc_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionNotification) String() string {
+func (x *ListAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionNotification) ProtoMessage() {}
+func (*ListAllowlistRequest) ProtoMessage() {}
 
-func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[133]
+func (x *ListAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6916,45 +7813,39 @@ func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
-func (*TransactionNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{133}
-}
-
-func (x *TransactionNotification) GetTransaction() *transactions.Transaction {
-	if x != nil {
-		return x.Transaction
-	}
-	return nil
+// Deprecated: Use ListAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{141}
 }
 
-type WalletTransactionNotification struct {
+type ListAllowlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction in this notification has finalized and
-	// been added to the blockchain.
-	Transaction *WalletTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The allowlisted peer IDs
+	Peer_IDs []string `protobuf:"bytes,1,rep,name=peer_IDs,json=peerIDs,proto3" json:"peer_IDs,omitempty"`
+	// The allowlisted subnets in CIDR notation
+	Subnets []string `protobuf:"bytes,2,rep,name=subnets,proto3" json:"subnets,omitempty"`
 }
 
-func (x *WalletTransactionNotification) Reset() {
-	*x = WalletTransactionNotification{}
+func (x *ListAllowlistResponse) Reset() {
+	*x = ListAllowlistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[134]
+		mi := &file_ilxrpc_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransactionNotification) String() string {
+func (x *ListAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransactionNotification) ProtoMessage() {}
+func (*ListAllowlistResponse) ProtoMessage() {}
 
-func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[134]
+func (x *ListAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6965,46 +7856,51 @@ func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransactionNotification.ProtoReflect.Descriptor instead.
-func (*WalletTransactionNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use ListAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*ListAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *WalletTransactionNotification) GetTransaction() *WalletTransaction {
+func (x *ListAllowlistResponse) GetPeer_IDs() []string {
 	if x != nil {
-		return x.Transaction
+		return x.Peer_IDs
 	}
 	return nil
 }
 
-type WalletSyncNotification struct {
+func (x *ListAllowlistResponse) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+type SetLogLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The current height the wallet is synced up to
-	CurrentHeight uint32 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
-	// The height of the chain that the wallet is syncing to
-	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	// The debug level to set the logging to
+	Level SetLogLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=pb.SetLogLevelRequest_Level" json:"level,omitempty"`
 }
 
-func (x *WalletSyncNotification) Reset() {
-	*x = WalletSyncNotification{}
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[135]
+		mi := &file_ilxrpc_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletSyncNotification) String() string {
+func (x *SetLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletSyncNotification) ProtoMessage() {}
+func (*SetLogLevelRequest) ProtoMessage() {}
 
-func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[135]
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7015,56 +7911,41 @@ func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletSyncNotification.ProtoReflect.Descriptor instead.
-func (*WalletSyncNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *WalletSyncNotification) GetCurrentHeight() uint32 {
+func (x *SetLogLevelRequest) GetLevel() SetLogLevelRequest_Level {
 	if x != nil {
-		return x.CurrentHeight
+		return x.Level
 	}
-	return 0
-}
-
-func (x *WalletSyncNotification) GetBestHeight() uint32 {
-	if x != nil {
-		return x.BestHeight
-	}
-	return 0
+	return SetLogLevelRequest_TRACE
 }
 
-type BlockNotification struct {
+type SetLogLevelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The BlockInfo (including header data) for the block
-	BlockInfo *BlockInfo `protobuf:"bytes,1,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
-	// The blocks transactions (if requested).
-	//
-	// The transactions will either be returned in for or just the txids depending
-	// on the request.
-	Transactions []*TransactionData `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *BlockNotification) Reset() {
-	*x = BlockNotification{}
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[136]
+		mi := &file_ilxrpc_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockNotification) String() string {
+func (x *SetLogLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockNotification) ProtoMessage() {}
+func (*SetLogLevelResponse) ProtoMessage() {}
 
-func (x *BlockNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[136]
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7075,52 +7956,75 @@ func (x *BlockNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
-func (*BlockNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *BlockNotification) GetBlockInfo() *BlockInfo {
-	if x != nil {
-		return x.BlockInfo
+type GetMinFeePerKilobyteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMinFeePerKilobyteRequest) Reset() {
+	*x = GetMinFeePerKilobyteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *BlockNotification) GetTransactions() []*TransactionData {
-	if x != nil {
-		return x.Transactions
+func (x *GetMinFeePerKilobyteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinFeePerKilobyteRequest) ProtoMessage() {}
+
+func (x *GetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CompressedBlockNotification struct {
+// Deprecated: Use GetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
+func (*GetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{145}
+}
+
+type GetMinFeePerKilobyteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A compressed block containing only the height,
-	// txids, outputs, and nullifiers.
-	Block *blocks.CompressedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// Fee per kilobyte response
+	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *CompressedBlockNotification) Reset() {
-	*x = CompressedBlockNotification{}
+func (x *GetMinFeePerKilobyteResponse) Reset() {
+	*x = GetMinFeePerKilobyteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[137]
+		mi := &file_ilxrpc_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompressedBlockNotification) String() string {
+func (x *GetMinFeePerKilobyteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompressedBlockNotification) ProtoMessage() {}
+func (*GetMinFeePerKilobyteResponse) ProtoMessage() {}
 
-func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[137]
+func (x *GetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7131,48 +8035,44 @@ func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompressedBlockNotification.ProtoReflect.Descriptor instead.
-func (*CompressedBlockNotification) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use GetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
+func (*GetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *CompressedBlockNotification) GetBlock() *blocks.CompressedBlock {
+func (x *GetMinFeePerKilobyteResponse) GetFeePerKilobyte() uint64 {
 	if x != nil {
-		return x.Block
+		return x.FeePerKilobyte
 	}
-	return nil
+	return 0
 }
 
-// DATA MESSAGES
-type TransactionData struct {
+type SetMinFeePerKilobyteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to TxidsOrTxs:
-	//
-	//	*TransactionData_Transaction_ID
-	//	*TransactionData_Transaction
-	TxidsOrTxs isTransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+	// Fee per byte to set
+	FeePerKilobyte uint64 `protobuf:"varint,1,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
 }
 
-func (x *TransactionData) Reset() {
-	*x = TransactionData{}
+func (x *SetMinFeePerKilobyteRequest) Reset() {
+	*x = SetMinFeePerKilobyteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[138]
+		mi := &file_ilxrpc_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactionData) String() string {
+func (x *SetMinFeePerKilobyteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionData) ProtoMessage() {}
+func (*SetMinFeePerKilobyteRequest) ProtoMessage() {}
 
-func (x *TransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[138]
+func (x *SetMinFeePerKilobyteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7183,94 +8083,79 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
-func (*TransactionData) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use SetMinFeePerKilobyteRequest.ProtoReflect.Descriptor instead.
+func (*SetMinFeePerKilobyteRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{147}
 }
 
-func (m *TransactionData) GetTxidsOrTxs() isTransactionData_TxidsOrTxs {
-	if m != nil {
-		return m.TxidsOrTxs
+func (x *SetMinFeePerKilobyteRequest) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionData) GetTransaction_ID() []byte {
-	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction_ID); ok {
-		return x.Transaction_ID
-	}
-	return nil
+type SetMinFeePerKilobyteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *TransactionData) GetTransaction() *transactions.Transaction {
-	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction); ok {
-		return x.Transaction
+func (x *SetMinFeePerKilobyteResponse) Reset() {
+	*x = SetMinFeePerKilobyteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isTransactionData_TxidsOrTxs interface {
-	isTransactionData_TxidsOrTxs()
+func (x *SetMinFeePerKilobyteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type TransactionData_Transaction_ID struct {
-	// Just the transaction ID
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3,oneof"`
-}
+func (*SetMinFeePerKilobyteResponse) ProtoMessage() {}
 
-type TransactionData_Transaction struct {
-	// A marshaled transaction.
-	Transaction *transactions.Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+func (x *SetMinFeePerKilobyteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*TransactionData_Transaction_ID) isTransactionData_TxidsOrTxs() {}
-
-func (*TransactionData_Transaction) isTransactionData_TxidsOrTxs() {}
+// Deprecated: Use SetMinFeePerKilobyteResponse.ProtoReflect.Descriptor instead.
+func (*SetMinFeePerKilobyteResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{148}
+}
 
-type BlockInfo struct {
+type GetMinStakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The hash of the block header.
-	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
-	// A version number to track software/protocol upgrades.
-	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// The height of the block in the chain.
-	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// The block in the chain right before this one.
-	Parent []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
-	// The next block in the chain (or nil if this block is the tip).
-	Child []byte `protobuf:"bytes,5,opt,name=child,proto3" json:"child,omitempty"`
-	// The timestamp of the block. Expressed in seconds since 1970-01-01.
-	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The root of the Merkle Tree built from all transactions in the block.
-	TxRoot []byte `protobuf:"bytes,7,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
-	// The peerID of the validator that created the block.
-	Producer_ID []byte `protobuf:"bytes,8,opt,name=producer_ID,json=producerID,proto3" json:"producer_ID,omitempty"`
-	// The size of the block in bytes.
-	Size uint32 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
-	// The number of transactions in the block.
-	NumTxs uint32 `protobuf:"varint,10,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
 }
 
-func (x *BlockInfo) Reset() {
-	*x = BlockInfo{}
+func (x *GetMinStakeRequest) Reset() {
+	*x = GetMinStakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[139]
+		mi := &file_ilxrpc_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockInfo) String() string {
+func (x *GetMinStakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockInfo) ProtoMessage() {}
+func (*GetMinStakeRequest) ProtoMessage() {}
 
-func (x *BlockInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[139]
+func (x *GetMinStakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7281,117 +8166,85 @@ func (x *BlockInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
-func (*BlockInfo) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use GetMinStakeRequest.ProtoReflect.Descriptor instead.
+func (*GetMinStakeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *BlockInfo) GetBlock_ID() []byte {
-	if x != nil {
-		return x.Block_ID
-	}
-	return nil
-}
+type GetMinStakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *BlockInfo) GetVersion() uint32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
+	// Minimum stake response
+	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *BlockInfo) GetHeight() uint32 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
-}
-
-func (x *BlockInfo) GetParent() []byte {
-	if x != nil {
-		return x.Parent
-	}
-	return nil
-}
-
-func (x *BlockInfo) GetChild() []byte {
-	if x != nil {
-		return x.Child
+func (x *GetMinStakeResponse) Reset() {
+	*x = GetMinStakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *BlockInfo) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+func (x *GetMinStakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BlockInfo) GetTxRoot() []byte {
-	if x != nil {
-		return x.TxRoot
-	}
-	return nil
-}
+func (*GetMinStakeResponse) ProtoMessage() {}
 
-func (x *BlockInfo) GetProducer_ID() []byte {
-	if x != nil {
-		return x.Producer_ID
+func (x *GetMinStakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *BlockInfo) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+// Deprecated: Use GetMinStakeResponse.ProtoReflect.Descriptor instead.
+func (*GetMinStakeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *BlockInfo) GetNumTxs() uint32 {
+func (x *GetMinStakeResponse) GetMinStakeAmount() uint64 {
 	if x != nil {
-		return x.NumTxs
+		return x.MinStakeAmount
 	}
 	return 0
 }
 
-type Validator struct {
+type SetMinStakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The validator ID encoded in bytes.
-	Validator_ID []byte `protobuf:"bytes,1,opt,name=validator_ID,json=validatorID,proto3" json:"validator_ID,omitempty"`
-	// The number of coins staked by this validator.
-	TotalStake uint64 `protobuf:"varint,2,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
-	// The total stake weighted by time lock.
-	StakeWeight uint64 `protobuf:"varint,3,opt,name=stake_weight,json=stakeWeight,proto3" json:"stake_weight,omitempty"`
-	// The nullifiers for the utxos the validator has staked.
-	Stake []*Validator_Stake `protobuf:"bytes,4,rep,name=stake,proto3" json:"stake,omitempty"`
-	// The total of any unclaimed validator rewards.
-	UnclaimedCoins uint64 `protobuf:"varint,5,opt,name=unclaimed_coins,json=unclaimedCoins,proto3" json:"unclaimed_coins,omitempty"`
-	// The number of blocks this validator has created this epoch.
-	EpochBlocks uint32 `protobuf:"varint,6,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// Minimum stake amount to set
+	MinStakeAmount uint64 `protobuf:"varint,1,opt,name=min_stake_amount,json=minStakeAmount,proto3" json:"min_stake_amount,omitempty"`
 }
 
-func (x *Validator) Reset() {
-	*x = Validator{}
+func (x *SetMinStakeRequest) Reset() {
+	*x = SetMinStakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[140]
+		mi := &file_ilxrpc_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Validator) String() string {
+func (x *SetMinStakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator) ProtoMessage() {}
+func (*SetMinStakeRequest) ProtoMessage() {}
 
-func (x *Validator) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[140]
+func (x *SetMinStakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7402,91 +8255,41 @@ func (x *Validator) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
-func (*Validator) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{140}
-}
-
-func (x *Validator) GetValidator_ID() []byte {
-	if x != nil {
-		return x.Validator_ID
-	}
-	return nil
-}
-
-func (x *Validator) GetTotalStake() uint64 {
-	if x != nil {
-		return x.TotalStake
-	}
-	return 0
-}
-
-func (x *Validator) GetStakeWeight() uint64 {
-	if x != nil {
-		return x.StakeWeight
-	}
-	return 0
-}
-
-func (x *Validator) GetStake() []*Validator_Stake {
-	if x != nil {
-		return x.Stake
-	}
-	return nil
-}
-
-func (x *Validator) GetUnclaimedCoins() uint64 {
-	if x != nil {
-		return x.UnclaimedCoins
-	}
-	return 0
+// Deprecated: Use SetMinStakeRequest.ProtoReflect.Descriptor instead.
+func (*SetMinStakeRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *Validator) GetEpochBlocks() uint32 {
+func (x *SetMinStakeRequest) GetMinStakeAmount() uint64 {
 	if x != nil {
-		return x.EpochBlocks
+		return x.MinStakeAmount
 	}
 	return 0
 }
 
-type Utxo struct {
+type SetMinStakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The commitment associated with the output
-	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	// The amount of coins
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The address that the utxo is associated with
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// Whether or not this is a watch only utxo.
-	// We canot spend watch only utxos without the
-	// private key.
-	WatchOnly bool `protobuf:"varint,4,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
-	// Is this utxo staked by the wallet.
-	Staked bool `protobuf:"varint,5,opt,name=staked,proto3" json:"staked,omitempty"`
-	// The timestamp this utxo is timelocked until (if applicable)
-	LockedUntill int64 `protobuf:"varint,6,opt,name=locked_untill,json=lockedUntill,proto3" json:"locked_untill,omitempty"`
 }
 
-func (x *Utxo) Reset() {
-	*x = Utxo{}
+func (x *SetMinStakeResponse) Reset() {
+	*x = SetMinStakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[141]
+		mi := &file_ilxrpc_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Utxo) String() string {
+func (x *SetMinStakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Utxo) ProtoMessage() {}
+func (*SetMinStakeResponse) ProtoMessage() {}
 
-func (x *Utxo) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[141]
+func (x *SetMinStakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7497,83 +8300,75 @@ func (x *Utxo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
-func (*Utxo) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use SetMinStakeResponse.ProtoReflect.Descriptor instead.
+func (*SetMinStakeResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *Utxo) GetCommitment() []byte {
-	if x != nil {
-		return x.Commitment
-	}
-	return nil
+type GetBlockSizeSoftLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Utxo) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+func (x *GetBlockSizeSoftLimitRequest) Reset() {
+	*x = GetBlockSizeSoftLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Utxo) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x *GetBlockSizeSoftLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Utxo) GetWatchOnly() bool {
-	if x != nil {
-		return x.WatchOnly
-	}
-	return false
-}
+func (*GetBlockSizeSoftLimitRequest) ProtoMessage() {}
 
-func (x *Utxo) GetStaked() bool {
-	if x != nil {
-		return x.Staked
+func (x *GetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Utxo) GetLockedUntill() int64 {
-	if x != nil {
-		return x.LockedUntill
-	}
-	return 0
+// Deprecated: Use GetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{153}
 }
 
-type RawTransaction struct {
+type GetBlockSizeSoftLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The transaction as it appears on the network
-	Tx *transactions.Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	// The private (hidden) data for each input
-	Inputs []*PrivateInput `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// The private (hidden) data for each output
-	Outputs []*PrivateOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Block size response
+	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *RawTransaction) Reset() {
-	*x = RawTransaction{}
+func (x *GetBlockSizeSoftLimitResponse) Reset() {
+	*x = GetBlockSizeSoftLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[142]
+		mi := &file_ilxrpc_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RawTransaction) String() string {
+func (x *GetBlockSizeSoftLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RawTransaction) ProtoMessage() {}
+func (*GetBlockSizeSoftLimitResponse) ProtoMessage() {}
 
-func (x *RawTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[142]
+func (x *GetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7584,75 +8379,44 @@ func (x *RawTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RawTransaction.ProtoReflect.Descriptor instead.
-func (*RawTransaction) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use GetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *RawTransaction) GetTx() *transactions.Transaction {
+func (x *GetBlockSizeSoftLimitResponse) GetBlockSize() uint32 {
 	if x != nil {
-		return x.Tx
-	}
-	return nil
-}
-
-func (x *RawTransaction) GetInputs() []*PrivateInput {
-	if x != nil {
-		return x.Inputs
-	}
-	return nil
-}
-
-func (x *RawTransaction) GetOutputs() []*PrivateOutput {
-	if x != nil {
-		return x.Outputs
+		return x.BlockSize
 	}
-	return nil
+	return 0
 }
 
-type PrivateInput struct {
+type SetBlockSizeSoftLimitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The amount of the input
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Input asset ID
-	Asset_ID []byte `protobuf:"bytes,2,opt,name=asset_ID,json=assetID,proto3" json:"asset_ID,omitempty"`
-	// Input salt
-	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
-	// Input state
-	State []byte `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	// The txo proof linking the input
-	// commitment to the accumulator
-	TxoProof *TxoProof `protobuf:"bytes,5,opt,name=txo_proof,json=txoProof,proto3" json:"txo_proof,omitempty"`
-	// The locking script
-	Script string `protobuf:"bytes,6,opt,name=script,proto3" json:"script,omitempty"`
-	// The locking function parameters
-	LockingParams [][]byte `protobuf:"bytes,7,rep,name=locking_params,json=lockingParams,proto3" json:"locking_params,omitempty"`
-	// Optional lurk expression used as
-	// input to locking script. This is
-	// only necessary for watch-only utxos.
-	UnlockingParams string `protobuf:"bytes,8,opt,name=unlocking_params,json=unlockingParams,proto3" json:"unlocking_params,omitempty"`
+	// Blocksize to set
+	BlockSize uint32 `protobuf:"varint,1,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
 }
 
-func (x *PrivateInput) Reset() {
-	*x = PrivateInput{}
+func (x *SetBlockSizeSoftLimitRequest) Reset() {
+	*x = SetBlockSizeSoftLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[143]
+		mi := &file_ilxrpc_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrivateInput) String() string {
+func (x *SetBlockSizeSoftLimitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrivateInput) ProtoMessage() {}
+func (*SetBlockSizeSoftLimitRequest) ProtoMessage() {}
 
-func (x *PrivateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[143]
+func (x *SetBlockSizeSoftLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7663,101 +8427,79 @@ func (x *PrivateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrivateInput.ProtoReflect.Descriptor instead.
-func (*PrivateInput) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use SetBlockSizeSoftLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetBlockSizeSoftLimitRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *PrivateInput) GetAmount() uint64 {
+func (x *SetBlockSizeSoftLimitRequest) GetBlockSize() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.BlockSize
 	}
 	return 0
 }
 
-func (x *PrivateInput) GetAsset_ID() []byte {
-	if x != nil {
-		return x.Asset_ID
-	}
-	return nil
-}
-
-func (x *PrivateInput) GetSalt() []byte {
-	if x != nil {
-		return x.Salt
-	}
-	return nil
+type SetBlockSizeSoftLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PrivateInput) GetState() []byte {
-	if x != nil {
-		return x.State
+func (x *SetBlockSizeSoftLimitResponse) Reset() {
+	*x = SetBlockSizeSoftLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PrivateInput) GetTxoProof() *TxoProof {
-	if x != nil {
-		return x.TxoProof
-	}
-	return nil
+func (x *SetBlockSizeSoftLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PrivateInput) GetScript() string {
-	if x != nil {
-		return x.Script
-	}
-	return ""
-}
+func (*SetBlockSizeSoftLimitResponse) ProtoMessage() {}
 
-func (x *PrivateInput) GetLockingParams() [][]byte {
-	if x != nil {
-		return x.LockingParams
+func (x *SetBlockSizeSoftLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PrivateInput) GetUnlockingParams() string {
-	if x != nil {
-		return x.UnlockingParams
-	}
-	return ""
+// Deprecated: Use SetBlockSizeSoftLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetBlockSizeSoftLimitResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{156}
 }
 
-type PrivateOutput struct {
+type GetTreasuryWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Output scriptHash
-	ScriptHash []byte `protobuf:"bytes,1,opt,name=script_hash,json=scriptHash,proto3" json:"script_hash,omitempty"`
-	// Output amount
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Output salt
-	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
-	// Output asset ID
-	Asset_ID []byte `protobuf:"bytes,4,opt,name=asset_ID,json=assetID,proto3" json:"asset_ID,omitempty"`
-	// Output state
-	State []byte `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *PrivateOutput) Reset() {
-	*x = PrivateOutput{}
+func (x *GetTreasuryWhitelistRequest) Reset() {
+	*x = GetTreasuryWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[144]
+		mi := &file_ilxrpc_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrivateOutput) String() string {
+func (x *GetTreasuryWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrivateOutput) ProtoMessage() {}
+func (*GetTreasuryWhitelistRequest) ProtoMessage() {}
 
-func (x *PrivateOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[144]
+func (x *GetTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7768,81 +8510,87 @@ func (x *PrivateOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrivateOutput.ProtoReflect.Descriptor instead.
-func (*PrivateOutput) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use GetTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*GetTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *PrivateOutput) GetScriptHash() []byte {
-	if x != nil {
-		return x.ScriptHash
-	}
-	return nil
+type GetTreasuryWhitelistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whitelisted txids
+	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
 }
 
-func (x *PrivateOutput) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+func (x *GetTreasuryWhitelistResponse) Reset() {
+	*x = GetTreasuryWhitelistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *PrivateOutput) GetSalt() []byte {
-	if x != nil {
-		return x.Salt
-	}
-	return nil
+func (x *GetTreasuryWhitelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PrivateOutput) GetAsset_ID() []byte {
-	if x != nil {
-		return x.Asset_ID
+func (*GetTreasuryWhitelistResponse) ProtoMessage() {}
+
+func (x *GetTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PrivateOutput) GetState() []byte {
+// Deprecated: Use GetTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*GetTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetTreasuryWhitelistResponse) GetTxids() [][]byte {
 	if x != nil {
-		return x.State
+		return x.Txids
 	}
 	return nil
 }
 
-type TxoProof struct {
+type UpdateTreasuryWhitelistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The commitment this inclusion proof is for
-	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
-	// The hashes that form the root preimage
-	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
-	// Flags indicate whether a hash in the hash list is left or right.
-	// 0 == left, 1 == right.
-	Flags uint64 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
-	// The index of this commitment in the tree
-	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
-	// The txoRoot this proof links to. This is found in the block header.
-	TxoRoot []byte `protobuf:"bytes,5,opt,name=txoRoot,proto3" json:"txoRoot,omitempty"`
+	// Txids to add to the whitelist
+	Add [][]byte `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	// Txids to remove from the whitelist
+	Remove [][]byte `protobuf:"bytes,2,rep,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func (x *TxoProof) Reset() {
-	*x = TxoProof{}
+func (x *UpdateTreasuryWhitelistRequest) Reset() {
+	*x = UpdateTreasuryWhitelistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[145]
+		mi := &file_ilxrpc_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxoProof) String() string {
+func (x *UpdateTreasuryWhitelistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxoProof) ProtoMessage() {}
+func (*UpdateTreasuryWhitelistRequest) ProtoMessage() {}
 
-func (x *TxoProof) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[145]
+func (x *UpdateTreasuryWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7853,76 +8601,93 @@ func (x *TxoProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxoProof.ProtoReflect.Descriptor instead.
-func (*TxoProof) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use UpdateTreasuryWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreasuryWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *TxoProof) GetCommitment() []byte {
+func (x *UpdateTreasuryWhitelistRequest) GetAdd() [][]byte {
 	if x != nil {
-		return x.Commitment
+		return x.Add
 	}
 	return nil
 }
 
-func (x *TxoProof) GetHashes() [][]byte {
+func (x *UpdateTreasuryWhitelistRequest) GetRemove() [][]byte {
 	if x != nil {
-		return x.Hashes
+		return x.Remove
 	}
 	return nil
 }
 
-func (x *TxoProof) GetFlags() uint64 {
-	if x != nil {
-		return x.Flags
+type UpdateTreasuryWhitelistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateTreasuryWhitelistResponse) Reset() {
+	*x = UpdateTreasuryWhitelistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TxoProof) GetIndex() uint64 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
+func (x *UpdateTreasuryWhitelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TxoProof) GetTxoRoot() []byte {
-	if x != nil {
-		return x.TxoRoot
+func (*UpdateTreasuryWhitelistResponse) ProtoMessage() {}
+
+func (x *UpdateTreasuryWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Peer struct {
+// Deprecated: Use UpdateTreasuryWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTreasuryWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{160}
+}
+
+type ReconsiderBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Peer ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The peer's user agent string
-	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// Multiaddrs
-	Addrs []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// Block ID to reconsider.
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// We likely don't have the block and will have to download it from
+	// another peer. You can set the peer here. If empty we will try to find
+	// it form a few random peers.
+	DownloadPeer string `protobuf:"bytes,2,opt,name=download_peer,json=downloadPeer,proto3" json:"download_peer,omitempty"`
 }
 
-func (x *Peer) Reset() {
-	*x = Peer{}
+func (x *ReconsiderBlockRequest) Reset() {
+	*x = ReconsiderBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[146]
+		mi := &file_ilxrpc_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Peer) String() string {
+func (x *ReconsiderBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peer) ProtoMessage() {}
+func (*ReconsiderBlockRequest) ProtoMessage() {}
 
-func (x *Peer) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[146]
+func (x *ReconsiderBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7933,68 +8698,48 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
-func (*Peer) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use ReconsiderBlockRequest.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *Peer) GetId() string {
+func (x *ReconsiderBlockRequest) GetBlock_ID() []byte {
 	if x != nil {
-		return x.Id
+		return x.Block_ID
 	}
-	return ""
+	return nil
 }
 
-func (x *Peer) GetUserAgent() string {
+func (x *ReconsiderBlockRequest) GetDownloadPeer() string {
 	if x != nil {
-		return x.UserAgent
+		return x.DownloadPeer
 	}
 	return ""
 }
 
-func (x *Peer) GetAddrs() []string {
-	if x != nil {
-		return x.Addrs
-	}
-	return nil
-}
-
-type WalletTransaction struct {
+type ReconsiderBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Transaction ID
-	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
-	// The net number of coins coming into the wallet
-	// Positive = receive
-	// Negative = send
-	NetCoins int64 `protobuf:"varint,2,opt,name=netCoins,proto3" json:"netCoins,omitempty"`
-	// The address and amount of each input if known
-	// to the wallet.
-	Inputs []*WalletTransaction_IO `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	// The address and amount of each output if known
-	// to the wallet.
-	Outputs []*WalletTransaction_IO `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
 }
 
-func (x *WalletTransaction) Reset() {
-	*x = WalletTransaction{}
+func (x *ReconsiderBlockResponse) Reset() {
+	*x = ReconsiderBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[147]
+		mi := &file_ilxrpc_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransaction) String() string {
+func (x *ReconsiderBlockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransaction) ProtoMessage() {}
+func (*ReconsiderBlockResponse) ProtoMessage() {}
 
-func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[147]
+func (x *ReconsiderBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8005,68 +8750,72 @@ func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
-func (*WalletTransaction) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use ReconsiderBlockResponse.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *WalletTransaction) GetTransaction_ID() []byte {
-	if x != nil {
-		return x.Transaction_ID
-	}
-	return nil
+type RecomputeChainStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *WalletTransaction) GetNetCoins() int64 {
-	if x != nil {
-		return x.NetCoins
+func (x *RecomputeChainStateRequest) Reset() {
+	*x = RecomputeChainStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WalletTransaction) GetInputs() []*WalletTransaction_IO {
-	if x != nil {
-		return x.Inputs
-	}
-	return nil
+func (x *RecomputeChainStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WalletTransaction) GetOutputs() []*WalletTransaction_IO {
-	if x != nil {
-		return x.Outputs
+func (*RecomputeChainStateRequest) ProtoMessage() {}
+
+func (x *RecomputeChainStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateRawTransactionRequest_Input struct {
+// Deprecated: Use RecomputeChainStateRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeChainStateRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{163}
+}
+
+type RecomputeChainStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to CommitmentOrPrivateInput:
-	//
-	//	*CreateRawTransactionRequest_Input_Commitment
-	//	*CreateRawTransactionRequest_Input_Input
-	CommitmentOrPrivateInput isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput `protobuf_oneof:"commitment_or_private_input"`
 }
 
-func (x *CreateRawTransactionRequest_Input) Reset() {
-	*x = CreateRawTransactionRequest_Input{}
+func (x *RecomputeChainStateResponse) Reset() {
+	*x = RecomputeChainStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[148]
+		mi := &file_ilxrpc_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRawTransactionRequest_Input) String() string {
+func (x *RecomputeChainStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRawTransactionRequest_Input) ProtoMessage() {}
+func (*RecomputeChainStateResponse) ProtoMessage() {}
 
-func (x *CreateRawTransactionRequest_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[148]
+func (x *RecomputeChainStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8077,84 +8826,75 @@ func (x *CreateRawTransactionRequest_Input) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRawTransactionRequest_Input.ProtoReflect.Descriptor instead.
-func (*CreateRawTransactionRequest_Input) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81, 0}
-}
-
-func (m *CreateRawTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput {
-	if m != nil {
-		return m.CommitmentOrPrivateInput
-	}
-	return nil
+// Deprecated: Use RecomputeChainStateResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeChainStateResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *CreateRawTransactionRequest_Input) GetCommitment() []byte {
-	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawTransactionRequest_Input_Commitment); ok {
-		return x.Commitment
-	}
-	return nil
+type SaveMempoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateRawTransactionRequest_Input) GetInput() *PrivateInput {
-	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawTransactionRequest_Input_Input); ok {
-		return x.Input
+func (x *SaveMempoolRequest) Reset() {
+	*x = SaveMempoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
-}
-
-type isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput interface {
-	isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput()
 }
 
-type CreateRawTransactionRequest_Input_Commitment struct {
-	// This is a utxo commitment known to the wallet
-	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3,oneof"`
+func (x *SaveMempoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CreateRawTransactionRequest_Input_Input struct {
-	// If trying to create a transaction spending a utxo that the
-	// wallet does not know about you must provide the full private
-	// input data.
-	Input *PrivateInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
-}
+func (*SaveMempoolRequest) ProtoMessage() {}
 
-func (*CreateRawTransactionRequest_Input_Commitment) isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput() {
+func (x *SaveMempoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*CreateRawTransactionRequest_Input_Input) isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput() {
+// Deprecated: Use SaveMempoolRequest.ProtoReflect.Descriptor instead.
+func (*SaveMempoolRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{165}
 }
 
-type CreateRawTransactionRequest_Output struct {
+type SaveMempoolResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address to send coins to
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// The amount to send
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// An optional state field
-	State []byte `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// The number of transactions saved
+	NumTransactions uint32 `protobuf:"varint,1,opt,name=num_transactions,json=numTransactions,proto3" json:"num_transactions,omitempty"`
 }
 
-func (x *CreateRawTransactionRequest_Output) Reset() {
-	*x = CreateRawTransactionRequest_Output{}
+func (x *SaveMempoolResponse) Reset() {
+	*x = SaveMempoolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[149]
+		mi := &file_ilxrpc_proto_msgTypes[166]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRawTransactionRequest_Output) String() string {
+func (x *SaveMempoolResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRawTransactionRequest_Output) ProtoMessage() {}
+func (*SaveMempoolResponse) ProtoMessage() {}
 
-func (x *CreateRawTransactionRequest_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[149]
+func (x *SaveMempoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[166]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8165,61 +8905,41 @@ func (x *CreateRawTransactionRequest_Output) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRawTransactionRequest_Output.ProtoReflect.Descriptor instead.
-func (*CreateRawTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{81, 1}
-}
-
-func (x *CreateRawTransactionRequest_Output) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use SaveMempoolResponse.ProtoReflect.Descriptor instead.
+func (*SaveMempoolResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *CreateRawTransactionRequest_Output) GetAmount() uint64 {
+func (x *SaveMempoolResponse) GetNumTransactions() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.NumTransactions
 	}
 	return 0
 }
 
-func (x *CreateRawTransactionRequest_Output) GetState() []byte {
-	if x != nil {
-		return x.State
-	}
-	return nil
-}
-
-type CreateRawStakeTransactionRequest_Input struct {
+type LoadMempoolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// Types that are assignable to CommitmentOrPrivateInput:
-	//
-	//	*CreateRawStakeTransactionRequest_Input_Commitment
-	//	*CreateRawStakeTransactionRequest_Input_Input
-	CommitmentOrPrivateInput isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput `protobuf_oneof:"commitment_or_private_input"`
-}
-
-func (x *CreateRawStakeTransactionRequest_Input) Reset() {
-	*x = CreateRawStakeTransactionRequest_Input{}
+func (x *LoadMempoolRequest) Reset() {
+	*x = LoadMempoolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[150]
+		mi := &file_ilxrpc_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRawStakeTransactionRequest_Input) String() string {
+func (x *LoadMempoolRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRawStakeTransactionRequest_Input) ProtoMessage() {}
+func (*LoadMempoolRequest) ProtoMessage() {}
 
-func (x *CreateRawStakeTransactionRequest_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[150]
+func (x *LoadMempoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8230,90 +8950,87 @@ func (x *CreateRawStakeTransactionRequest_Input) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRawStakeTransactionRequest_Input.ProtoReflect.Descriptor instead.
-func (*CreateRawStakeTransactionRequest_Input) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{83, 0}
+// Deprecated: Use LoadMempoolRequest.ProtoReflect.Descriptor instead.
+func (*LoadMempoolRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{167}
 }
 
-func (m *CreateRawStakeTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput {
-	if m != nil {
-		return m.CommitmentOrPrivateInput
-	}
-	return nil
-}
+type LoadMempoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *CreateRawStakeTransactionRequest_Input) GetCommitment() []byte {
-	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawStakeTransactionRequest_Input_Commitment); ok {
-		return x.Commitment
-	}
-	return nil
+	// The number of saved transactions accepted into the mempool
+	NumTransactions uint32 `protobuf:"varint,1,opt,name=num_transactions,json=numTransactions,proto3" json:"num_transactions,omitempty"`
 }
 
-func (x *CreateRawStakeTransactionRequest_Input) GetInput() *PrivateInput {
-	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawStakeTransactionRequest_Input_Input); ok {
-		return x.Input
+func (x *LoadMempoolResponse) Reset() {
+	*x = LoadMempoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput interface {
-	isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput()
+func (x *LoadMempoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CreateRawStakeTransactionRequest_Input_Commitment struct {
-	// This is a utxo commitment known to the wallet
-	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3,oneof"`
-}
+func (*LoadMempoolResponse) ProtoMessage() {}
 
-type CreateRawStakeTransactionRequest_Input_Input struct {
-	// If trying to create a transaction spending a utxo that the
-	// wallet does not know about you must provide the full private
-	// input data.
-	Input *PrivateInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+func (x *LoadMempoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*CreateRawStakeTransactionRequest_Input_Commitment) isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput() {
+// Deprecated: Use LoadMempoolResponse.ProtoReflect.Descriptor instead.
+func (*LoadMempoolResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{168}
 }
 
-func (*CreateRawStakeTransactionRequest_Input_Input) isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput() {
+func (x *LoadMempoolResponse) GetNumTransactions() uint32 {
+	if x != nil {
+		return x.NumTransactions
+	}
+	return 0
 }
 
-type Validator_Stake struct {
+type PrioritiseTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The nullifier that is staked
-	Nullifier []byte `protobuf:"bytes,1,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
-	// The amount staked
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// The timestamp that this utxo is timelocked until
-	TimelockedUntil int64 `protobuf:"varint,3,opt,name=timelocked_until,json=timelockedUntil,proto3" json:"timelocked_until,omitempty"`
-	// When this nullifier will expire and be removed from
-	// the validator set.
-	Expiration int64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// The earliest date at which this nullifier can be restaked.
-	// One week before expiration.
-	RestakeEligibility int64 `protobuf:"varint,5,opt,name=restake_eligibility,json=restakeEligibility,proto3" json:"restake_eligibility,omitempty"`
+	// The transaction to prioritise. It does not need to be in the mempool.
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The amount to add to the transaction's fee. May be negative.
+	FeeDelta int64 `protobuf:"varint,2,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
 }
 
-func (x *Validator_Stake) Reset() {
-	*x = Validator_Stake{}
+func (x *PrioritiseTransactionRequest) Reset() {
+	*x = PrioritiseTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[151]
+		mi := &file_ilxrpc_proto_msgTypes[169]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Validator_Stake) String() string {
+func (x *PrioritiseTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Validator_Stake) ProtoMessage() {}
+func (*PrioritiseTransactionRequest) ProtoMessage() {}
 
-func (x *Validator_Stake) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[151]
+func (x *PrioritiseTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[169]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8324,79 +9041,51 @@ func (x *Validator_Stake) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Validator_Stake.ProtoReflect.Descriptor instead.
-func (*Validator_Stake) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{140, 0}
+// Deprecated: Use PrioritiseTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PrioritiseTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *Validator_Stake) GetNullifier() []byte {
+func (x *PrioritiseTransactionRequest) GetTransaction_ID() []byte {
 	if x != nil {
-		return x.Nullifier
+		return x.Transaction_ID
 	}
 	return nil
 }
 
-func (x *Validator_Stake) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Validator_Stake) GetTimelockedUntil() int64 {
-	if x != nil {
-		return x.TimelockedUntil
-	}
-	return 0
-}
-
-func (x *Validator_Stake) GetExpiration() int64 {
-	if x != nil {
-		return x.Expiration
-	}
-	return 0
-}
-
-func (x *Validator_Stake) GetRestakeEligibility() int64 {
+func (x *PrioritiseTransactionRequest) GetFeeDelta() int64 {
 	if x != nil {
-		return x.RestakeEligibility
+		return x.FeeDelta
 	}
 	return 0
 }
 
-type WalletTransaction_IO struct {
+type PrioritiseTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Either address/amount information or unknown
-	// if input or output did not belong to this wallet
-	// and can't be decrypted.
-	//
-	// Types that are assignable to IoType:
-	//
-	//	*WalletTransaction_IO_TxIo
-	//	*WalletTransaction_IO_Unknown_
-	IoType isWalletTransaction_IO_IoType `protobuf_oneof:"io_type"`
+	// The transaction's total fee delta after this request
+	FeeDelta int64 `protobuf:"varint,1,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
 }
 
-func (x *WalletTransaction_IO) Reset() {
-	*x = WalletTransaction_IO{}
+func (x *PrioritiseTransactionResponse) Reset() {
+	*x = PrioritiseTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[152]
+		mi := &file_ilxrpc_proto_msgTypes[170]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransaction_IO) String() string {
+func (x *PrioritiseTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransaction_IO) ProtoMessage() {}
+func (*PrioritiseTransactionResponse) ProtoMessage() {}
 
-func (x *WalletTransaction_IO) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[152]
+func (x *PrioritiseTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[170]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8407,76 +9096,95 @@ func (x *WalletTransaction_IO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransaction_IO.ProtoReflect.Descriptor instead.
-func (*WalletTransaction_IO) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0}
+// Deprecated: Use PrioritiseTransactionResponse.ProtoReflect.Descriptor instead.
+func (*PrioritiseTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{170}
 }
 
-func (m *WalletTransaction_IO) GetIoType() isWalletTransaction_IO_IoType {
-	if m != nil {
-		return m.IoType
+func (x *PrioritiseTransactionResponse) GetFeeDelta() int64 {
+	if x != nil {
+		return x.FeeDelta
 	}
-	return nil
+	return 0
 }
 
-func (x *WalletTransaction_IO) GetTxIo() *WalletTransaction_IO_TxIO {
-	if x, ok := x.GetIoType().(*WalletTransaction_IO_TxIo); ok {
-		return x.TxIo
-	}
-	return nil
+// NOTIFICATIONS
+type TransactionNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction in this notification has finalized and
+	// been added to the blockchain.
+	Transaction *transactions.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *WalletTransaction_IO) GetUnknown() *WalletTransaction_IO_Unknown {
-	if x, ok := x.GetIoType().(*WalletTransaction_IO_Unknown_); ok {
-		return x.Unknown
+func (x *TransactionNotification) Reset() {
+	*x = TransactionNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isWalletTransaction_IO_IoType interface {
-	isWalletTransaction_IO_IoType()
+func (x *TransactionNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type WalletTransaction_IO_TxIo struct {
-	TxIo *WalletTransaction_IO_TxIO `protobuf:"bytes,1,opt,name=tx_io,json=txIo,proto3,oneof"`
-}
+func (*TransactionNotification) ProtoMessage() {}
 
-type WalletTransaction_IO_Unknown_ struct {
-	Unknown *WalletTransaction_IO_Unknown `protobuf:"bytes,2,opt,name=unknown,proto3,oneof"`
+func (x *TransactionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*WalletTransaction_IO_TxIo) isWalletTransaction_IO_IoType() {}
+// Deprecated: Use TransactionNotification.ProtoReflect.Descriptor instead.
+func (*TransactionNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{171}
+}
 
-func (*WalletTransaction_IO_Unknown_) isWalletTransaction_IO_IoType() {}
+func (x *TransactionNotification) GetTransaction() *transactions.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
 
-type WalletTransaction_IO_TxIO struct {
+type WalletTransactionNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address associated with the input or output
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Amount of coins associated with the input or output
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The transaction in this notification has finalized and
+	// been added to the blockchain.
+	Transaction *WalletTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *WalletTransaction_IO_TxIO) Reset() {
-	*x = WalletTransaction_IO_TxIO{}
+func (x *WalletTransactionNotification) Reset() {
+	*x = WalletTransactionNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[153]
+		mi := &file_ilxrpc_proto_msgTypes[172]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransaction_IO_TxIO) String() string {
+func (x *WalletTransactionNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransaction_IO_TxIO) ProtoMessage() {}
+func (*WalletTransactionNotification) ProtoMessage() {}
 
-func (x *WalletTransaction_IO_TxIO) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[153]
+func (x *WalletTransactionNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[172]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8487,50 +9195,46 @@ func (x *WalletTransaction_IO_TxIO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransaction_IO_TxIO.ProtoReflect.Descriptor instead.
-func (*WalletTransaction_IO_TxIO) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0, 0}
-}
-
-func (x *WalletTransaction_IO_TxIO) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use WalletTransactionNotification.ProtoReflect.Descriptor instead.
+func (*WalletTransactionNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *WalletTransaction_IO_TxIO) GetAmount() uint64 {
+func (x *WalletTransactionNotification) GetTransaction() *WalletTransaction {
 	if x != nil {
-		return x.Amount
+		return x.Transaction
 	}
-	return 0
+	return nil
 }
 
-// Represents an input or output not belonging to
-// the wallet.
-type WalletTransaction_IO_Unknown struct {
+type WalletSyncNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The current height the wallet is synced up to
+	CurrentHeight uint32 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// The height of the chain that the wallet is syncing to
+	BestHeight uint32 `protobuf:"varint,2,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 }
 
-func (x *WalletTransaction_IO_Unknown) Reset() {
-	*x = WalletTransaction_IO_Unknown{}
+func (x *WalletSyncNotification) Reset() {
+	*x = WalletSyncNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ilxrpc_proto_msgTypes[154]
+		mi := &file_ilxrpc_proto_msgTypes[173]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransaction_IO_Unknown) String() string {
+func (x *WalletSyncNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransaction_IO_Unknown) ProtoMessage() {}
+func (*WalletSyncNotification) ProtoMessage() {}
 
-func (x *WalletTransaction_IO_Unknown) ProtoReflect() protoreflect.Message {
-	mi := &file_ilxrpc_proto_msgTypes[154]
+func (x *WalletSyncNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[173]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8541,1494 +9245,4894 @@ func (x *WalletTransaction_IO_Unknown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransaction_IO_Unknown.ProtoReflect.Descriptor instead.
-func (*WalletTransaction_IO_Unknown) Descriptor() ([]byte, []int) {
-	return file_ilxrpc_proto_rawDescGZIP(), []int{147, 0, 1}
+// Deprecated: Use WalletSyncNotification.ProtoReflect.Descriptor instead.
+func (*WalletSyncNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{173}
 }
 
-var File_ilxrpc_proto protoreflect.FileDescriptor
+func (x *WalletSyncNotification) GetCurrentHeight() uint32 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
 
-var file_ilxrpc_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x69, 0x6c, 0x78, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
-	0x70, 0x62, 0x1a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x22, 0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x62, 0x65,
-	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x22, 0x3e, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x0b, 0x0a, 0x07, 0x4d,
-	0x41, 0x49, 0x4e, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x47, 0x54,
-	0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x53, 0x54, 0x4e, 0x45, 0x54,
-	0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x4e, 0x45, 0x54, 0x10, 0x03,
-	0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x39,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
-	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f,
-	0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x44, 0x0a, 0x1a, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x36, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b,
-	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22,
-	0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8a, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
-	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x72, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0x0a, 0x13, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x7d, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x19,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0x64, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75,
-	0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x66, 0x75, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c,
-	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x12, 0x38, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69,
-	0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3b, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb3,
-	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62,
-	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53,
-	0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x1b,
-	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x74, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54,
-	0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22,
-	0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x63, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6e,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x53, 0x65, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x38, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65, 0x77,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61,
-	0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78,
-	0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78,
-	0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xc2, 0x01, 0x0a,
-	0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76,
-	0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46,
-	0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x56, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
-	0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x22, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79,
-	0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x21, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65,
-	0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x22, 0x77, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69,
-	0x65, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x76, 0x69, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x1d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1a, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67,
-	0x68, 0x61, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x55, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61,
-	0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
-	0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x15, 0x50, 0x72, 0x6f,
-	0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x13, 0x0a,
-	0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x75, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xc0, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x73, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
-	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x1a,
-	0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x50, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78,
-	0x22, 0xd8, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x4e, 0x0a, 0x21, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x47, 0x0a, 0x1a, 0x50,
-	0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77,
-	0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72,
-	0x61, 0x77, 0x54, 0x78, 0x22, 0x48, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x30,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3a, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x1d, 0x0a,
-	0x1b, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a,
-	0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x53,
-	0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e,
-	0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b,
-	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x54, 0x69,
-	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x53,
-	0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f,
-	0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50,
-	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x53, 0x77, 0x65, 0x65, 0x70,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x27, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9a, 0x01, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64,
-	0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x78, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22,
-	0x29, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a,
-	0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22, 0x15,
-	0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x22, 0x4a, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41,
-	0x43, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52,
-	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x05, 0x22, 0x15, 0x0a, 0x13,
-	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50,
-	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69,
-	0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x1b,
-	0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f,
-	0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66,
-	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c,
-	0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x12,
-	0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13,
-	0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
-	0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x64,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0d,
-	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60,
-	0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x22, 0x7a, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x45, 0x0a, 0x1b,
-	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x7c, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
-	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x30, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64, 0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78,
-	0x73, 0x22, 0x8b, 0x02, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x49, 0x44,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x78,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x54, 0x78, 0x73, 0x22,
-	0xa5, 0x03, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
-	0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74,
-	0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x45, 0x6c, 0x69, 0x67,
-	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb3, 0x01, 0x0a, 0x04, 0x55, 0x74, 0x78, 0x6f,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x6c, 0x22, 0x85, 0x01,
-	0x0a, 0x0e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x28,
-	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x78, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x6f, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d,
-	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
-	0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x6f,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6f,
-	0x52, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x6f, 0x52,
-	0x6f, 0x6f, 0x74, 0x22, 0x4b, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64,
-	0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
-	0x22, 0x87, 0x03, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x6e, 0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x70,
-	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x49, 0x4f, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
-	0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a,
-	0xc8, 0x01, 0x0a, 0x02, 0x49, 0x4f, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e,
-	0x54, 0x78, 0x49, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6f, 0x12, 0x3c, 0x0a, 0x07,
-	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48,
-	0x00, 0x52, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x1a, 0x38, 0x0a, 0x04, 0x54, 0x78,
-	0x49, 0x4f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42,
-	0x09, 0x0a, 0x07, 0x69, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0xd1, 0x0a, 0x0a, 0x11, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
-	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72,
-	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f,
-	0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62,
-	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
-	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xe1,
-	0x02, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
-	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x32, 0xdc, 0x13, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x53, 0x65, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
-	0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79,
-	0x70, 0x61, 0x69, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79,
-	0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53,
-	0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61,
-	0x69, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77,
-	0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
-	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
-	0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12,
-	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62,
-	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55,
-	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x53, 0x65,
-	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x21,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
-	0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6e,
-	0x64, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65,
-	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x40, 0x0a, 0x0b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
-	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65,
-	0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x6f, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e,
-	0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30,
-	0x01, 0x32, 0xaf, 0x0a, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12,
-	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x50, 0x65, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
-	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12,
-	0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50,
-	0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
-	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a,
-	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x69, 0x6c, 0x6c, 0x69, 0x75, 0x6d,
-	0x2f, 0x69, 0x6c, 0x78, 0x64, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+func (x *WalletSyncNotification) GetBestHeight() uint32 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
 }
 
-var (
-	file_ilxrpc_proto_rawDescOnce sync.Once
-	file_ilxrpc_proto_rawDescData = file_ilxrpc_proto_rawDesc
-)
+type BlockNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_ilxrpc_proto_rawDescGZIP() []byte {
-	file_ilxrpc_proto_rawDescOnce.Do(func() {
-		file_ilxrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_ilxrpc_proto_rawDescData)
-	})
-	return file_ilxrpc_proto_rawDescData
+	// The BlockInfo (including header data) for the block
+	BlockInfo *BlockInfo `protobuf:"bytes,1,opt,name=block_info,json=blockInfo,proto3" json:"block_info,omitempty"`
+	// The blocks transactions (if requested).
+	//
+	// The transactions will either be returned in for or just the txids depending
+	// on the request.
+	Transactions []*TransactionData `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-var file_ilxrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ilxrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 155)
-var file_ilxrpc_proto_goTypes = []interface{}{
-	(GetBlockchainInfoResponse_Network)(0),          // 0: pb.GetBlockchainInfoResponse.Network
-	(SetLogLevelRequest_Level)(0),                   // 1: pb.SetLogLevelRequest.Level
-	(*GetMempoolInfoRequest)(nil),                   // 2: pb.GetMempoolInfoRequest
-	(*GetMempoolInfoResponse)(nil),                  // 3: pb.GetMempoolInfoResponse
-	(*GetMempoolRequest)(nil),                       // 4: pb.GetMempoolRequest
-	(*GetMempoolResponse)(nil),                      // 5: pb.GetMempoolResponse
-	(*GetBlockchainInfoRequest)(nil),                // 6: pb.GetBlockchainInfoRequest
-	(*GetBlockchainInfoResponse)(nil),               // 7: pb.GetBlockchainInfoResponse
-	(*GetBlockInfoRequest)(nil),                     // 8: pb.GetBlockInfoRequest
-	(*GetBlockInfoResponse)(nil),                    // 9: pb.GetBlockInfoResponse
-	(*GetBlockRequest)(nil),                         // 10: pb.GetBlockRequest
-	(*GetBlockResponse)(nil),                        // 11: pb.GetBlockResponse
-	(*GetCompressedBlockRequest)(nil),               // 12: pb.GetCompressedBlockRequest
-	(*GetCompressedBlockResponse)(nil),              // 13: pb.GetCompressedBlockResponse
-	(*GetHeadersRequest)(nil),                       // 14: pb.GetHeadersRequest
-	(*GetHeadersResponse)(nil),                      // 15: pb.GetHeadersResponse
-	(*GetCompressedBlocksRequest)(nil),              // 16: pb.GetCompressedBlocksRequest
-	(*GetCompressedBlocksResponse)(nil),             // 17: pb.GetCompressedBlocksResponse
-	(*GetTransactionRequest)(nil),                   // 18: pb.GetTransactionRequest
-	(*GetTransactionResponse)(nil),                  // 19: pb.GetTransactionResponse
-	(*GetMerkleProofRequest)(nil),                   // 20: pb.GetMerkleProofRequest
-	(*GetMerkleProofResponse)(nil),                  // 21: pb.GetMerkleProofResponse
-	(*GetValidatorRequest)(nil),                     // 22: pb.GetValidatorRequest
-	(*GetValidatorResponse)(nil),                    // 23: pb.GetValidatorResponse
-	(*GetValidatorSetInfoRequest)(nil),              // 24: pb.GetValidatorSetInfoRequest
-	(*GetValidatorSetInfoResponse)(nil),             // 25: pb.GetValidatorSetInfoResponse
-	(*GetValidatorSetRequest)(nil),                  // 26: pb.GetValidatorSetRequest
-	(*GetValidatorSetResponse)(nil),                 // 27: pb.GetValidatorSetResponse
-	(*GetAccumulatorCheckpointRequest)(nil),         // 28: pb.GetAccumulatorCheckpointRequest
-	(*GetAccumulatorCheckpointResponse)(nil),        // 29: pb.GetAccumulatorCheckpointResponse
-	(*SubmitTransactionRequest)(nil),                // 30: pb.SubmitTransactionRequest
-	(*SubmitTransactionResponse)(nil),               // 31: pb.SubmitTransactionResponse
-	(*SubscribeBlocksRequest)(nil),                  // 32: pb.SubscribeBlocksRequest
-	(*SubscribeCompressedBlocksRequest)(nil),        // 33: pb.SubscribeCompressedBlocksRequest
-	(*RegisterViewKeyRequest)(nil),                  // 34: pb.RegisterViewKeyRequest
-	(*RegisterViewKeyResponse)(nil),                 // 35: pb.RegisterViewKeyResponse
-	(*SubscribeTransactionsRequest)(nil),            // 36: pb.SubscribeTransactionsRequest
-	(*GetWalletTransactionsRequest)(nil),            // 37: pb.GetWalletTransactionsRequest
-	(*GetWalletTransactionsResponse)(nil),           // 38: pb.GetWalletTransactionsResponse
-	(*GetTxoProofRequest)(nil),                      // 39: pb.GetTxoProofRequest
-	(*GetTxoProofResponse)(nil),                     // 40: pb.GetTxoProofResponse
-	(*GetBalanceRequest)(nil),                       // 41: pb.GetBalanceRequest
-	(*GetBalanceResponse)(nil),                      // 42: pb.GetBalanceResponse
-	(*GetWalletSeedRequest)(nil),                    // 43: pb.GetWalletSeedRequest
-	(*GetWalletSeedResponse)(nil),                   // 44: pb.GetWalletSeedResponse
-	(*GetAddressRequest)(nil),                       // 45: pb.GetAddressRequest
-	(*GetAddressResponse)(nil),                      // 46: pb.GetAddressResponse
-	(*GetTimelockedAddressRequest)(nil),             // 47: pb.GetTimelockedAddressRequest
-	(*GetTimelockedAddressResponse)(nil),            // 48: pb.GetTimelockedAddressResponse
-	(*GetAddressesRequest)(nil),                     // 49: pb.GetAddressesRequest
-	(*GetAddressesResponse)(nil),                    // 50: pb.GetAddressesResponse
-	(*GetAddressInfoRequest)(nil),                   // 51: pb.GetAddressInfoRequest
-	(*GetAddressInfoResponse)(nil),                  // 52: pb.GetAddressInfoResponse
-	(*GetNewAddressRequest)(nil),                    // 53: pb.GetNewAddressRequest
-	(*GetNewAddressResponse)(nil),                   // 54: pb.GetNewAddressResponse
-	(*GetTransactionsRequest)(nil),                  // 55: pb.GetTransactionsRequest
-	(*GetTransactionsResponse)(nil),                 // 56: pb.GetTransactionsResponse
-	(*GetUtxosRequest)(nil),                         // 57: pb.GetUtxosRequest
-	(*GetUtxosResponse)(nil),                        // 58: pb.GetUtxosResponse
-	(*GetPrivateKeyRequest)(nil),                    // 59: pb.GetPrivateKeyRequest
-	(*GetPrivateKeyResponse)(nil),                   // 60: pb.GetPrivateKeyResponse
-	(*ImportAddressRequest)(nil),                    // 61: pb.ImportAddressRequest
-	(*ImportAddressResponse)(nil),                   // 62: pb.ImportAddressResponse
-	(*CreateMultisigSpendKeypairRequest)(nil),       // 63: pb.CreateMultisigSpendKeypairRequest
-	(*CreateMultisigSpendKeypairResponse)(nil),      // 64: pb.CreateMultisigSpendKeypairResponse
-	(*CreateMultisigViewKeypairRequest)(nil),        // 65: pb.CreateMultisigViewKeypairRequest
-	(*CreateMultisigViewKeypairResponse)(nil),       // 66: pb.CreateMultisigViewKeypairResponse
-	(*CreateMultisigAddressRequest)(nil),            // 67: pb.CreateMultisigAddressRequest
-	(*CreateMultisigAddressResponse)(nil),           // 68: pb.CreateMultisigAddressResponse
-	(*CreateMultiSignatureRequest)(nil),             // 69: pb.CreateMultiSignatureRequest
-	(*CreateMultiSignatureResponse)(nil),            // 70: pb.CreateMultiSignatureResponse
-	(*ProveMultisigRequest)(nil),                    // 71: pb.ProveMultisigRequest
-	(*ProveMultisigResponse)(nil),                   // 72: pb.ProveMultisigResponse
-	(*WalletLockRequest)(nil),                       // 73: pb.WalletLockRequest
-	(*WalletLockResponse)(nil),                      // 74: pb.WalletLockResponse
-	(*WalletUnlockRequest)(nil),                     // 75: pb.WalletUnlockRequest
-	(*WalletUnlockResponse)(nil),                    // 76: pb.WalletUnlockResponse
-	(*SetWalletPassphraseRequest)(nil),              // 77: pb.SetWalletPassphraseRequest
-	(*SetWalletPassphraseResponse)(nil),             // 78: pb.SetWalletPassphraseResponse
-	(*ChangeWalletPassphraseRequest)(nil),           // 79: pb.ChangeWalletPassphraseRequest
-	(*ChangeWalletPassphraseResponse)(nil),          // 80: pb.ChangeWalletPassphraseResponse
-	(*DeletePrivateKeysRequest)(nil),                // 81: pb.DeletePrivateKeysRequest
-	(*DeletePrivateKeysResponse)(nil),               // 82: pb.DeletePrivateKeysResponse
-	(*CreateRawTransactionRequest)(nil),             // 83: pb.CreateRawTransactionRequest
-	(*CreateRawTransactionResponse)(nil),            // 84: pb.CreateRawTransactionResponse
-	(*CreateRawStakeTransactionRequest)(nil),        // 85: pb.CreateRawStakeTransactionRequest
-	(*CreateRawStakeTransactionResponse)(nil),       // 86: pb.CreateRawStakeTransactionResponse
-	(*ProveRawTransactionRequest)(nil),              // 87: pb.ProveRawTransactionRequest
-	(*ProveRawTransactionResponse)(nil),             // 88: pb.ProveRawTransactionResponse
-	(*StakeRequest)(nil),                            // 89: pb.StakeRequest
-	(*StakeResponse)(nil),                           // 90: pb.StakeResponse
-	(*SetAutoStakeRewardsRequest)(nil),              // 91: pb.SetAutoStakeRewardsRequest
-	(*SetAutoStakeRewardsResponse)(nil),             // 92: pb.SetAutoStakeRewardsResponse
-	(*SpendRequest)(nil),                            // 93: pb.SpendRequest
-	(*SpendResponse)(nil),                           // 94: pb.SpendResponse
-	(*TimelockCoinsRequest)(nil),                    // 95: pb.TimelockCoinsRequest
-	(*TimelockCoinsResponse)(nil),                   // 96: pb.TimelockCoinsResponse
-	(*SweepWalletRequest)(nil),                      // 97: pb.SweepWalletRequest
-	(*SweepWalletResponse)(nil),                     // 98: pb.SweepWalletResponse
-	(*SubscribeWalletTransactionsRequest)(nil),      // 99: pb.SubscribeWalletTransactionsRequest
-	(*SubscribeWalletSyncNotificationsRequest)(nil), // 100: pb.SubscribeWalletSyncNotificationsRequest
-	(*GetHostInfoRequest)(nil),                      // 101: pb.GetHostInfoRequest
-	(*GetHostInfoResponse)(nil),                     // 102: pb.GetHostInfoResponse
-	(*GetNetworkKeyRequest)(nil),                    // 103: pb.GetNetworkKeyRequest
-	(*GetNetworkKeyResponse)(nil),                   // 104: pb.GetNetworkKeyResponse
-	(*GetPeersRequest)(nil),                         // 105: pb.GetPeersRequest
-	(*GetPeersResponse)(nil),                        // 106: pb.GetPeersResponse
-	(*AddPeerRequest)(nil),                          // 107: pb.AddPeerRequest
-	(*AddPeerResponse)(nil),                         // 108: pb.AddPeerResponse
-	(*BlockPeerRequest)(nil),                        // 109: pb.BlockPeerRequest
-	(*BlockPeerResponse)(nil),                       // 110: pb.BlockPeerResponse
-	(*UnblockPeerRequest)(nil),                      // 111: pb.UnblockPeerRequest
-	(*UnblockPeerResponse)(nil),                     // 112: pb.UnblockPeerResponse
-	(*SetLogLevelRequest)(nil),                      // 113: pb.SetLogLevelRequest
-	(*SetLogLevelResponse)(nil),                     // 114: pb.SetLogLevelResponse
-	(*GetMinFeePerKilobyteRequest)(nil),             // 115: pb.GetMinFeePerKilobyteRequest
-	(*GetMinFeePerKilobyteResponse)(nil),            // 116: pb.GetMinFeePerKilobyteResponse
-	(*SetMinFeePerKilobyteRequest)(nil),             // 117: pb.SetMinFeePerKilobyteRequest
-	(*SetMinFeePerKilobyteResponse)(nil),            // 118: pb.SetMinFeePerKilobyteResponse
-	(*GetMinStakeRequest)(nil),                      // 119: pb.GetMinStakeRequest
-	(*GetMinStakeResponse)(nil),                     // 120: pb.GetMinStakeResponse
-	(*SetMinStakeRequest)(nil),                      // 121: pb.SetMinStakeRequest
-	(*SetMinStakeResponse)(nil),                     // 122: pb.SetMinStakeResponse
-	(*GetBlockSizeSoftLimitRequest)(nil),            // 123: pb.GetBlockSizeSoftLimitRequest
-	(*GetBlockSizeSoftLimitResponse)(nil),           // 124: pb.GetBlockSizeSoftLimitResponse
-	(*SetBlockSizeSoftLimitRequest)(nil),            // 125: pb.SetBlockSizeSoftLimitRequest
-	(*SetBlockSizeSoftLimitResponse)(nil),           // 126: pb.SetBlockSizeSoftLimitResponse
-	(*GetTreasuryWhitelistRequest)(nil),             // 127: pb.GetTreasuryWhitelistRequest
-	(*GetTreasuryWhitelistResponse)(nil),            // 128: pb.GetTreasuryWhitelistResponse
-	(*UpdateTreasuryWhitelistRequest)(nil),          // 129: pb.UpdateTreasuryWhitelistRequest
-	(*UpdateTreasuryWhitelistResponse)(nil),         // 130: pb.UpdateTreasuryWhitelistResponse
-	(*ReconsiderBlockRequest)(nil),                  // 131: pb.ReconsiderBlockRequest
-	(*ReconsiderBlockResponse)(nil),                 // 132: pb.ReconsiderBlockResponse
-	(*RecomputeChainStateRequest)(nil),              // 133: pb.RecomputeChainStateRequest
-	(*RecomputeChainStateResponse)(nil),             // 134: pb.RecomputeChainStateResponse
-	(*TransactionNotification)(nil),                 // 135: pb.TransactionNotification
-	(*WalletTransactionNotification)(nil),           // 136: pb.WalletTransactionNotification
-	(*WalletSyncNotification)(nil),                  // 137: pb.WalletSyncNotification
-	(*BlockNotification)(nil),                       // 138: pb.BlockNotification
-	(*CompressedBlockNotification)(nil),             // 139: pb.CompressedBlockNotification
-	(*TransactionData)(nil),                         // 140: pb.TransactionData
-	(*BlockInfo)(nil),                               // 141: pb.BlockInfo
-	(*Validator)(nil),                               // 142: pb.Validator
-	(*Utxo)(nil),                                    // 143: pb.Utxo
-	(*RawTransaction)(nil),                          // 144: pb.RawTransaction
-	(*PrivateInput)(nil),                            // 145: pb.PrivateInput
-	(*PrivateOutput)(nil),                           // 146: pb.PrivateOutput
-	(*TxoProof)(nil),                                // 147: pb.TxoProof
-	(*Peer)(nil),                                    // 148: pb.Peer
-	(*WalletTransaction)(nil),                       // 149: pb.WalletTransaction
-	(*CreateRawTransactionRequest_Input)(nil),       // 150: pb.CreateRawTransactionRequest.Input
-	(*CreateRawTransactionRequest_Output)(nil),      // 151: pb.CreateRawTransactionRequest.Output
-	(*CreateRawStakeTransactionRequest_Input)(nil),  // 152: pb.CreateRawStakeTransactionRequest.Input
-	(*Validator_Stake)(nil),                         // 153: pb.Validator.Stake
-	(*WalletTransaction_IO)(nil),                    // 154: pb.WalletTransaction.IO
-	(*WalletTransaction_IO_TxIO)(nil),               // 155: pb.WalletTransaction.IO.TxIO
-	(*WalletTransaction_IO_Unknown)(nil),            // 156: pb.WalletTransaction.IO.Unknown
-	(*blocks.Block)(nil),                            // 157: Block
-	(*blocks.CompressedBlock)(nil),                  // 158: CompressedBlock
-	(*blocks.BlockHeader)(nil),                      // 159: BlockHeader
-	(*transactions.Transaction)(nil),                // 160: Transaction
+func (x *BlockNotification) Reset() {
+	*x = BlockNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_ilxrpc_proto_depIdxs = []int32{
-	140, // 0: pb.GetMempoolResponse.transaction_data:type_name -> pb.TransactionData
-	0,   // 1: pb.GetBlockchainInfoResponse.network:type_name -> pb.GetBlockchainInfoResponse.Network
-	141, // 2: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
-	157, // 3: pb.GetBlockResponse.block:type_name -> Block
-	158, // 4: pb.GetCompressedBlockResponse.block:type_name -> CompressedBlock
-	159, // 5: pb.GetHeadersResponse.headers:type_name -> BlockHeader
-	158, // 6: pb.GetCompressedBlocksResponse.blocks:type_name -> CompressedBlock
-	160, // 7: pb.GetTransactionResponse.tx:type_name -> Transaction
-	141, // 8: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
-	142, // 9: pb.GetValidatorResponse.validator:type_name -> pb.Validator
-	142, // 10: pb.GetValidatorSetResponse.validators:type_name -> pb.Validator
-	160, // 11: pb.SubmitTransactionRequest.transaction:type_name -> Transaction
-	160, // 12: pb.GetWalletTransactionsResponse.transactions:type_name -> Transaction
-	147, // 13: pb.GetTxoProofResponse.proofs:type_name -> pb.TxoProof
-	149, // 14: pb.GetTransactionsResponse.txs:type_name -> pb.WalletTransaction
-	143, // 15: pb.GetUtxosResponse.utxos:type_name -> pb.Utxo
-	160, // 16: pb.CreateMultiSignatureRequest.tx:type_name -> Transaction
-	144, // 17: pb.ProveMultisigRequest.raw_tx:type_name -> pb.RawTransaction
-	160, // 18: pb.ProveMultisigResponse.proved_tx:type_name -> Transaction
-	150, // 19: pb.CreateRawTransactionRequest.inputs:type_name -> pb.CreateRawTransactionRequest.Input
-	151, // 20: pb.CreateRawTransactionRequest.outputs:type_name -> pb.CreateRawTransactionRequest.Output
-	144, // 21: pb.CreateRawTransactionResponse.raw_tx:type_name -> pb.RawTransaction
-	152, // 22: pb.CreateRawStakeTransactionRequest.input:type_name -> pb.CreateRawStakeTransactionRequest.Input
-	144, // 23: pb.CreateRawStakeTransactionResponse.raw_tx:type_name -> pb.RawTransaction
-	144, // 24: pb.ProveRawTransactionRequest.raw_tx:type_name -> pb.RawTransaction
-	160, // 25: pb.ProveRawTransactionResponse.proved_tx:type_name -> Transaction
-	148, // 26: pb.GetPeersResponse.peers:type_name -> pb.Peer
-	1,   // 27: pb.SetLogLevelRequest.level:type_name -> pb.SetLogLevelRequest.Level
-	160, // 28: pb.TransactionNotification.transaction:type_name -> Transaction
-	149, // 29: pb.WalletTransactionNotification.transaction:type_name -> pb.WalletTransaction
-	141, // 30: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
-	140, // 31: pb.BlockNotification.transactions:type_name -> pb.TransactionData
-	158, // 32: pb.CompressedBlockNotification.block:type_name -> CompressedBlock
-	160, // 33: pb.TransactionData.transaction:type_name -> Transaction
-	153, // 34: pb.Validator.stake:type_name -> pb.Validator.Stake
-	160, // 35: pb.RawTransaction.tx:type_name -> Transaction
-	145, // 36: pb.RawTransaction.inputs:type_name -> pb.PrivateInput
-	146, // 37: pb.RawTransaction.outputs:type_name -> pb.PrivateOutput
-	147, // 38: pb.PrivateInput.txo_proof:type_name -> pb.TxoProof
-	154, // 39: pb.WalletTransaction.inputs:type_name -> pb.WalletTransaction.IO
-	154, // 40: pb.WalletTransaction.outputs:type_name -> pb.WalletTransaction.IO
-	145, // 41: pb.CreateRawTransactionRequest.Input.input:type_name -> pb.PrivateInput
-	145, // 42: pb.CreateRawStakeTransactionRequest.Input.input:type_name -> pb.PrivateInput
-	155, // 43: pb.WalletTransaction.IO.tx_io:type_name -> pb.WalletTransaction.IO.TxIO
-	156, // 44: pb.WalletTransaction.IO.unknown:type_name -> pb.WalletTransaction.IO.Unknown
-	2,   // 45: pb.BlockchainService.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
-	4,   // 46: pb.BlockchainService.GetMempool:input_type -> pb.GetMempoolRequest
-	6,   // 47: pb.BlockchainService.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
-	8,   // 48: pb.BlockchainService.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
-	10,  // 49: pb.BlockchainService.GetBlock:input_type -> pb.GetBlockRequest
-	12,  // 50: pb.BlockchainService.GetCompressedBlock:input_type -> pb.GetCompressedBlockRequest
-	14,  // 51: pb.BlockchainService.GetHeaders:input_type -> pb.GetHeadersRequest
-	16,  // 52: pb.BlockchainService.GetCompressedBlocks:input_type -> pb.GetCompressedBlocksRequest
-	18,  // 53: pb.BlockchainService.GetTransaction:input_type -> pb.GetTransactionRequest
-	20,  // 54: pb.BlockchainService.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
-	22,  // 55: pb.BlockchainService.GetValidator:input_type -> pb.GetValidatorRequest
-	24,  // 56: pb.BlockchainService.GetValidatorSetInfo:input_type -> pb.GetValidatorSetInfoRequest
-	26,  // 57: pb.BlockchainService.GetValidatorSet:input_type -> pb.GetValidatorSetRequest
-	28,  // 58: pb.BlockchainService.GetAccumulatorCheckpoint:input_type -> pb.GetAccumulatorCheckpointRequest
-	30,  // 59: pb.BlockchainService.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
-	32,  // 60: pb.BlockchainService.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
-	33,  // 61: pb.BlockchainService.SubscribeCompressedBlocks:input_type -> pb.SubscribeCompressedBlocksRequest
-	34,  // 62: pb.WalletServerService.RegisterViewKey:input_type -> pb.RegisterViewKeyRequest
-	36,  // 63: pb.WalletServerService.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
-	37,  // 64: pb.WalletServerService.GetWalletTransactions:input_type -> pb.GetWalletTransactionsRequest
-	39,  // 65: pb.WalletServerService.GetTxoProof:input_type -> pb.GetTxoProofRequest
-	41,  // 66: pb.WalletService.GetBalance:input_type -> pb.GetBalanceRequest
-	43,  // 67: pb.WalletService.GetWalletSeed:input_type -> pb.GetWalletSeedRequest
-	45,  // 68: pb.WalletService.GetAddress:input_type -> pb.GetAddressRequest
-	47,  // 69: pb.WalletService.GetTimelockedAddress:input_type -> pb.GetTimelockedAddressRequest
-	49,  // 70: pb.WalletService.GetAddresses:input_type -> pb.GetAddressesRequest
-	51,  // 71: pb.WalletService.GetAddressInfo:input_type -> pb.GetAddressInfoRequest
-	53,  // 72: pb.WalletService.GetNewAddress:input_type -> pb.GetNewAddressRequest
-	55,  // 73: pb.WalletService.GetTransactions:input_type -> pb.GetTransactionsRequest
-	57,  // 74: pb.WalletService.GetUtxos:input_type -> pb.GetUtxosRequest
-	59,  // 75: pb.WalletService.GetPrivateKey:input_type -> pb.GetPrivateKeyRequest
-	61,  // 76: pb.WalletService.ImportAddress:input_type -> pb.ImportAddressRequest
-	63,  // 77: pb.WalletService.CreateMultisigSpendKeypair:input_type -> pb.CreateMultisigSpendKeypairRequest
-	65,  // 78: pb.WalletService.CreateMultisigViewKeypair:input_type -> pb.CreateMultisigViewKeypairRequest
-	67,  // 79: pb.WalletService.CreateMultisigAddress:input_type -> pb.CreateMultisigAddressRequest
-	69,  // 80: pb.WalletService.CreateMultiSignature:input_type -> pb.CreateMultiSignatureRequest
-	71,  // 81: pb.WalletService.ProveMultisig:input_type -> pb.ProveMultisigRequest
-	73,  // 82: pb.WalletService.WalletLock:input_type -> pb.WalletLockRequest
-	75,  // 83: pb.WalletService.WalletUnlock:input_type -> pb.WalletUnlockRequest
-	77,  // 84: pb.WalletService.SetWalletPassphrase:input_type -> pb.SetWalletPassphraseRequest
-	79,  // 85: pb.WalletService.ChangeWalletPassphrase:input_type -> pb.ChangeWalletPassphraseRequest
-	81,  // 86: pb.WalletService.DeletePrivateKeys:input_type -> pb.DeletePrivateKeysRequest
-	83,  // 87: pb.WalletService.CreateRawTransaction:input_type -> pb.CreateRawTransactionRequest
-	85,  // 88: pb.WalletService.CreateRawStakeTransaction:input_type -> pb.CreateRawStakeTransactionRequest
-	87,  // 89: pb.WalletService.ProveRawTransaction:input_type -> pb.ProveRawTransactionRequest
-	89,  // 90: pb.WalletService.Stake:input_type -> pb.StakeRequest
-	91,  // 91: pb.WalletService.SetAutoStakeRewards:input_type -> pb.SetAutoStakeRewardsRequest
-	93,  // 92: pb.WalletService.Spend:input_type -> pb.SpendRequest
-	95,  // 93: pb.WalletService.TimelockCoins:input_type -> pb.TimelockCoinsRequest
-	97,  // 94: pb.WalletService.SweepWallet:input_type -> pb.SweepWalletRequest
-	99,  // 95: pb.WalletService.SubscribeWalletTransactions:input_type -> pb.SubscribeWalletTransactionsRequest
-	100, // 96: pb.WalletService.SubscribeWalletSyncNotifications:input_type -> pb.SubscribeWalletSyncNotificationsRequest
-	101, // 97: pb.NodeService.GetHostInfo:input_type -> pb.GetHostInfoRequest
-	103, // 98: pb.NodeService.GetNetworkKey:input_type -> pb.GetNetworkKeyRequest
-	105, // 99: pb.NodeService.GetPeers:input_type -> pb.GetPeersRequest
-	107, // 100: pb.NodeService.AddPeer:input_type -> pb.AddPeerRequest
-	109, // 101: pb.NodeService.BlockPeer:input_type -> pb.BlockPeerRequest
-	111, // 102: pb.NodeService.UnblockPeer:input_type -> pb.UnblockPeerRequest
-	113, // 103: pb.NodeService.SetLogLevel:input_type -> pb.SetLogLevelRequest
-	115, // 104: pb.NodeService.GetMinFeePerKilobyte:input_type -> pb.GetMinFeePerKilobyteRequest
-	117, // 105: pb.NodeService.SetMinFeePerKilobyte:input_type -> pb.SetMinFeePerKilobyteRequest
-	119, // 106: pb.NodeService.GetMinStake:input_type -> pb.GetMinStakeRequest
-	121, // 107: pb.NodeService.SetMinStake:input_type -> pb.SetMinStakeRequest
-	123, // 108: pb.NodeService.GetBlockSizeSoftLimit:input_type -> pb.GetBlockSizeSoftLimitRequest
-	125, // 109: pb.NodeService.SetBlockSizeSoftLimit:input_type -> pb.SetBlockSizeSoftLimitRequest
-	127, // 110: pb.NodeService.GetTreasuryWhitelist:input_type -> pb.GetTreasuryWhitelistRequest
-	129, // 111: pb.NodeService.UpdateTreasuryWhitelist:input_type -> pb.UpdateTreasuryWhitelistRequest
-	131, // 112: pb.NodeService.ReconsiderBlock:input_type -> pb.ReconsiderBlockRequest
-	133, // 113: pb.NodeService.RecomputeChainState:input_type -> pb.RecomputeChainStateRequest
-	3,   // 114: pb.BlockchainService.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
-	5,   // 115: pb.BlockchainService.GetMempool:output_type -> pb.GetMempoolResponse
-	7,   // 116: pb.BlockchainService.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
-	9,   // 117: pb.BlockchainService.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
-	11,  // 118: pb.BlockchainService.GetBlock:output_type -> pb.GetBlockResponse
-	13,  // 119: pb.BlockchainService.GetCompressedBlock:output_type -> pb.GetCompressedBlockResponse
-	15,  // 120: pb.BlockchainService.GetHeaders:output_type -> pb.GetHeadersResponse
-	17,  // 121: pb.BlockchainService.GetCompressedBlocks:output_type -> pb.GetCompressedBlocksResponse
-	19,  // 122: pb.BlockchainService.GetTransaction:output_type -> pb.GetTransactionResponse
-	21,  // 123: pb.BlockchainService.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
-	23,  // 124: pb.BlockchainService.GetValidator:output_type -> pb.GetValidatorResponse
-	25,  // 125: pb.BlockchainService.GetValidatorSetInfo:output_type -> pb.GetValidatorSetInfoResponse
-	27,  // 126: pb.BlockchainService.GetValidatorSet:output_type -> pb.GetValidatorSetResponse
-	29,  // 127: pb.BlockchainService.GetAccumulatorCheckpoint:output_type -> pb.GetAccumulatorCheckpointResponse
-	31,  // 128: pb.BlockchainService.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
-	138, // 129: pb.BlockchainService.SubscribeBlocks:output_type -> pb.BlockNotification
-	139, // 130: pb.BlockchainService.SubscribeCompressedBlocks:output_type -> pb.CompressedBlockNotification
-	35,  // 131: pb.WalletServerService.RegisterViewKey:output_type -> pb.RegisterViewKeyResponse
-	135, // 132: pb.WalletServerService.SubscribeTransactions:output_type -> pb.TransactionNotification
-	38,  // 133: pb.WalletServerService.GetWalletTransactions:output_type -> pb.GetWalletTransactionsResponse
-	40,  // 134: pb.WalletServerService.GetTxoProof:output_type -> pb.GetTxoProofResponse
-	42,  // 135: pb.WalletService.GetBalance:output_type -> pb.GetBalanceResponse
-	44,  // 136: pb.WalletService.GetWalletSeed:output_type -> pb.GetWalletSeedResponse
-	46,  // 137: pb.WalletService.GetAddress:output_type -> pb.GetAddressResponse
-	48,  // 138: pb.WalletService.GetTimelockedAddress:output_type -> pb.GetTimelockedAddressResponse
-	50,  // 139: pb.WalletService.GetAddresses:output_type -> pb.GetAddressesResponse
-	52,  // 140: pb.WalletService.GetAddressInfo:output_type -> pb.GetAddressInfoResponse
-	54,  // 141: pb.WalletService.GetNewAddress:output_type -> pb.GetNewAddressResponse
-	56,  // 142: pb.WalletService.GetTransactions:output_type -> pb.GetTransactionsResponse
-	58,  // 143: pb.WalletService.GetUtxos:output_type -> pb.GetUtxosResponse
-	60,  // 144: pb.WalletService.GetPrivateKey:output_type -> pb.GetPrivateKeyResponse
-	62,  // 145: pb.WalletService.ImportAddress:output_type -> pb.ImportAddressResponse
-	64,  // 146: pb.WalletService.CreateMultisigSpendKeypair:output_type -> pb.CreateMultisigSpendKeypairResponse
-	66,  // 147: pb.WalletService.CreateMultisigViewKeypair:output_type -> pb.CreateMultisigViewKeypairResponse
-	68,  // 148: pb.WalletService.CreateMultisigAddress:output_type -> pb.CreateMultisigAddressResponse
-	70,  // 149: pb.WalletService.CreateMultiSignature:output_type -> pb.CreateMultiSignatureResponse
-	72,  // 150: pb.WalletService.ProveMultisig:output_type -> pb.ProveMultisigResponse
-	74,  // 151: pb.WalletService.WalletLock:output_type -> pb.WalletLockResponse
-	76,  // 152: pb.WalletService.WalletUnlock:output_type -> pb.WalletUnlockResponse
-	78,  // 153: pb.WalletService.SetWalletPassphrase:output_type -> pb.SetWalletPassphraseResponse
-	80,  // 154: pb.WalletService.ChangeWalletPassphrase:output_type -> pb.ChangeWalletPassphraseResponse
-	82,  // 155: pb.WalletService.DeletePrivateKeys:output_type -> pb.DeletePrivateKeysResponse
-	84,  // 156: pb.WalletService.CreateRawTransaction:output_type -> pb.CreateRawTransactionResponse
-	86,  // 157: pb.WalletService.CreateRawStakeTransaction:output_type -> pb.CreateRawStakeTransactionResponse
-	88,  // 158: pb.WalletService.ProveRawTransaction:output_type -> pb.ProveRawTransactionResponse
-	90,  // 159: pb.WalletService.Stake:output_type -> pb.StakeResponse
-	92,  // 160: pb.WalletService.SetAutoStakeRewards:output_type -> pb.SetAutoStakeRewardsResponse
-	94,  // 161: pb.WalletService.Spend:output_type -> pb.SpendResponse
-	96,  // 162: pb.WalletService.TimelockCoins:output_type -> pb.TimelockCoinsResponse
-	98,  // 163: pb.WalletService.SweepWallet:output_type -> pb.SweepWalletResponse
-	136, // 164: pb.WalletService.SubscribeWalletTransactions:output_type -> pb.WalletTransactionNotification
-	137, // 165: pb.WalletService.SubscribeWalletSyncNotifications:output_type -> pb.WalletSyncNotification
-	102, // 166: pb.NodeService.GetHostInfo:output_type -> pb.GetHostInfoResponse
-	104, // 167: pb.NodeService.GetNetworkKey:output_type -> pb.GetNetworkKeyResponse
-	106, // 168: pb.NodeService.GetPeers:output_type -> pb.GetPeersResponse
-	108, // 169: pb.NodeService.AddPeer:output_type -> pb.AddPeerResponse
-	110, // 170: pb.NodeService.BlockPeer:output_type -> pb.BlockPeerResponse
-	112, // 171: pb.NodeService.UnblockPeer:output_type -> pb.UnblockPeerResponse
-	114, // 172: pb.NodeService.SetLogLevel:output_type -> pb.SetLogLevelResponse
-	116, // 173: pb.NodeService.GetMinFeePerKilobyte:output_type -> pb.GetMinFeePerKilobyteResponse
-	118, // 174: pb.NodeService.SetMinFeePerKilobyte:output_type -> pb.SetMinFeePerKilobyteResponse
-	120, // 175: pb.NodeService.GetMinStake:output_type -> pb.GetMinStakeResponse
-	122, // 176: pb.NodeService.SetMinStake:output_type -> pb.SetMinStakeResponse
-	124, // 177: pb.NodeService.GetBlockSizeSoftLimit:output_type -> pb.GetBlockSizeSoftLimitResponse
-	126, // 178: pb.NodeService.SetBlockSizeSoftLimit:output_type -> pb.SetBlockSizeSoftLimitResponse
-	128, // 179: pb.NodeService.GetTreasuryWhitelist:output_type -> pb.GetTreasuryWhitelistResponse
-	130, // 180: pb.NodeService.UpdateTreasuryWhitelist:output_type -> pb.UpdateTreasuryWhitelistResponse
-	132, // 181: pb.NodeService.ReconsiderBlock:output_type -> pb.ReconsiderBlockResponse
-	134, // 182: pb.NodeService.RecomputeChainState:output_type -> pb.RecomputeChainStateResponse
-	114, // [114:183] is the sub-list for method output_type
-	45,  // [45:114] is the sub-list for method input_type
-	45,  // [45:45] is the sub-list for extension type_name
-	45,  // [45:45] is the sub-list for extension extendee
-	0,   // [0:45] is the sub-list for field type_name
+
+func (x *BlockNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_ilxrpc_proto_init() }
-func file_ilxrpc_proto_init() {
-	if File_ilxrpc_proto != nil {
-		return
+func (*BlockNotification) ProtoMessage() {}
+
+func (x *BlockNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ilxrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolInfoRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockNotification.ProtoReflect.Descriptor instead.
+func (*BlockNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *BlockNotification) GetBlockInfo() *BlockInfo {
+	if x != nil {
+		return x.BlockInfo
+	}
+	return nil
+}
+
+func (x *BlockNotification) GetTransactions() []*TransactionData {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type CompressedBlockNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A compressed block containing only the height,
+	// txids, outputs, and nullifiers.
+	Block *blocks.CompressedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *CompressedBlockNotification) Reset() {
+	*x = CompressedBlockNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompressedBlockNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompressedBlockNotification) ProtoMessage() {}
+
+func (x *CompressedBlockNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompressedBlockNotification.ProtoReflect.Descriptor instead.
+func (*CompressedBlockNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CompressedBlockNotification) GetBlock() *blocks.CompressedBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type FinalityNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the block
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// The height of the block
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The type of event that triggered this notification
+	Event FinalityNotification_Event `protobuf:"varint,3,opt,name=event,proto3,enum=pb.FinalityNotification_Event" json:"event,omitempty"`
+	// Whether the block is currently preferred by this node
+	Preferred bool `protobuf:"varint,4,opt,name=preferred,proto3" json:"preferred,omitempty"`
+	// The block's current confidence score
+	Confidence uint32 `protobuf:"varint,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// The confidence score at which the block is considered final
+	FinalizationScore uint32 `protobuf:"varint,6,opt,name=finalization_score,json=finalizationScore,proto3" json:"finalization_score,omitempty"`
+}
+
+func (x *FinalityNotification) Reset() {
+	*x = FinalityNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalityNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalityNotification) ProtoMessage() {}
+
+func (x *FinalityNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalityNotification.ProtoReflect.Descriptor instead.
+func (*FinalityNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *FinalityNotification) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *FinalityNotification) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FinalityNotification) GetEvent() FinalityNotification_Event {
+	if x != nil {
+		return x.Event
+	}
+	return FinalityNotification_SEEN
+}
+
+func (x *FinalityNotification) GetPreferred() bool {
+	if x != nil {
+		return x.Preferred
+	}
+	return false
+}
+
+func (x *FinalityNotification) GetConfidence() uint32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *FinalityNotification) GetFinalizationScore() uint32 {
+	if x != nil {
+		return x.FinalizationScore
+	}
+	return 0
+}
+
+type SyncStatusNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current sync status
+	Status *SyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SyncStatusNotification) Reset() {
+	*x = SyncStatusNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStatusNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatusNotification) ProtoMessage() {}
+
+func (x *SyncStatusNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatusNotification.ProtoReflect.Descriptor instead.
+func (*SyncStatusNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *SyncStatusNotification) GetStatus() *SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ForkNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fork that was observed
+	Fork *Fork `protobuf:"bytes,1,opt,name=fork,proto3" json:"fork,omitempty"`
+}
+
+func (x *ForkNotification) Reset() {
+	*x = ForkNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkNotification) ProtoMessage() {}
+
+func (x *ForkNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkNotification.ProtoReflect.Descriptor instead.
+func (*ForkNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ForkNotification) GetFork() *Fork {
+	if x != nil {
+		return x.Fork
+	}
+	return nil
+}
+
+type MempoolNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of event
+	Event MempoolNotification_Event `protobuf:"varint,1,opt,name=event,proto3,enum=pb.MempoolNotification_Event" json:"event,omitempty"`
+	// The transaction's mempool entry
+	Entry *MempoolEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *MempoolNotification) Reset() {
+	*x = MempoolNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolNotification) ProtoMessage() {}
+
+func (x *MempoolNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MempoolNotification.ProtoReflect.Descriptor instead.
+func (*MempoolNotification) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *MempoolNotification) GetEvent() MempoolNotification_Event {
+	if x != nil {
+		return x.Event
+	}
+	return MempoolNotification_TX_ADDED
+}
+
+func (x *MempoolNotification) GetEntry() *MempoolEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// DATA MESSAGES
+type MempoolEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction ID
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The transaction type
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The serialized size of the transaction in bytes
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The fee paid by the transaction
+	Fee uint64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee per kilobyte paid by the transaction
+	FeePerKilobyte uint64 `protobuf:"varint,5,opt,name=fee_per_kilobyte,json=feePerKilobyte,proto3" json:"fee_per_kilobyte,omitempty"`
+	// The fee delta set with PrioritiseTransaction
+	FeeDelta int64 `protobuf:"varint,10,opt,name=fee_delta,json=feeDelta,proto3" json:"fee_delta,omitempty"`
+	// The time the transaction entered the mempool. Expressed in
+	// seconds since 1970-01-01.
+	EntryTime int64 `protobuf:"varint,6,opt,name=entry_time,json=entryTime,proto3" json:"entry_time,omitempty"`
+	// The status of the transaction in the mempool
+	Status MempoolEntry_Status `protobuf:"varint,7,opt,name=status,proto3,enum=pb.MempoolEntry_Status" json:"status,omitempty"`
+	// The range of block times in which the transaction may be included
+	// in a block. Zero if the transaction has no locktime. Expressed in
+	// seconds since 1970-01-01.
+	LocktimeStart int64 `protobuf:"varint,8,opt,name=locktime_start,json=locktimeStart,proto3" json:"locktime_start,omitempty"`
+	LocktimeEnd   int64 `protobuf:"varint,9,opt,name=locktime_end,json=locktimeEnd,proto3" json:"locktime_end,omitempty"`
+}
+
+func (x *MempoolEntry) Reset() {
+	*x = MempoolEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MempoolEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MempoolEntry) ProtoMessage() {}
+
+func (x *MempoolEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MempoolEntry.ProtoReflect.Descriptor instead.
+func (*MempoolEntry) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *MempoolEntry) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *MempoolEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MempoolEntry) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetFeePerKilobyte() uint64 {
+	if x != nil {
+		return x.FeePerKilobyte
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetFeeDelta() int64 {
+	if x != nil {
+		return x.FeeDelta
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetEntryTime() int64 {
+	if x != nil {
+		return x.EntryTime
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetStatus() MempoolEntry_Status {
+	if x != nil {
+		return x.Status
+	}
+	return MempoolEntry_PENDING
+}
+
+func (x *MempoolEntry) GetLocktimeStart() int64 {
+	if x != nil {
+		return x.LocktimeStart
+	}
+	return 0
+}
+
+func (x *MempoolEntry) GetLocktimeEnd() int64 {
+	if x != nil {
+		return x.LocktimeEnd
+	}
+	return 0
+}
+
+type TransactionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to TxidsOrTxs:
+	//
+	//	*TransactionData_Transaction_ID
+	//	*TransactionData_Transaction
+	TxidsOrTxs isTransactionData_TxidsOrTxs `protobuf_oneof:"txids_or_txs"`
+}
+
+func (x *TransactionData) Reset() {
+	*x = TransactionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionData) ProtoMessage() {}
+
+func (x *TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
+func (*TransactionData) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (m *TransactionData) GetTxidsOrTxs() isTransactionData_TxidsOrTxs {
+	if m != nil {
+		return m.TxidsOrTxs
+	}
+	return nil
+}
+
+func (x *TransactionData) GetTransaction_ID() []byte {
+	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction_ID); ok {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *TransactionData) GetTransaction() *transactions.Transaction {
+	if x, ok := x.GetTxidsOrTxs().(*TransactionData_Transaction); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+type isTransactionData_TxidsOrTxs interface {
+	isTransactionData_TxidsOrTxs()
+}
+
+type TransactionData_Transaction_ID struct {
+	// Just the transaction ID
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3,oneof"`
+}
+
+type TransactionData_Transaction struct {
+	// A marshaled transaction.
+	Transaction *transactions.Transaction `protobuf:"bytes,2,opt,name=transaction,proto3,oneof"`
+}
+
+func (*TransactionData_Transaction_ID) isTransactionData_TxidsOrTxs() {}
+
+func (*TransactionData_Transaction) isTransactionData_TxidsOrTxs() {}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the block header.
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// A version number to track software/protocol upgrades.
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The height of the block in the chain.
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The block in the chain right before this one.
+	Parent []byte `protobuf:"bytes,4,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The next block in the chain (or nil if this block is the tip).
+	Child []byte `protobuf:"bytes,5,opt,name=child,proto3" json:"child,omitempty"`
+	// The timestamp of the block. Expressed in seconds since 1970-01-01.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The root of the Merkle Tree built from all transactions in the block.
+	TxRoot []byte `protobuf:"bytes,7,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
+	// The peerID of the validator that created the block.
+	Producer_ID []byte `protobuf:"bytes,8,opt,name=producer_ID,json=producerID,proto3" json:"producer_ID,omitempty"`
+	// The size of the block in bytes.
+	Size uint32 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	// The number of transactions in the block.
+	NumTxs uint32 `protobuf:"varint,10,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *BlockInfo) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetParent() []byte {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetChild() []byte {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetTxRoot() []byte {
+	if x != nil {
+		return x.TxRoot
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetProducer_ID() []byte {
+	if x != nil {
+		return x.Producer_ID
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetNumTxs() uint32 {
+	if x != nil {
+		return x.NumTxs
+	}
+	return 0
+}
+
+type Validator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The validator ID encoded in bytes.
+	Validator_ID []byte `protobuf:"bytes,1,opt,name=validator_ID,json=validatorID,proto3" json:"validator_ID,omitempty"`
+	// The number of coins staked by this validator.
+	TotalStake uint64 `protobuf:"varint,2,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
+	// The total stake weighted by time lock.
+	StakeWeight uint64 `protobuf:"varint,3,opt,name=stake_weight,json=stakeWeight,proto3" json:"stake_weight,omitempty"`
+	// The nullifiers for the utxos the validator has staked.
+	Stake []*Validator_Stake `protobuf:"bytes,4,rep,name=stake,proto3" json:"stake,omitempty"`
+	// The total of any unclaimed validator rewards.
+	UnclaimedCoins uint64 `protobuf:"varint,5,opt,name=unclaimed_coins,json=unclaimedCoins,proto3" json:"unclaimed_coins,omitempty"`
+	// The number of blocks this validator has created this epoch.
+	EpochBlocks uint32 `protobuf:"varint,6,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+}
+
+func (x *Validator) Reset() {
+	*x = Validator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator) ProtoMessage() {}
+
+func (x *Validator) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator.ProtoReflect.Descriptor instead.
+func (*Validator) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *Validator) GetValidator_ID() []byte {
+	if x != nil {
+		return x.Validator_ID
+	}
+	return nil
+}
+
+func (x *Validator) GetTotalStake() uint64 {
+	if x != nil {
+		return x.TotalStake
+	}
+	return 0
+}
+
+func (x *Validator) GetStakeWeight() uint64 {
+	if x != nil {
+		return x.StakeWeight
+	}
+	return 0
+}
+
+func (x *Validator) GetStake() []*Validator_Stake {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *Validator) GetUnclaimedCoins() uint64 {
+	if x != nil {
+		return x.UnclaimedCoins
+	}
+	return 0
+}
+
+func (x *Validator) GetEpochBlocks() uint32 {
+	if x != nil {
+		return x.EpochBlocks
+	}
+	return 0
+}
+
+type Utxo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The commitment associated with the output
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The amount of coins
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The address that the utxo is associated with
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether or not this is a watch only utxo.
+	// We canot spend watch only utxos without the
+	// private key.
+	WatchOnly bool `protobuf:"varint,4,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
+	// Is this utxo staked by the wallet.
+	Staked bool `protobuf:"varint,5,opt,name=staked,proto3" json:"staked,omitempty"`
+	// The timestamp this utxo is timelocked until (if applicable)
+	LockedUntill int64 `protobuf:"varint,6,opt,name=locked_untill,json=lockedUntill,proto3" json:"locked_untill,omitempty"`
+}
+
+func (x *Utxo) Reset() {
+	*x = Utxo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Utxo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Utxo) ProtoMessage() {}
+
+func (x *Utxo) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *Utxo) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *Utxo) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Utxo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Utxo) GetWatchOnly() bool {
+	if x != nil {
+		return x.WatchOnly
+	}
+	return false
+}
+
+func (x *Utxo) GetStaked() bool {
+	if x != nil {
+		return x.Staked
+	}
+	return false
+}
+
+func (x *Utxo) GetLockedUntill() int64 {
+	if x != nil {
+		return x.LockedUntill
+	}
+	return 0
+}
+
+type RawTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The transaction as it appears on the network
+	Tx *transactions.Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// The private (hidden) data for each input
+	Inputs []*PrivateInput `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The private (hidden) data for each output
+	Outputs []*PrivateOutput `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *RawTransaction) Reset() {
+	*x = RawTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawTransaction) ProtoMessage() {}
+
+func (x *RawTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawTransaction.ProtoReflect.Descriptor instead.
+func (*RawTransaction) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *RawTransaction) GetTx() *transactions.Transaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *RawTransaction) GetInputs() []*PrivateInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *RawTransaction) GetOutputs() []*PrivateOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type PrivateInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of the input
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Input asset ID
+	Asset_ID []byte `protobuf:"bytes,2,opt,name=asset_ID,json=assetID,proto3" json:"asset_ID,omitempty"`
+	// Input salt
+	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// Input state
+	State []byte `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// The txo proof linking the input
+	// commitment to the accumulator
+	TxoProof *TxoProof `protobuf:"bytes,5,opt,name=txo_proof,json=txoProof,proto3" json:"txo_proof,omitempty"`
+	// The locking script
+	Script string `protobuf:"bytes,6,opt,name=script,proto3" json:"script,omitempty"`
+	// The locking function parameters
+	LockingParams [][]byte `protobuf:"bytes,7,rep,name=locking_params,json=lockingParams,proto3" json:"locking_params,omitempty"`
+	// Optional lurk expression used as
+	// input to locking script. This is
+	// only necessary for watch-only utxos.
+	UnlockingParams string `protobuf:"bytes,8,opt,name=unlocking_params,json=unlockingParams,proto3" json:"unlocking_params,omitempty"`
+}
+
+func (x *PrivateInput) Reset() {
+	*x = PrivateInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateInput) ProtoMessage() {}
+
+func (x *PrivateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateInput.ProtoReflect.Descriptor instead.
+func (*PrivateInput) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *PrivateInput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PrivateInput) GetAsset_ID() []byte {
+	if x != nil {
+		return x.Asset_ID
+	}
+	return nil
+}
+
+func (x *PrivateInput) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *PrivateInput) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *PrivateInput) GetTxoProof() *TxoProof {
+	if x != nil {
+		return x.TxoProof
+	}
+	return nil
+}
+
+func (x *PrivateInput) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *PrivateInput) GetLockingParams() [][]byte {
+	if x != nil {
+		return x.LockingParams
+	}
+	return nil
+}
+
+func (x *PrivateInput) GetUnlockingParams() string {
+	if x != nil {
+		return x.UnlockingParams
+	}
+	return ""
+}
+
+type PrivateOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output scriptHash
+	ScriptHash []byte `protobuf:"bytes,1,opt,name=script_hash,json=scriptHash,proto3" json:"script_hash,omitempty"`
+	// Output amount
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Output salt
+	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// Output asset ID
+	Asset_ID []byte `protobuf:"bytes,4,opt,name=asset_ID,json=assetID,proto3" json:"asset_ID,omitempty"`
+	// Output state
+	State []byte `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *PrivateOutput) Reset() {
+	*x = PrivateOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateOutput) ProtoMessage() {}
+
+func (x *PrivateOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateOutput.ProtoReflect.Descriptor instead.
+func (*PrivateOutput) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *PrivateOutput) GetScriptHash() []byte {
+	if x != nil {
+		return x.ScriptHash
+	}
+	return nil
+}
+
+func (x *PrivateOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PrivateOutput) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *PrivateOutput) GetAsset_ID() []byte {
+	if x != nil {
+		return x.Asset_ID
+	}
+	return nil
+}
+
+func (x *PrivateOutput) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type TxoProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The commitment this inclusion proof is for
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// The hashes that form the root preimage
+	Hashes [][]byte `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// Flags indicate whether a hash in the hash list is left or right.
+	// 0 == left, 1 == right.
+	Flags uint64 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	// The index of this commitment in the tree
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// The txoRoot this proof links to. This is found in the block header.
+	TxoRoot []byte `protobuf:"bytes,5,opt,name=txoRoot,proto3" json:"txoRoot,omitempty"`
+}
+
+func (x *TxoProof) Reset() {
+	*x = TxoProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxoProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxoProof) ProtoMessage() {}
+
+func (x *TxoProof) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxoProof.ProtoReflect.Descriptor instead.
+func (*TxoProof) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *TxoProof) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *TxoProof) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *TxoProof) GetFlags() uint64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *TxoProof) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxoProof) GetTxoRoot() []byte {
+	if x != nil {
+		return x.TxoRoot
+	}
+	return nil
+}
+
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Peer ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The peer's user agent string
+	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Multiaddrs
+	Addrs []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// Whether the connection was initiated by the peer
+	Inbound bool `protobuf:"varint,4,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	// Total bytes received from the peer
+	BytesIn uint64 `protobuf:"varint,5,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	// Total bytes sent to the peer
+	BytesOut uint64 `protobuf:"varint,6,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	// Current receive rate in bytes per second
+	RateIn uint64 `protobuf:"varint,7,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	// Current send rate in bytes per second
+	RateOut uint64 `protobuf:"varint,8,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *Peer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Peer) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Peer) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+func (x *Peer) GetInbound() bool {
+	if x != nil {
+		return x.Inbound
+	}
+	return false
+}
+
+func (x *Peer) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *Peer) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *Peer) GetRateIn() uint64 {
+	if x != nil {
+		return x.RateIn
+	}
+	return 0
+}
+
+func (x *Peer) GetRateOut() uint64 {
+	if x != nil {
+		return x.RateOut
+	}
+	return 0
+}
+
+type WalletTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Transaction ID
+	Transaction_ID []byte `protobuf:"bytes,1,opt,name=transaction_ID,json=transactionID,proto3" json:"transaction_ID,omitempty"`
+	// The net number of coins coming into the wallet
+	// Positive = receive
+	// Negative = send
+	NetCoins int64 `protobuf:"varint,2,opt,name=netCoins,proto3" json:"netCoins,omitempty"`
+	// The address and amount of each input if known
+	// to the wallet.
+	Inputs []*WalletTransaction_IO `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	// The address and amount of each output if known
+	// to the wallet.
+	Outputs []*WalletTransaction_IO `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *WalletTransaction) GetTransaction_ID() []byte {
+	if x != nil {
+		return x.Transaction_ID
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetNetCoins() int64 {
+	if x != nil {
+		return x.NetCoins
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetInputs() []*WalletTransaction_IO {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetOutputs() []*WalletTransaction_IO {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type SyncStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the node believes it is synced to the tip of the chain
+	Current bool `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	// The height of the node's best block
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The estimated height of the tip of the chain based on what
+	// peers report
+	NetworkHeight uint32 `protobuf:"varint,3,opt,name=network_height,json=networkHeight,proto3" json:"network_height,omitempty"`
+	// The peers blocks are currently being downloaded from
+	SyncPeers []string `protobuf:"bytes,4,rep,name=sync_peers,json=syncPeers,proto3" json:"sync_peers,omitempty"`
+	// The peers grouped by the best block they reported when the sync
+	// started. More than one bucket may indicate a fork.
+	Buckets []*SyncStatus_PeerBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// The recent rate at which blocks are being connected
+	BlocksPerSecond float64 `protobuf:"fixed64,6,opt,name=blocks_per_second,json=blocksPerSecond,proto3" json:"blocks_per_second,omitempty"`
+	// The estimated number of seconds until the node is synced
+	EtaSeconds uint64 `protobuf:"varint,7,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
+}
+
+func (x *SyncStatus) Reset() {
+	*x = SyncStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatus) ProtoMessage() {}
+
+func (x *SyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatus.ProtoReflect.Descriptor instead.
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *SyncStatus) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *SyncStatus) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SyncStatus) GetNetworkHeight() uint32 {
+	if x != nil {
+		return x.NetworkHeight
+	}
+	return 0
+}
+
+func (x *SyncStatus) GetSyncPeers() []string {
+	if x != nil {
+		return x.SyncPeers
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetBuckets() []*SyncStatus_PeerBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *SyncStatus) GetBlocksPerSecond() float64 {
+	if x != nil {
+		return x.BlocksPerSecond
+	}
+	return 0
+}
+
+func (x *SyncStatus) GetEtaSeconds() uint64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+type ChainTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the tip block
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// The height of the tip block
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of blocks between the tip and the point where it
+	// forks from the best chain. Zero for the active tip.
+	BranchLength uint32 `protobuf:"varint,3,opt,name=branch_length,json=branchLength,proto3" json:"branch_length,omitempty"`
+	// The status of the tip
+	Status ChainTip_Status `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ChainTip_Status" json:"status,omitempty"`
+}
+
+func (x *ChainTip) Reset() {
+	*x = ChainTip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainTip) ProtoMessage() {}
+
+func (x *ChainTip) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainTip.ProtoReflect.Descriptor instead.
+func (*ChainTip) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ChainTip) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *ChainTip) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ChainTip) GetBranchLength() uint32 {
+	if x != nil {
+		return x.BranchLength
+	}
+	return 0
+}
+
+func (x *ChainTip) GetStatus() ChainTip_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ChainTip_ACTIVE
+}
+
+type Fork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The last block the competing chains have in common
+	ForkBlock_ID []byte `protobuf:"bytes,1,opt,name=fork_block_ID,json=forkBlockID,proto3" json:"fork_block_ID,omitempty"`
+	// The height of the fork block
+	ForkHeight uint32 `protobuf:"varint,2,opt,name=fork_height,json=forkHeight,proto3" json:"fork_height,omitempty"`
+	// The competing sides of the fork
+	Tips []*Fork_Tip `protobuf:"bytes,3,rep,name=tips,proto3" json:"tips,omitempty"`
+	// The block ID of the tip that was selected
+	Winner []byte `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+	// Whether the fork was near the tip of the chain and the
+	// winner was selected by consensus rather than chain score
+	ChosenByConsensus bool `protobuf:"varint,5,opt,name=chosen_by_consensus,json=chosenByConsensus,proto3" json:"chosen_by_consensus,omitempty"`
+	// The unix timestamp at which the fork was observed
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Fork) Reset() {
+	*x = Fork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fork) ProtoMessage() {}
+
+func (x *Fork) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fork.ProtoReflect.Descriptor instead.
+func (*Fork) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *Fork) GetForkBlock_ID() []byte {
+	if x != nil {
+		return x.ForkBlock_ID
+	}
+	return nil
+}
+
+func (x *Fork) GetForkHeight() uint32 {
+	if x != nil {
+		return x.ForkHeight
+	}
+	return 0
+}
+
+func (x *Fork) GetTips() []*Fork_Tip {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+func (x *Fork) GetWinner() []byte {
+	if x != nil {
+		return x.Winner
+	}
+	return nil
+}
+
+func (x *Fork) GetChosenByConsensus() bool {
+	if x != nil {
+		return x.ChosenByConsensus
+	}
+	return false
+}
+
+func (x *Fork) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type CreateRawTransactionRequest_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to CommitmentOrPrivateInput:
+	//
+	//	*CreateRawTransactionRequest_Input_Commitment
+	//	*CreateRawTransactionRequest_Input_Input
+	CommitmentOrPrivateInput isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput `protobuf_oneof:"commitment_or_private_input"`
+}
+
+func (x *CreateRawTransactionRequest_Input) Reset() {
+	*x = CreateRawTransactionRequest_Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRawTransactionRequest_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRawTransactionRequest_Input) ProtoMessage() {}
+
+func (x *CreateRawTransactionRequest_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRawTransactionRequest_Input.ProtoReflect.Descriptor instead.
+func (*CreateRawTransactionRequest_Input) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (m *CreateRawTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput {
+	if m != nil {
+		return m.CommitmentOrPrivateInput
+	}
+	return nil
+}
+
+func (x *CreateRawTransactionRequest_Input) GetCommitment() []byte {
+	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawTransactionRequest_Input_Commitment); ok {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *CreateRawTransactionRequest_Input) GetInput() *PrivateInput {
+	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawTransactionRequest_Input_Input); ok {
+		return x.Input
+	}
+	return nil
+}
+
+type isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput interface {
+	isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput()
+}
+
+type CreateRawTransactionRequest_Input_Commitment struct {
+	// This is a utxo commitment known to the wallet
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3,oneof"`
+}
+
+type CreateRawTransactionRequest_Input_Input struct {
+	// If trying to create a transaction spending a utxo that the
+	// wallet does not know about you must provide the full private
+	// input data.
+	Input *PrivateInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+func (*CreateRawTransactionRequest_Input_Commitment) isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput() {
+}
+
+func (*CreateRawTransactionRequest_Input_Input) isCreateRawTransactionRequest_Input_CommitmentOrPrivateInput() {
+}
+
+type CreateRawTransactionRequest_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address to send coins to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The amount to send
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// An optional state field
+	State []byte `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *CreateRawTransactionRequest_Output) Reset() {
+	*x = CreateRawTransactionRequest_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRawTransactionRequest_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRawTransactionRequest_Output) ProtoMessage() {}
+
+func (x *CreateRawTransactionRequest_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRawTransactionRequest_Output.ProtoReflect.Descriptor instead.
+func (*CreateRawTransactionRequest_Output) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{88, 1}
+}
+
+func (x *CreateRawTransactionRequest_Output) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateRawTransactionRequest_Output) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRawTransactionRequest_Output) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type CreateRawStakeTransactionRequest_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to CommitmentOrPrivateInput:
+	//
+	//	*CreateRawStakeTransactionRequest_Input_Commitment
+	//	*CreateRawStakeTransactionRequest_Input_Input
+	CommitmentOrPrivateInput isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput `protobuf_oneof:"commitment_or_private_input"`
+}
+
+func (x *CreateRawStakeTransactionRequest_Input) Reset() {
+	*x = CreateRawStakeTransactionRequest_Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRawStakeTransactionRequest_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRawStakeTransactionRequest_Input) ProtoMessage() {}
+
+func (x *CreateRawStakeTransactionRequest_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRawStakeTransactionRequest_Input.ProtoReflect.Descriptor instead.
+func (*CreateRawStakeTransactionRequest_Input) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{90, 0}
+}
+
+func (m *CreateRawStakeTransactionRequest_Input) GetCommitmentOrPrivateInput() isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput {
+	if m != nil {
+		return m.CommitmentOrPrivateInput
+	}
+	return nil
+}
+
+func (x *CreateRawStakeTransactionRequest_Input) GetCommitment() []byte {
+	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawStakeTransactionRequest_Input_Commitment); ok {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *CreateRawStakeTransactionRequest_Input) GetInput() *PrivateInput {
+	if x, ok := x.GetCommitmentOrPrivateInput().(*CreateRawStakeTransactionRequest_Input_Input); ok {
+		return x.Input
+	}
+	return nil
+}
+
+type isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput interface {
+	isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput()
+}
+
+type CreateRawStakeTransactionRequest_Input_Commitment struct {
+	// This is a utxo commitment known to the wallet
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3,oneof"`
+}
+
+type CreateRawStakeTransactionRequest_Input_Input struct {
+	// If trying to create a transaction spending a utxo that the
+	// wallet does not know about you must provide the full private
+	// input data.
+	Input *PrivateInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+func (*CreateRawStakeTransactionRequest_Input_Commitment) isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput() {
+}
+
+func (*CreateRawStakeTransactionRequest_Input_Input) isCreateRawStakeTransactionRequest_Input_CommitmentOrPrivateInput() {
+}
+
+type GetNetTotalsResponse_ProtocolBandwidth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The protocol name. One of:
+	// blocks, transactions, pubsub, consensus, chainservice, dht, other.
+	// The pubsub total includes the blocks and transactions topics.
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Total bytes received
+	BytesIn uint64 `protobuf:"varint,2,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	// Total bytes sent
+	BytesOut uint64 `protobuf:"varint,3,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	// Current receive rate in bytes per second
+	RateIn uint64 `protobuf:"varint,4,opt,name=rate_in,json=rateIn,proto3" json:"rate_in,omitempty"`
+	// Current send rate in bytes per second
+	RateOut uint64 `protobuf:"varint,5,opt,name=rate_out,json=rateOut,proto3" json:"rate_out,omitempty"`
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) Reset() {
+	*x = GetNetTotalsResponse_ProtocolBandwidth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetTotalsResponse_ProtocolBandwidth) ProtoMessage() {}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetTotalsResponse_ProtocolBandwidth.ProtoReflect.Descriptor instead.
+func (*GetNetTotalsResponse_ProtocolBandwidth) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{115, 0}
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) GetBytesIn() uint64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) GetBytesOut() uint64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) GetRateIn() uint64 {
+	if x != nil {
+		return x.RateIn
+	}
+	return 0
+}
+
+func (x *GetNetTotalsResponse_ProtocolBandwidth) GetRateOut() uint64 {
+	if x != nil {
+		return x.RateOut
+	}
+	return 0
+}
+
+type ListBansResponse_Ban struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The banned peer ID. Set for peer bans.
+	Peer_ID string `protobuf:"bytes,1,opt,name=peer_ID,json=peerID,proto3" json:"peer_ID,omitempty"`
+	// The banned subnet in CIDR notation. Set for IP
+	// address and subnet bans. Banned IP addresses
+	// use a full length mask.
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// The reason for the ban
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix timestamp (in seconds) when the ban expires
+	Expiration int64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (x *ListBansResponse_Ban) Reset() {
+	*x = ListBansResponse_Ban{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBansResponse_Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBansResponse_Ban) ProtoMessage() {}
+
+func (x *ListBansResponse_Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBansResponse_Ban.ProtoReflect.Descriptor instead.
+func (*ListBansResponse_Ban) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{136, 0}
+}
+
+func (x *ListBansResponse_Ban) GetPeer_ID() string {
+	if x != nil {
+		return x.Peer_ID
+	}
+	return ""
+}
+
+func (x *ListBansResponse_Ban) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *ListBansResponse_Ban) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListBansResponse_Ban) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+type Validator_Stake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The nullifier that is staked
+	Nullifier []byte `protobuf:"bytes,1,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
+	// The amount staked
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The timestamp that this utxo is timelocked until
+	TimelockedUntil int64 `protobuf:"varint,3,opt,name=timelocked_until,json=timelockedUntil,proto3" json:"timelocked_until,omitempty"`
+	// When this nullifier will expire and be removed from
+	// the validator set.
+	Expiration int64 `protobuf:"varint,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// The earliest date at which this nullifier can be restaked.
+	// One week before expiration.
+	RestakeEligibility int64 `protobuf:"varint,5,opt,name=restake_eligibility,json=restakeEligibility,proto3" json:"restake_eligibility,omitempty"`
+}
+
+func (x *Validator_Stake) Reset() {
+	*x = Validator_Stake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Validator_Stake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Validator_Stake) ProtoMessage() {}
+
+func (x *Validator_Stake) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Validator_Stake.ProtoReflect.Descriptor instead.
+func (*Validator_Stake) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{183, 0}
+}
+
+func (x *Validator_Stake) GetNullifier() []byte {
+	if x != nil {
+		return x.Nullifier
+	}
+	return nil
+}
+
+func (x *Validator_Stake) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Validator_Stake) GetTimelockedUntil() int64 {
+	if x != nil {
+		return x.TimelockedUntil
+	}
+	return 0
+}
+
+func (x *Validator_Stake) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+func (x *Validator_Stake) GetRestakeEligibility() int64 {
+	if x != nil {
+		return x.RestakeEligibility
+	}
+	return 0
+}
+
+type WalletTransaction_IO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Either address/amount information or unknown
+	// if input or output did not belong to this wallet
+	// and can't be decrypted.
+	//
+	// Types that are assignable to IoType:
+	//
+	//	*WalletTransaction_IO_TxIo
+	//	*WalletTransaction_IO_Unknown_
+	IoType isWalletTransaction_IO_IoType `protobuf_oneof:"io_type"`
+}
+
+func (x *WalletTransaction_IO) Reset() {
+	*x = WalletTransaction_IO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletTransaction_IO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction_IO) ProtoMessage() {}
+
+func (x *WalletTransaction_IO) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction_IO.ProtoReflect.Descriptor instead.
+func (*WalletTransaction_IO) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{190, 0}
+}
+
+func (m *WalletTransaction_IO) GetIoType() isWalletTransaction_IO_IoType {
+	if m != nil {
+		return m.IoType
+	}
+	return nil
+}
+
+func (x *WalletTransaction_IO) GetTxIo() *WalletTransaction_IO_TxIO {
+	if x, ok := x.GetIoType().(*WalletTransaction_IO_TxIo); ok {
+		return x.TxIo
+	}
+	return nil
+}
+
+func (x *WalletTransaction_IO) GetUnknown() *WalletTransaction_IO_Unknown {
+	if x, ok := x.GetIoType().(*WalletTransaction_IO_Unknown_); ok {
+		return x.Unknown
+	}
+	return nil
+}
+
+type isWalletTransaction_IO_IoType interface {
+	isWalletTransaction_IO_IoType()
+}
+
+type WalletTransaction_IO_TxIo struct {
+	TxIo *WalletTransaction_IO_TxIO `protobuf:"bytes,1,opt,name=tx_io,json=txIo,proto3,oneof"`
+}
+
+type WalletTransaction_IO_Unknown_ struct {
+	Unknown *WalletTransaction_IO_Unknown `protobuf:"bytes,2,opt,name=unknown,proto3,oneof"`
+}
+
+func (*WalletTransaction_IO_TxIo) isWalletTransaction_IO_IoType() {}
+
+func (*WalletTransaction_IO_Unknown_) isWalletTransaction_IO_IoType() {}
+
+type WalletTransaction_IO_TxIO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address associated with the input or output
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount of coins associated with the input or output
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *WalletTransaction_IO_TxIO) Reset() {
+	*x = WalletTransaction_IO_TxIO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletTransaction_IO_TxIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction_IO_TxIO) ProtoMessage() {}
+
+func (x *WalletTransaction_IO_TxIO) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction_IO_TxIO.ProtoReflect.Descriptor instead.
+func (*WalletTransaction_IO_TxIO) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{190, 0, 0}
+}
+
+func (x *WalletTransaction_IO_TxIO) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletTransaction_IO_TxIO) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Represents an input or output not belonging to
+// the wallet.
+type WalletTransaction_IO_Unknown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WalletTransaction_IO_Unknown) Reset() {
+	*x = WalletTransaction_IO_Unknown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletTransaction_IO_Unknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction_IO_Unknown) ProtoMessage() {}
+
+func (x *WalletTransaction_IO_Unknown) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction_IO_Unknown.ProtoReflect.Descriptor instead.
+func (*WalletTransaction_IO_Unknown) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{190, 0, 1}
+}
+
+type SyncStatus_PeerBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The best block ID reported by the peers in the bucket
+	BestBlock_ID []byte `protobuf:"bytes,1,opt,name=best_block_ID,json=bestBlockID,proto3" json:"best_block_ID,omitempty"`
+	// The height of the best block
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The peers in the bucket
+	Peers []string `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *SyncStatus_PeerBucket) Reset() {
+	*x = SyncStatus_PeerBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncStatus_PeerBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatus_PeerBucket) ProtoMessage() {}
+
+func (x *SyncStatus_PeerBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatus_PeerBucket.ProtoReflect.Descriptor instead.
+func (*SyncStatus_PeerBucket) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{191, 0}
+}
+
+func (x *SyncStatus_PeerBucket) GetBestBlock_ID() []byte {
+	if x != nil {
+		return x.BestBlock_ID
+	}
+	return nil
+}
+
+func (x *SyncStatus_PeerBucket) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *SyncStatus_PeerBucket) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type Fork_Tip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the last block downloaded on this side
+	// of the fork
+	Block_ID []byte `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	// The height of the block
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The peers following this side of the fork
+	Peers []string `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	// The chain score of the evaluation window. Lower is better.
+	ChainScore float64 `protobuf:"fixed64,4,opt,name=chain_score,json=chainScore,proto3" json:"chain_score,omitempty"`
+}
+
+func (x *Fork_Tip) Reset() {
+	*x = Fork_Tip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ilxrpc_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fork_Tip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fork_Tip) ProtoMessage() {}
+
+func (x *Fork_Tip) ProtoReflect() protoreflect.Message {
+	mi := &file_ilxrpc_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fork_Tip.ProtoReflect.Descriptor instead.
+func (*Fork_Tip) Descriptor() ([]byte, []int) {
+	return file_ilxrpc_proto_rawDescGZIP(), []int{193, 0}
+}
+
+func (x *Fork_Tip) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *Fork_Tip) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Fork_Tip) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *Fork_Tip) GetChainScore() float64 {
+	if x != nil {
+		return x.ChainScore
+	}
+	return 0
+}
+
+var File_ilxrpc_proto protoreflect.FileDescriptor
+
+var file_ilxrpc_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x69, 0x6c, 0x78, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa4, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x68, 0x65, 0x6c, 0x64, 0x22, 0x40, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x10,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3f, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x41, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x39, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3f, 0x0a, 0x13, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69,
+	0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x1a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65,
+	0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x62, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74,
+	0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x22, 0x3e, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x0b,
+	0x0a, 0x07, 0x4d, 0x41, 0x49, 0x4e, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x47, 0x54, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x53, 0x54,
+	0x4e, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x4e, 0x45,
+	0x54, 0x10, 0x03, 0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x58, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x30, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x44, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x22, 0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x55, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x07, 0x57, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x8a, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x22, 0x72, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x15, 0x0a,
+	0x13, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x4a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x42, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xec, 0x02,
+	0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x44, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52,
+	0x55, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x03, 0x22, 0x64, 0x0a, 0x16,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x22, 0x19,
+	0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x1c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x69,
+	0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x4b,
+	0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x62, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x62, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x62, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e,
+	0x62, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x74, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x36, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x53, 0x65,
+	0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x38, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x69,
+	0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x78, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73,
+	0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x40, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x69, 0x65, 0x77, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x2a, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x46,
+	0x72, 0x6f, 0x6d, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65,
+	0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22,
+	0x22, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b,
+	0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0x77, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b,
+	0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1a,
+	0x0a, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x07, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x74,
+	0x78, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x68, 0x61, 0x73, 0x68, 0x22, 0x3c, 0x0a, 0x1c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x55, 0x0a, 0x14, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x69, 0x67,
+	0x73, 0x22, 0x42, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x13, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x51, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x53,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22,
+	0x20, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x03, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x1a, 0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x50, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0xd8, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x72, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x1d, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x22, 0x4e, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61,
+	0x77, 0x54, 0x78, 0x22, 0x47, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x48, 0x0a, 0x1b,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x54, 0x78, 0x22, 0x30, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x1a, 0x53, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69,
+	0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa4, 0x01, 0x0a, 0x14,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c,
+	0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
+	0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x3c, 0x0a, 0x13, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8e, 0x01,
+	0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x38,
+	0x0a, 0x0f, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x29,
+	0x0a, 0x27, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x9a, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xea, 0x02, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x12, 0x48,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x4f,
+	0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
+	0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x47, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x38, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x54, 0x69, 0x70, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x6f, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2b, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x13, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x12, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x14, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xb0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x04, 0x62, 0x61,
+	0x6e, 0x73, 0x1a, 0x6e, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x56, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x22, 0x4a, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05,
+	0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x05, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22,
+	0x47, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b,
+	0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x3e, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x65, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a,
+	0x1b, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x75, 0x6d,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x6f,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x1c,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x22, 0x3c, 0x0a, 0x1d, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x73, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x49,
+	0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1d, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7a, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x45, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xac, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x3e, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49,
+	0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x10, 0x46, 0x6f, 0x72,
+	0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x04, 0x66, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x04, 0x66, 0x6f, 0x72, 0x6b, 0x22, 0xd9, 0x01, 0x0a, 0x13,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x65, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x58, 0x5f,
+	0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x58, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x58, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x58, 0x5f, 0x45, 0x56, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x15, 0x0a, 0x11, 0x54, 0x58, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x22, 0x80, 0x03, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62,
+	0x79, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c,
+	0x6f, 0x63, 0x6b, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x22, 0x2e, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x22, 0x7c, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x74, 0x78, 0x69, 0x64,
+	0x73, 0x5f, 0x6f, 0x72, 0x5f, 0x74, 0x78, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x74, 0x78, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x5f, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x6e, 0x75, 0x6d, 0x54, 0x78, 0x73, 0x22, 0xa5, 0x03, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x75, 0x6e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x13, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xb3,
+	0x01, 0x0a, 0x04, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x6c, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x80, 0x02, 0x0a,
+	0x0c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x74, 0x78,
+	0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x08, 0x74, 0x78, 0x6f,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x8d, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0x88, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x04, 0x50,
+	0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x22, 0x87,
+	0x03, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e,
+	0x65, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49,
+	0x4f, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x49, 0x4f, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0xc8, 0x01,
+	0x0a, 0x02, 0x49, 0x4f, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x54, 0x78,
+	0x49, 0x4f, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6f, 0x12, 0x3c, 0x0a, 0x07, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x49, 0x4f, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52,
+	0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x1a, 0x38, 0x0a, 0x04, 0x54, 0x78, 0x49, 0x4f,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x09, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x42, 0x09, 0x0a,
+	0x07, 0x69, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe6, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x33, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x74, 0x61, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x1a, 0x5e, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x54, 0x69, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0xc4, 0x02, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x22,
+	0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x2e, 0x54, 0x69, 0x70, 0x52,
+	0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x68, 0x6f, 0x73,
+	0x65, 0x6e, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x6f, 0x0a, 0x03, 0x54,
+	0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x32, 0xce, 0x0d, 0x0a,
+	0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
+	0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x11,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xe1, 0x02,
+	0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0x92, 0x14, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53,
+	0x65, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x53, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70,
+	0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x53, 0x70,
+	0x65, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69,
+	0x72, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x56, 0x69, 0x65, 0x77, 0x4b,
+	0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73,
+	0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0d, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x21, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x61, 0x77, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x61, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x77, 0x65, 0x65,
+	0x70, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x12, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x32, 0xda, 0x12, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4e, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x6f, 0x72, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6f,
+	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x65, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50,
+	0x65, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x64, 0x64,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69, 0x6c,
+	0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x4b, 0x69,
+	0x6c, 0x6f, 0x62, 0x79, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53,
+	0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x53,
+	0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x64, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
+	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x73,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x73, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2d, 0x69, 0x6c, 0x6c, 0x69, 0x75, 0x6d,
+	0x2f, 0x69, 0x6c, 0x78, 0x64, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ilxrpc_proto_rawDescOnce sync.Once
+	file_ilxrpc_proto_rawDescData = file_ilxrpc_proto_rawDesc
+)
+
+func file_ilxrpc_proto_rawDescGZIP() []byte {
+	file_ilxrpc_proto_rawDescOnce.Do(func() {
+		file_ilxrpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_ilxrpc_proto_rawDescData)
+	})
+	return file_ilxrpc_proto_rawDescData
+}
+
+var file_ilxrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_ilxrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 205)
+var file_ilxrpc_proto_goTypes = []interface{}{
+	(GetBlockchainInfoResponse_Network)(0),          // 0: pb.GetBlockchainInfoResponse.Network
+	(TestTransactionResponse_ErrorType)(0),          // 1: pb.TestTransactionResponse.ErrorType
+	(SetLogLevelRequest_Level)(0),                   // 2: pb.SetLogLevelRequest.Level
+	(FinalityNotification_Event)(0),                 // 3: pb.FinalityNotification.Event
+	(MempoolNotification_Event)(0),                  // 4: pb.MempoolNotification.Event
+	(MempoolEntry_Status)(0),                        // 5: pb.MempoolEntry.Status
+	(ChainTip_Status)(0),                            // 6: pb.ChainTip.Status
+	(*GetMempoolInfoRequest)(nil),                   // 7: pb.GetMempoolInfoRequest
+	(*GetMempoolInfoResponse)(nil),                  // 8: pb.GetMempoolInfoResponse
+	(*GetMempoolRequest)(nil),                       // 9: pb.GetMempoolRequest
+	(*GetMempoolResponse)(nil),                      // 10: pb.GetMempoolResponse
+	(*GetMempoolEntryRequest)(nil),                  // 11: pb.GetMempoolEntryRequest
+	(*GetMempoolEntryResponse)(nil),                 // 12: pb.GetMempoolEntryResponse
+	(*EstimateFeeRequest)(nil),                      // 13: pb.EstimateFeeRequest
+	(*EstimateFeeResponse)(nil),                     // 14: pb.EstimateFeeResponse
+	(*GetBlockchainInfoRequest)(nil),                // 15: pb.GetBlockchainInfoRequest
+	(*GetBlockchainInfoResponse)(nil),               // 16: pb.GetBlockchainInfoResponse
+	(*GetBlockInfoRequest)(nil),                     // 17: pb.GetBlockInfoRequest
+	(*GetBlockInfoResponse)(nil),                    // 18: pb.GetBlockInfoResponse
+	(*GetBlockRequest)(nil),                         // 19: pb.GetBlockRequest
+	(*GetBlockResponse)(nil),                        // 20: pb.GetBlockResponse
+	(*GetCompressedBlockRequest)(nil),               // 21: pb.GetCompressedBlockRequest
+	(*GetCompressedBlockResponse)(nil),              // 22: pb.GetCompressedBlockResponse
+	(*GetHeadersRequest)(nil),                       // 23: pb.GetHeadersRequest
+	(*GetHeadersResponse)(nil),                      // 24: pb.GetHeadersResponse
+	(*GetCompressedBlocksRequest)(nil),              // 25: pb.GetCompressedBlocksRequest
+	(*GetCompressedBlocksResponse)(nil),             // 26: pb.GetCompressedBlocksResponse
+	(*GetTransactionRequest)(nil),                   // 27: pb.GetTransactionRequest
+	(*GetTransactionResponse)(nil),                  // 28: pb.GetTransactionResponse
+	(*GetMerkleProofRequest)(nil),                   // 29: pb.GetMerkleProofRequest
+	(*GetMerkleProofResponse)(nil),                  // 30: pb.GetMerkleProofResponse
+	(*GetValidatorRequest)(nil),                     // 31: pb.GetValidatorRequest
+	(*GetValidatorResponse)(nil),                    // 32: pb.GetValidatorResponse
+	(*GetValidatorSetInfoRequest)(nil),              // 33: pb.GetValidatorSetInfoRequest
+	(*GetValidatorSetInfoResponse)(nil),             // 34: pb.GetValidatorSetInfoResponse
+	(*GetValidatorSetRequest)(nil),                  // 35: pb.GetValidatorSetRequest
+	(*GetValidatorSetResponse)(nil),                 // 36: pb.GetValidatorSetResponse
+	(*GetAccumulatorCheckpointRequest)(nil),         // 37: pb.GetAccumulatorCheckpointRequest
+	(*GetAccumulatorCheckpointResponse)(nil),        // 38: pb.GetAccumulatorCheckpointResponse
+	(*SubmitTransactionRequest)(nil),                // 39: pb.SubmitTransactionRequest
+	(*SubmitTransactionResponse)(nil),               // 40: pb.SubmitTransactionResponse
+	(*TestTransactionRequest)(nil),                  // 41: pb.TestTransactionRequest
+	(*TestTransactionResponse)(nil),                 // 42: pb.TestTransactionResponse
+	(*SubscribeBlocksRequest)(nil),                  // 43: pb.SubscribeBlocksRequest
+	(*SubscribeCompressedBlocksRequest)(nil),        // 44: pb.SubscribeCompressedBlocksRequest
+	(*SubscribeFinalityRequest)(nil),                // 45: pb.SubscribeFinalityRequest
+	(*RegisterViewKeyRequest)(nil),                  // 46: pb.RegisterViewKeyRequest
+	(*RegisterViewKeyResponse)(nil),                 // 47: pb.RegisterViewKeyResponse
+	(*SubscribeTransactionsRequest)(nil),            // 48: pb.SubscribeTransactionsRequest
+	(*GetWalletTransactionsRequest)(nil),            // 49: pb.GetWalletTransactionsRequest
+	(*GetWalletTransactionsResponse)(nil),           // 50: pb.GetWalletTransactionsResponse
+	(*GetTxoProofRequest)(nil),                      // 51: pb.GetTxoProofRequest
+	(*GetTxoProofResponse)(nil),                     // 52: pb.GetTxoProofResponse
+	(*GetBalanceRequest)(nil),                       // 53: pb.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                      // 54: pb.GetBalanceResponse
+	(*GetWalletSeedRequest)(nil),                    // 55: pb.GetWalletSeedRequest
+	(*GetWalletSeedResponse)(nil),                   // 56: pb.GetWalletSeedResponse
+	(*GetAddressRequest)(nil),                       // 57: pb.GetAddressRequest
+	(*GetAddressResponse)(nil),                      // 58: pb.GetAddressResponse
+	(*GetTimelockedAddressRequest)(nil),             // 59: pb.GetTimelockedAddressRequest
+	(*GetTimelockedAddressResponse)(nil),            // 60: pb.GetTimelockedAddressResponse
+	(*GetAddressesRequest)(nil),                     // 61: pb.GetAddressesRequest
+	(*GetAddressesResponse)(nil),                    // 62: pb.GetAddressesResponse
+	(*GetAddressInfoRequest)(nil),                   // 63: pb.GetAddressInfoRequest
+	(*GetAddressInfoResponse)(nil),                  // 64: pb.GetAddressInfoResponse
+	(*GetNewAddressRequest)(nil),                    // 65: pb.GetNewAddressRequest
+	(*GetNewAddressResponse)(nil),                   // 66: pb.GetNewAddressResponse
+	(*GetTransactionsRequest)(nil),                  // 67: pb.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),                 // 68: pb.GetTransactionsResponse
+	(*GetUtxosRequest)(nil),                         // 69: pb.GetUtxosRequest
+	(*GetUtxosResponse)(nil),                        // 70: pb.GetUtxosResponse
+	(*GetPrivateKeyRequest)(nil),                    // 71: pb.GetPrivateKeyRequest
+	(*GetPrivateKeyResponse)(nil),                   // 72: pb.GetPrivateKeyResponse
+	(*ImportAddressRequest)(nil),                    // 73: pb.ImportAddressRequest
+	(*ImportAddressResponse)(nil),                   // 74: pb.ImportAddressResponse
+	(*CreateMultisigSpendKeypairRequest)(nil),       // 75: pb.CreateMultisigSpendKeypairRequest
+	(*CreateMultisigSpendKeypairResponse)(nil),      // 76: pb.CreateMultisigSpendKeypairResponse
+	(*CreateMultisigViewKeypairRequest)(nil),        // 77: pb.CreateMultisigViewKeypairRequest
+	(*CreateMultisigViewKeypairResponse)(nil),       // 78: pb.CreateMultisigViewKeypairResponse
+	(*CreateMultisigAddressRequest)(nil),            // 79: pb.CreateMultisigAddressRequest
+	(*CreateMultisigAddressResponse)(nil),           // 80: pb.CreateMultisigAddressResponse
+	(*CreateMultiSignatureRequest)(nil),             // 81: pb.CreateMultiSignatureRequest
+	(*CreateMultiSignatureResponse)(nil),            // 82: pb.CreateMultiSignatureResponse
+	(*ProveMultisigRequest)(nil),                    // 83: pb.ProveMultisigRequest
+	(*ProveMultisigResponse)(nil),                   // 84: pb.ProveMultisigResponse
+	(*WalletLockRequest)(nil),                       // 85: pb.WalletLockRequest
+	(*WalletLockResponse)(nil),                      // 86: pb.WalletLockResponse
+	(*WalletUnlockRequest)(nil),                     // 87: pb.WalletUnlockRequest
+	(*WalletUnlockResponse)(nil),                    // 88: pb.WalletUnlockResponse
+	(*SetWalletPassphraseRequest)(nil),              // 89: pb.SetWalletPassphraseRequest
+	(*SetWalletPassphraseResponse)(nil),             // 90: pb.SetWalletPassphraseResponse
+	(*ChangeWalletPassphraseRequest)(nil),           // 91: pb.ChangeWalletPassphraseRequest
+	(*ChangeWalletPassphraseResponse)(nil),          // 92: pb.ChangeWalletPassphraseResponse
+	(*DeletePrivateKeysRequest)(nil),                // 93: pb.DeletePrivateKeysRequest
+	(*DeletePrivateKeysResponse)(nil),               // 94: pb.DeletePrivateKeysResponse
+	(*CreateRawTransactionRequest)(nil),             // 95: pb.CreateRawTransactionRequest
+	(*CreateRawTransactionResponse)(nil),            // 96: pb.CreateRawTransactionResponse
+	(*CreateRawStakeTransactionRequest)(nil),        // 97: pb.CreateRawStakeTransactionRequest
+	(*CreateRawStakeTransactionResponse)(nil),       // 98: pb.CreateRawStakeTransactionResponse
+	(*ProveRawTransactionRequest)(nil),              // 99: pb.ProveRawTransactionRequest
+	(*ProveRawTransactionResponse)(nil),             // 100: pb.ProveRawTransactionResponse
+	(*StakeRequest)(nil),                            // 101: pb.StakeRequest
+	(*StakeResponse)(nil),                           // 102: pb.StakeResponse
+	(*SetAutoStakeRewardsRequest)(nil),              // 103: pb.SetAutoStakeRewardsRequest
+	(*SetAutoStakeRewardsResponse)(nil),             // 104: pb.SetAutoStakeRewardsResponse
+	(*SpendRequest)(nil),                            // 105: pb.SpendRequest
+	(*SpendResponse)(nil),                           // 106: pb.SpendResponse
+	(*TimelockCoinsRequest)(nil),                    // 107: pb.TimelockCoinsRequest
+	(*TimelockCoinsResponse)(nil),                   // 108: pb.TimelockCoinsResponse
+	(*SweepWalletRequest)(nil),                      // 109: pb.SweepWalletRequest
+	(*SweepWalletResponse)(nil),                     // 110: pb.SweepWalletResponse
+	(*BumpFeeRequest)(nil),                          // 111: pb.BumpFeeRequest
+	(*BumpFeeResponse)(nil),                         // 112: pb.BumpFeeResponse
+	(*SubscribeWalletTransactionsRequest)(nil),      // 113: pb.SubscribeWalletTransactionsRequest
+	(*SubscribeWalletSyncNotificationsRequest)(nil), // 114: pb.SubscribeWalletSyncNotificationsRequest
+	(*GetHostInfoRequest)(nil),                      // 115: pb.GetHostInfoRequest
+	(*GetHostInfoResponse)(nil),                     // 116: pb.GetHostInfoResponse
+	(*GetNetworkKeyRequest)(nil),                    // 117: pb.GetNetworkKeyRequest
+	(*GetNetworkKeyResponse)(nil),                   // 118: pb.GetNetworkKeyResponse
+	(*GetPeersRequest)(nil),                         // 119: pb.GetPeersRequest
+	(*GetPeersResponse)(nil),                        // 120: pb.GetPeersResponse
+	(*GetNetTotalsRequest)(nil),                     // 121: pb.GetNetTotalsRequest
+	(*GetNetTotalsResponse)(nil),                    // 122: pb.GetNetTotalsResponse
+	(*GetSyncStatusRequest)(nil),                    // 123: pb.GetSyncStatusRequest
+	(*GetSyncStatusResponse)(nil),                   // 124: pb.GetSyncStatusResponse
+	(*SubscribeSyncStatusRequest)(nil),              // 125: pb.SubscribeSyncStatusRequest
+	(*GetChainTipsRequest)(nil),                     // 126: pb.GetChainTipsRequest
+	(*GetChainTipsResponse)(nil),                    // 127: pb.GetChainTipsResponse
+	(*GetForksRequest)(nil),                         // 128: pb.GetForksRequest
+	(*GetForksResponse)(nil),                        // 129: pb.GetForksResponse
+	(*SubscribeForksRequest)(nil),                   // 130: pb.SubscribeForksRequest
+	(*SubscribeMempoolRequest)(nil),                 // 131: pb.SubscribeMempoolRequest
+	(*AddPeerRequest)(nil),                          // 132: pb.AddPeerRequest
+	(*AddPeerResponse)(nil),                         // 133: pb.AddPeerResponse
+	(*BlockPeerRequest)(nil),                        // 134: pb.BlockPeerRequest
+	(*BlockPeerResponse)(nil),                       // 135: pb.BlockPeerResponse
+	(*UnblockPeerRequest)(nil),                      // 136: pb.UnblockPeerRequest
+	(*UnblockPeerResponse)(nil),                     // 137: pb.UnblockPeerResponse
+	(*BlockSubnetRequest)(nil),                      // 138: pb.BlockSubnetRequest
+	(*BlockSubnetResponse)(nil),                     // 139: pb.BlockSubnetResponse
+	(*UnblockSubnetRequest)(nil),                    // 140: pb.UnblockSubnetRequest
+	(*UnblockSubnetResponse)(nil),                   // 141: pb.UnblockSubnetResponse
+	(*ListBansRequest)(nil),                         // 142: pb.ListBansRequest
+	(*ListBansResponse)(nil),                        // 143: pb.ListBansResponse
+	(*AddAllowlistRequest)(nil),                     // 144: pb.AddAllowlistRequest
+	(*AddAllowlistResponse)(nil),                    // 145: pb.AddAllowlistResponse
+	(*RemoveAllowlistRequest)(nil),                  // 146: pb.RemoveAllowlistRequest
+	(*RemoveAllowlistResponse)(nil),                 // 147: pb.RemoveAllowlistResponse
+	(*ListAllowlistRequest)(nil),                    // 148: pb.ListAllowlistRequest
+	(*ListAllowlistResponse)(nil),                   // 149: pb.ListAllowlistResponse
+	(*SetLogLevelRequest)(nil),                      // 150: pb.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),                     // 151: pb.SetLogLevelResponse
+	(*GetMinFeePerKilobyteRequest)(nil),             // 152: pb.GetMinFeePerKilobyteRequest
+	(*GetMinFeePerKilobyteResponse)(nil),            // 153: pb.GetMinFeePerKilobyteResponse
+	(*SetMinFeePerKilobyteRequest)(nil),             // 154: pb.SetMinFeePerKilobyteRequest
+	(*SetMinFeePerKilobyteResponse)(nil),            // 155: pb.SetMinFeePerKilobyteResponse
+	(*GetMinStakeRequest)(nil),                      // 156: pb.GetMinStakeRequest
+	(*GetMinStakeResponse)(nil),                     // 157: pb.GetMinStakeResponse
+	(*SetMinStakeRequest)(nil),                      // 158: pb.SetMinStakeRequest
+	(*SetMinStakeResponse)(nil),                     // 159: pb.SetMinStakeResponse
+	(*GetBlockSizeSoftLimitRequest)(nil),            // 160: pb.GetBlockSizeSoftLimitRequest
+	(*GetBlockSizeSoftLimitResponse)(nil),           // 161: pb.GetBlockSizeSoftLimitResponse
+	(*SetBlockSizeSoftLimitRequest)(nil),            // 162: pb.SetBlockSizeSoftLimitRequest
+	(*SetBlockSizeSoftLimitResponse)(nil),           // 163: pb.SetBlockSizeSoftLimitResponse
+	(*GetTreasuryWhitelistRequest)(nil),             // 164: pb.GetTreasuryWhitelistRequest
+	(*GetTreasuryWhitelistResponse)(nil),            // 165: pb.GetTreasuryWhitelistResponse
+	(*UpdateTreasuryWhitelistRequest)(nil),          // 166: pb.UpdateTreasuryWhitelistRequest
+	(*UpdateTreasuryWhitelistResponse)(nil),         // 167: pb.UpdateTreasuryWhitelistResponse
+	(*ReconsiderBlockRequest)(nil),                  // 168: pb.ReconsiderBlockRequest
+	(*ReconsiderBlockResponse)(nil),                 // 169: pb.ReconsiderBlockResponse
+	(*RecomputeChainStateRequest)(nil),              // 170: pb.RecomputeChainStateRequest
+	(*RecomputeChainStateResponse)(nil),             // 171: pb.RecomputeChainStateResponse
+	(*SaveMempoolRequest)(nil),                      // 172: pb.SaveMempoolRequest
+	(*SaveMempoolResponse)(nil),                     // 173: pb.SaveMempoolResponse
+	(*LoadMempoolRequest)(nil),                      // 174: pb.LoadMempoolRequest
+	(*LoadMempoolResponse)(nil),                     // 175: pb.LoadMempoolResponse
+	(*PrioritiseTransactionRequest)(nil),            // 176: pb.PrioritiseTransactionRequest
+	(*PrioritiseTransactionResponse)(nil),           // 177: pb.PrioritiseTransactionResponse
+	(*TransactionNotification)(nil),                 // 178: pb.TransactionNotification
+	(*WalletTransactionNotification)(nil),           // 179: pb.WalletTransactionNotification
+	(*WalletSyncNotification)(nil),                  // 180: pb.WalletSyncNotification
+	(*BlockNotification)(nil),                       // 181: pb.BlockNotification
+	(*CompressedBlockNotification)(nil),             // 182: pb.CompressedBlockNotification
+	(*FinalityNotification)(nil),                    // 183: pb.FinalityNotification
+	(*SyncStatusNotification)(nil),                  // 184: pb.SyncStatusNotification
+	(*ForkNotification)(nil),                        // 185: pb.ForkNotification
+	(*MempoolNotification)(nil),                     // 186: pb.MempoolNotification
+	(*MempoolEntry)(nil),                            // 187: pb.MempoolEntry
+	(*TransactionData)(nil),                         // 188: pb.TransactionData
+	(*BlockInfo)(nil),                               // 189: pb.BlockInfo
+	(*Validator)(nil),                               // 190: pb.Validator
+	(*Utxo)(nil),                                    // 191: pb.Utxo
+	(*RawTransaction)(nil),                          // 192: pb.RawTransaction
+	(*PrivateInput)(nil),                            // 193: pb.PrivateInput
+	(*PrivateOutput)(nil),                           // 194: pb.PrivateOutput
+	(*TxoProof)(nil),                                // 195: pb.TxoProof
+	(*Peer)(nil),                                    // 196: pb.Peer
+	(*WalletTransaction)(nil),                       // 197: pb.WalletTransaction
+	(*SyncStatus)(nil),                              // 198: pb.SyncStatus
+	(*ChainTip)(nil),                                // 199: pb.ChainTip
+	(*Fork)(nil),                                    // 200: pb.Fork
+	(*CreateRawTransactionRequest_Input)(nil),       // 201: pb.CreateRawTransactionRequest.Input
+	(*CreateRawTransactionRequest_Output)(nil),      // 202: pb.CreateRawTransactionRequest.Output
+	(*CreateRawStakeTransactionRequest_Input)(nil),  // 203: pb.CreateRawStakeTransactionRequest.Input
+	(*GetNetTotalsResponse_ProtocolBandwidth)(nil),  // 204: pb.GetNetTotalsResponse.ProtocolBandwidth
+	(*ListBansResponse_Ban)(nil),                    // 205: pb.ListBansResponse.Ban
+	(*Validator_Stake)(nil),                         // 206: pb.Validator.Stake
+	(*WalletTransaction_IO)(nil),                    // 207: pb.WalletTransaction.IO
+	(*WalletTransaction_IO_TxIO)(nil),               // 208: pb.WalletTransaction.IO.TxIO
+	(*WalletTransaction_IO_Unknown)(nil),            // 209: pb.WalletTransaction.IO.Unknown
+	(*SyncStatus_PeerBucket)(nil),                   // 210: pb.SyncStatus.PeerBucket
+	(*Fork_Tip)(nil),                                // 211: pb.Fork.Tip
+	(*blocks.Block)(nil),                            // 212: Block
+	(*blocks.CompressedBlock)(nil),                  // 213: CompressedBlock
+	(*blocks.BlockHeader)(nil),                      // 214: BlockHeader
+	(*transactions.Transaction)(nil),                // 215: Transaction
+}
+var file_ilxrpc_proto_depIdxs = []int32{
+	188, // 0: pb.GetMempoolResponse.transaction_data:type_name -> pb.TransactionData
+	187, // 1: pb.GetMempoolEntryResponse.entry:type_name -> pb.MempoolEntry
+	0,   // 2: pb.GetBlockchainInfoResponse.network:type_name -> pb.GetBlockchainInfoResponse.Network
+	189, // 3: pb.GetBlockInfoResponse.info:type_name -> pb.BlockInfo
+	212, // 4: pb.GetBlockResponse.block:type_name -> Block
+	213, // 5: pb.GetCompressedBlockResponse.block:type_name -> CompressedBlock
+	214, // 6: pb.GetHeadersResponse.headers:type_name -> BlockHeader
+	213, // 7: pb.GetCompressedBlocksResponse.blocks:type_name -> CompressedBlock
+	215, // 8: pb.GetTransactionResponse.tx:type_name -> Transaction
+	189, // 9: pb.GetMerkleProofResponse.block:type_name -> pb.BlockInfo
+	190, // 10: pb.GetValidatorResponse.validator:type_name -> pb.Validator
+	190, // 11: pb.GetValidatorSetResponse.validators:type_name -> pb.Validator
+	215, // 12: pb.SubmitTransactionRequest.transaction:type_name -> Transaction
+	215, // 13: pb.TestTransactionRequest.transaction:type_name -> Transaction
+	1,   // 14: pb.TestTransactionResponse.error_type:type_name -> pb.TestTransactionResponse.ErrorType
+	215, // 15: pb.GetWalletTransactionsResponse.transactions:type_name -> Transaction
+	195, // 16: pb.GetTxoProofResponse.proofs:type_name -> pb.TxoProof
+	197, // 17: pb.GetTransactionsResponse.txs:type_name -> pb.WalletTransaction
+	191, // 18: pb.GetUtxosResponse.utxos:type_name -> pb.Utxo
+	215, // 19: pb.CreateMultiSignatureRequest.tx:type_name -> Transaction
+	192, // 20: pb.ProveMultisigRequest.raw_tx:type_name -> pb.RawTransaction
+	215, // 21: pb.ProveMultisigResponse.proved_tx:type_name -> Transaction
+	201, // 22: pb.CreateRawTransactionRequest.inputs:type_name -> pb.CreateRawTransactionRequest.Input
+	202, // 23: pb.CreateRawTransactionRequest.outputs:type_name -> pb.CreateRawTransactionRequest.Output
+	192, // 24: pb.CreateRawTransactionResponse.raw_tx:type_name -> pb.RawTransaction
+	203, // 25: pb.CreateRawStakeTransactionRequest.input:type_name -> pb.CreateRawStakeTransactionRequest.Input
+	192, // 26: pb.CreateRawStakeTransactionResponse.raw_tx:type_name -> pb.RawTransaction
+	192, // 27: pb.ProveRawTransactionRequest.raw_tx:type_name -> pb.RawTransaction
+	215, // 28: pb.ProveRawTransactionResponse.proved_tx:type_name -> Transaction
+	196, // 29: pb.GetPeersResponse.peers:type_name -> pb.Peer
+	204, // 30: pb.GetNetTotalsResponse.protocols:type_name -> pb.GetNetTotalsResponse.ProtocolBandwidth
+	198, // 31: pb.GetSyncStatusResponse.status:type_name -> pb.SyncStatus
+	199, // 32: pb.GetChainTipsResponse.tips:type_name -> pb.ChainTip
+	200, // 33: pb.GetForksResponse.forks:type_name -> pb.Fork
+	205, // 34: pb.ListBansResponse.bans:type_name -> pb.ListBansResponse.Ban
+	2,   // 35: pb.SetLogLevelRequest.level:type_name -> pb.SetLogLevelRequest.Level
+	215, // 36: pb.TransactionNotification.transaction:type_name -> Transaction
+	197, // 37: pb.WalletTransactionNotification.transaction:type_name -> pb.WalletTransaction
+	189, // 38: pb.BlockNotification.block_info:type_name -> pb.BlockInfo
+	188, // 39: pb.BlockNotification.transactions:type_name -> pb.TransactionData
+	213, // 40: pb.CompressedBlockNotification.block:type_name -> CompressedBlock
+	3,   // 41: pb.FinalityNotification.event:type_name -> pb.FinalityNotification.Event
+	198, // 42: pb.SyncStatusNotification.status:type_name -> pb.SyncStatus
+	200, // 43: pb.ForkNotification.fork:type_name -> pb.Fork
+	4,   // 44: pb.MempoolNotification.event:type_name -> pb.MempoolNotification.Event
+	187, // 45: pb.MempoolNotification.entry:type_name -> pb.MempoolEntry
+	5,   // 46: pb.MempoolEntry.status:type_name -> pb.MempoolEntry.Status
+	215, // 47: pb.TransactionData.transaction:type_name -> Transaction
+	206, // 48: pb.Validator.stake:type_name -> pb.Validator.Stake
+	215, // 49: pb.RawTransaction.tx:type_name -> Transaction
+	193, // 50: pb.RawTransaction.inputs:type_name -> pb.PrivateInput
+	194, // 51: pb.RawTransaction.outputs:type_name -> pb.PrivateOutput
+	195, // 52: pb.PrivateInput.txo_proof:type_name -> pb.TxoProof
+	207, // 53: pb.WalletTransaction.inputs:type_name -> pb.WalletTransaction.IO
+	207, // 54: pb.WalletTransaction.outputs:type_name -> pb.WalletTransaction.IO
+	210, // 55: pb.SyncStatus.buckets:type_name -> pb.SyncStatus.PeerBucket
+	6,   // 56: pb.ChainTip.status:type_name -> pb.ChainTip.Status
+	211, // 57: pb.Fork.tips:type_name -> pb.Fork.Tip
+	193, // 58: pb.CreateRawTransactionRequest.Input.input:type_name -> pb.PrivateInput
+	193, // 59: pb.CreateRawStakeTransactionRequest.Input.input:type_name -> pb.PrivateInput
+	208, // 60: pb.WalletTransaction.IO.tx_io:type_name -> pb.WalletTransaction.IO.TxIO
+	209, // 61: pb.WalletTransaction.IO.unknown:type_name -> pb.WalletTransaction.IO.Unknown
+	7,   // 62: pb.BlockchainService.GetMempoolInfo:input_type -> pb.GetMempoolInfoRequest
+	9,   // 63: pb.BlockchainService.GetMempool:input_type -> pb.GetMempoolRequest
+	11,  // 64: pb.BlockchainService.GetMempoolEntry:input_type -> pb.GetMempoolEntryRequest
+	13,  // 65: pb.BlockchainService.EstimateFee:input_type -> pb.EstimateFeeRequest
+	15,  // 66: pb.BlockchainService.GetBlockchainInfo:input_type -> pb.GetBlockchainInfoRequest
+	17,  // 67: pb.BlockchainService.GetBlockInfo:input_type -> pb.GetBlockInfoRequest
+	19,  // 68: pb.BlockchainService.GetBlock:input_type -> pb.GetBlockRequest
+	21,  // 69: pb.BlockchainService.GetCompressedBlock:input_type -> pb.GetCompressedBlockRequest
+	23,  // 70: pb.BlockchainService.GetHeaders:input_type -> pb.GetHeadersRequest
+	25,  // 71: pb.BlockchainService.GetCompressedBlocks:input_type -> pb.GetCompressedBlocksRequest
+	27,  // 72: pb.BlockchainService.GetTransaction:input_type -> pb.GetTransactionRequest
+	29,  // 73: pb.BlockchainService.GetMerkleProof:input_type -> pb.GetMerkleProofRequest
+	31,  // 74: pb.BlockchainService.GetValidator:input_type -> pb.GetValidatorRequest
+	33,  // 75: pb.BlockchainService.GetValidatorSetInfo:input_type -> pb.GetValidatorSetInfoRequest
+	35,  // 76: pb.BlockchainService.GetValidatorSet:input_type -> pb.GetValidatorSetRequest
+	37,  // 77: pb.BlockchainService.GetAccumulatorCheckpoint:input_type -> pb.GetAccumulatorCheckpointRequest
+	39,  // 78: pb.BlockchainService.SubmitTransaction:input_type -> pb.SubmitTransactionRequest
+	41,  // 79: pb.BlockchainService.TestTransaction:input_type -> pb.TestTransactionRequest
+	43,  // 80: pb.BlockchainService.SubscribeBlocks:input_type -> pb.SubscribeBlocksRequest
+	131, // 81: pb.BlockchainService.SubscribeMempool:input_type -> pb.SubscribeMempoolRequest
+	44,  // 82: pb.BlockchainService.SubscribeCompressedBlocks:input_type -> pb.SubscribeCompressedBlocksRequest
+	45,  // 83: pb.BlockchainService.SubscribeFinality:input_type -> pb.SubscribeFinalityRequest
+	46,  // 84: pb.WalletServerService.RegisterViewKey:input_type -> pb.RegisterViewKeyRequest
+	48,  // 85: pb.WalletServerService.SubscribeTransactions:input_type -> pb.SubscribeTransactionsRequest
+	49,  // 86: pb.WalletServerService.GetWalletTransactions:input_type -> pb.GetWalletTransactionsRequest
+	51,  // 87: pb.WalletServerService.GetTxoProof:input_type -> pb.GetTxoProofRequest
+	53,  // 88: pb.WalletService.GetBalance:input_type -> pb.GetBalanceRequest
+	55,  // 89: pb.WalletService.GetWalletSeed:input_type -> pb.GetWalletSeedRequest
+	57,  // 90: pb.WalletService.GetAddress:input_type -> pb.GetAddressRequest
+	59,  // 91: pb.WalletService.GetTimelockedAddress:input_type -> pb.GetTimelockedAddressRequest
+	61,  // 92: pb.WalletService.GetAddresses:input_type -> pb.GetAddressesRequest
+	63,  // 93: pb.WalletService.GetAddressInfo:input_type -> pb.GetAddressInfoRequest
+	65,  // 94: pb.WalletService.GetNewAddress:input_type -> pb.GetNewAddressRequest
+	67,  // 95: pb.WalletService.GetTransactions:input_type -> pb.GetTransactionsRequest
+	69,  // 96: pb.WalletService.GetUtxos:input_type -> pb.GetUtxosRequest
+	71,  // 97: pb.WalletService.GetPrivateKey:input_type -> pb.GetPrivateKeyRequest
+	73,  // 98: pb.WalletService.ImportAddress:input_type -> pb.ImportAddressRequest
+	75,  // 99: pb.WalletService.CreateMultisigSpendKeypair:input_type -> pb.CreateMultisigSpendKeypairRequest
+	77,  // 100: pb.WalletService.CreateMultisigViewKeypair:input_type -> pb.CreateMultisigViewKeypairRequest
+	79,  // 101: pb.WalletService.CreateMultisigAddress:input_type -> pb.CreateMultisigAddressRequest
+	81,  // 102: pb.WalletService.CreateMultiSignature:input_type -> pb.CreateMultiSignatureRequest
+	83,  // 103: pb.WalletService.ProveMultisig:input_type -> pb.ProveMultisigRequest
+	85,  // 104: pb.WalletService.WalletLock:input_type -> pb.WalletLockRequest
+	87,  // 105: pb.WalletService.WalletUnlock:input_type -> pb.WalletUnlockRequest
+	89,  // 106: pb.WalletService.SetWalletPassphrase:input_type -> pb.SetWalletPassphraseRequest
+	91,  // 107: pb.WalletService.ChangeWalletPassphrase:input_type -> pb.ChangeWalletPassphraseRequest
+	93,  // 108: pb.WalletService.DeletePrivateKeys:input_type -> pb.DeletePrivateKeysRequest
+	95,  // 109: pb.WalletService.CreateRawTransaction:input_type -> pb.CreateRawTransactionRequest
+	97,  // 110: pb.WalletService.CreateRawStakeTransaction:input_type -> pb.CreateRawStakeTransactionRequest
+	99,  // 111: pb.WalletService.ProveRawTransaction:input_type -> pb.ProveRawTransactionRequest
+	101, // 112: pb.WalletService.Stake:input_type -> pb.StakeRequest
+	103, // 113: pb.WalletService.SetAutoStakeRewards:input_type -> pb.SetAutoStakeRewardsRequest
+	105, // 114: pb.WalletService.Spend:input_type -> pb.SpendRequest
+	107, // 115: pb.WalletService.TimelockCoins:input_type -> pb.TimelockCoinsRequest
+	109, // 116: pb.WalletService.SweepWallet:input_type -> pb.SweepWalletRequest
+	111, // 117: pb.WalletService.BumpFee:input_type -> pb.BumpFeeRequest
+	113, // 118: pb.WalletService.SubscribeWalletTransactions:input_type -> pb.SubscribeWalletTransactionsRequest
+	114, // 119: pb.WalletService.SubscribeWalletSyncNotifications:input_type -> pb.SubscribeWalletSyncNotificationsRequest
+	115, // 120: pb.NodeService.GetHostInfo:input_type -> pb.GetHostInfoRequest
+	117, // 121: pb.NodeService.GetNetworkKey:input_type -> pb.GetNetworkKeyRequest
+	119, // 122: pb.NodeService.GetPeers:input_type -> pb.GetPeersRequest
+	121, // 123: pb.NodeService.GetNetTotals:input_type -> pb.GetNetTotalsRequest
+	123, // 124: pb.NodeService.GetSyncStatus:input_type -> pb.GetSyncStatusRequest
+	125, // 125: pb.NodeService.SubscribeSyncStatus:input_type -> pb.SubscribeSyncStatusRequest
+	126, // 126: pb.NodeService.GetChainTips:input_type -> pb.GetChainTipsRequest
+	128, // 127: pb.NodeService.GetForks:input_type -> pb.GetForksRequest
+	130, // 128: pb.NodeService.SubscribeForks:input_type -> pb.SubscribeForksRequest
+	132, // 129: pb.NodeService.AddPeer:input_type -> pb.AddPeerRequest
+	134, // 130: pb.NodeService.BlockPeer:input_type -> pb.BlockPeerRequest
+	136, // 131: pb.NodeService.UnblockPeer:input_type -> pb.UnblockPeerRequest
+	138, // 132: pb.NodeService.BlockSubnet:input_type -> pb.BlockSubnetRequest
+	140, // 133: pb.NodeService.UnblockSubnet:input_type -> pb.UnblockSubnetRequest
+	142, // 134: pb.NodeService.ListBans:input_type -> pb.ListBansRequest
+	144, // 135: pb.NodeService.AddAllowlist:input_type -> pb.AddAllowlistRequest
+	146, // 136: pb.NodeService.RemoveAllowlist:input_type -> pb.RemoveAllowlistRequest
+	148, // 137: pb.NodeService.ListAllowlist:input_type -> pb.ListAllowlistRequest
+	150, // 138: pb.NodeService.SetLogLevel:input_type -> pb.SetLogLevelRequest
+	152, // 139: pb.NodeService.GetMinFeePerKilobyte:input_type -> pb.GetMinFeePerKilobyteRequest
+	154, // 140: pb.NodeService.SetMinFeePerKilobyte:input_type -> pb.SetMinFeePerKilobyteRequest
+	156, // 141: pb.NodeService.GetMinStake:input_type -> pb.GetMinStakeRequest
+	158, // 142: pb.NodeService.SetMinStake:input_type -> pb.SetMinStakeRequest
+	160, // 143: pb.NodeService.GetBlockSizeSoftLimit:input_type -> pb.GetBlockSizeSoftLimitRequest
+	162, // 144: pb.NodeService.SetBlockSizeSoftLimit:input_type -> pb.SetBlockSizeSoftLimitRequest
+	164, // 145: pb.NodeService.GetTreasuryWhitelist:input_type -> pb.GetTreasuryWhitelistRequest
+	166, // 146: pb.NodeService.UpdateTreasuryWhitelist:input_type -> pb.UpdateTreasuryWhitelistRequest
+	168, // 147: pb.NodeService.ReconsiderBlock:input_type -> pb.ReconsiderBlockRequest
+	170, // 148: pb.NodeService.RecomputeChainState:input_type -> pb.RecomputeChainStateRequest
+	172, // 149: pb.NodeService.SaveMempool:input_type -> pb.SaveMempoolRequest
+	174, // 150: pb.NodeService.LoadMempool:input_type -> pb.LoadMempoolRequest
+	176, // 151: pb.NodeService.PrioritiseTransaction:input_type -> pb.PrioritiseTransactionRequest
+	8,   // 152: pb.BlockchainService.GetMempoolInfo:output_type -> pb.GetMempoolInfoResponse
+	10,  // 153: pb.BlockchainService.GetMempool:output_type -> pb.GetMempoolResponse
+	12,  // 154: pb.BlockchainService.GetMempoolEntry:output_type -> pb.GetMempoolEntryResponse
+	14,  // 155: pb.BlockchainService.EstimateFee:output_type -> pb.EstimateFeeResponse
+	16,  // 156: pb.BlockchainService.GetBlockchainInfo:output_type -> pb.GetBlockchainInfoResponse
+	18,  // 157: pb.BlockchainService.GetBlockInfo:output_type -> pb.GetBlockInfoResponse
+	20,  // 158: pb.BlockchainService.GetBlock:output_type -> pb.GetBlockResponse
+	22,  // 159: pb.BlockchainService.GetCompressedBlock:output_type -> pb.GetCompressedBlockResponse
+	24,  // 160: pb.BlockchainService.GetHeaders:output_type -> pb.GetHeadersResponse
+	26,  // 161: pb.BlockchainService.GetCompressedBlocks:output_type -> pb.GetCompressedBlocksResponse
+	28,  // 162: pb.BlockchainService.GetTransaction:output_type -> pb.GetTransactionResponse
+	30,  // 163: pb.BlockchainService.GetMerkleProof:output_type -> pb.GetMerkleProofResponse
+	32,  // 164: pb.BlockchainService.GetValidator:output_type -> pb.GetValidatorResponse
+	34,  // 165: pb.BlockchainService.GetValidatorSetInfo:output_type -> pb.GetValidatorSetInfoResponse
+	36,  // 166: pb.BlockchainService.GetValidatorSet:output_type -> pb.GetValidatorSetResponse
+	38,  // 167: pb.BlockchainService.GetAccumulatorCheckpoint:output_type -> pb.GetAccumulatorCheckpointResponse
+	40,  // 168: pb.BlockchainService.SubmitTransaction:output_type -> pb.SubmitTransactionResponse
+	42,  // 169: pb.BlockchainService.TestTransaction:output_type -> pb.TestTransactionResponse
+	181, // 170: pb.BlockchainService.SubscribeBlocks:output_type -> pb.BlockNotification
+	186, // 171: pb.BlockchainService.SubscribeMempool:output_type -> pb.MempoolNotification
+	182, // 172: pb.BlockchainService.SubscribeCompressedBlocks:output_type -> pb.CompressedBlockNotification
+	183, // 173: pb.BlockchainService.SubscribeFinality:output_type -> pb.FinalityNotification
+	47,  // 174: pb.WalletServerService.RegisterViewKey:output_type -> pb.RegisterViewKeyResponse
+	178, // 175: pb.WalletServerService.SubscribeTransactions:output_type -> pb.TransactionNotification
+	50,  // 176: pb.WalletServerService.GetWalletTransactions:output_type -> pb.GetWalletTransactionsResponse
+	52,  // 177: pb.WalletServerService.GetTxoProof:output_type -> pb.GetTxoProofResponse
+	54,  // 178: pb.WalletService.GetBalance:output_type -> pb.GetBalanceResponse
+	56,  // 179: pb.WalletService.GetWalletSeed:output_type -> pb.GetWalletSeedResponse
+	58,  // 180: pb.WalletService.GetAddress:output_type -> pb.GetAddressResponse
+	60,  // 181: pb.WalletService.GetTimelockedAddress:output_type -> pb.GetTimelockedAddressResponse
+	62,  // 182: pb.WalletService.GetAddresses:output_type -> pb.GetAddressesResponse
+	64,  // 183: pb.WalletService.GetAddressInfo:output_type -> pb.GetAddressInfoResponse
+	66,  // 184: pb.WalletService.GetNewAddress:output_type -> pb.GetNewAddressResponse
+	68,  // 185: pb.WalletService.GetTransactions:output_type -> pb.GetTransactionsResponse
+	70,  // 186: pb.WalletService.GetUtxos:output_type -> pb.GetUtxosResponse
+	72,  // 187: pb.WalletService.GetPrivateKey:output_type -> pb.GetPrivateKeyResponse
+	74,  // 188: pb.WalletService.ImportAddress:output_type -> pb.ImportAddressResponse
+	76,  // 189: pb.WalletService.CreateMultisigSpendKeypair:output_type -> pb.CreateMultisigSpendKeypairResponse
+	78,  // 190: pb.WalletService.CreateMultisigViewKeypair:output_type -> pb.CreateMultisigViewKeypairResponse
+	80,  // 191: pb.WalletService.CreateMultisigAddress:output_type -> pb.CreateMultisigAddressResponse
+	82,  // 192: pb.WalletService.CreateMultiSignature:output_type -> pb.CreateMultiSignatureResponse
+	84,  // 193: pb.WalletService.ProveMultisig:output_type -> pb.ProveMultisigResponse
+	86,  // 194: pb.WalletService.WalletLock:output_type -> pb.WalletLockResponse
+	88,  // 195: pb.WalletService.WalletUnlock:output_type -> pb.WalletUnlockResponse
+	90,  // 196: pb.WalletService.SetWalletPassphrase:output_type -> pb.SetWalletPassphraseResponse
+	92,  // 197: pb.WalletService.ChangeWalletPassphrase:output_type -> pb.ChangeWalletPassphraseResponse
+	94,  // 198: pb.WalletService.DeletePrivateKeys:output_type -> pb.DeletePrivateKeysResponse
+	96,  // 199: pb.WalletService.CreateRawTransaction:output_type -> pb.CreateRawTransactionResponse
+	98,  // 200: pb.WalletService.CreateRawStakeTransaction:output_type -> pb.CreateRawStakeTransactionResponse
+	100, // 201: pb.WalletService.ProveRawTransaction:output_type -> pb.ProveRawTransactionResponse
+	102, // 202: pb.WalletService.Stake:output_type -> pb.StakeResponse
+	104, // 203: pb.WalletService.SetAutoStakeRewards:output_type -> pb.SetAutoStakeRewardsResponse
+	106, // 204: pb.WalletService.Spend:output_type -> pb.SpendResponse
+	108, // 205: pb.WalletService.TimelockCoins:output_type -> pb.TimelockCoinsResponse
+	110, // 206: pb.WalletService.SweepWallet:output_type -> pb.SweepWalletResponse
+	112, // 207: pb.WalletService.BumpFee:output_type -> pb.BumpFeeResponse
+	179, // 208: pb.WalletService.SubscribeWalletTransactions:output_type -> pb.WalletTransactionNotification
+	180, // 209: pb.WalletService.SubscribeWalletSyncNotifications:output_type -> pb.WalletSyncNotification
+	116, // 210: pb.NodeService.GetHostInfo:output_type -> pb.GetHostInfoResponse
+	118, // 211: pb.NodeService.GetNetworkKey:output_type -> pb.GetNetworkKeyResponse
+	120, // 212: pb.NodeService.GetPeers:output_type -> pb.GetPeersResponse
+	122, // 213: pb.NodeService.GetNetTotals:output_type -> pb.GetNetTotalsResponse
+	124, // 214: pb.NodeService.GetSyncStatus:output_type -> pb.GetSyncStatusResponse
+	184, // 215: pb.NodeService.SubscribeSyncStatus:output_type -> pb.SyncStatusNotification
+	127, // 216: pb.NodeService.GetChainTips:output_type -> pb.GetChainTipsResponse
+	129, // 217: pb.NodeService.GetForks:output_type -> pb.GetForksResponse
+	185, // 218: pb.NodeService.SubscribeForks:output_type -> pb.ForkNotification
+	133, // 219: pb.NodeService.AddPeer:output_type -> pb.AddPeerResponse
+	135, // 220: pb.NodeService.BlockPeer:output_type -> pb.BlockPeerResponse
+	137, // 221: pb.NodeService.UnblockPeer:output_type -> pb.UnblockPeerResponse
+	139, // 222: pb.NodeService.BlockSubnet:output_type -> pb.BlockSubnetResponse
+	141, // 223: pb.NodeService.UnblockSubnet:output_type -> pb.UnblockSubnetResponse
+	143, // 224: pb.NodeService.ListBans:output_type -> pb.ListBansResponse
+	145, // 225: pb.NodeService.AddAllowlist:output_type -> pb.AddAllowlistResponse
+	147, // 226: pb.NodeService.RemoveAllowlist:output_type -> pb.RemoveAllowlistResponse
+	149, // 227: pb.NodeService.ListAllowlist:output_type -> pb.ListAllowlistResponse
+	151, // 228: pb.NodeService.SetLogLevel:output_type -> pb.SetLogLevelResponse
+	153, // 229: pb.NodeService.GetMinFeePerKilobyte:output_type -> pb.GetMinFeePerKilobyteResponse
+	155, // 230: pb.NodeService.SetMinFeePerKilobyte:output_type -> pb.SetMinFeePerKilobyteResponse
+	157, // 231: pb.NodeService.GetMinStake:output_type -> pb.GetMinStakeResponse
+	159, // 232: pb.NodeService.SetMinStake:output_type -> pb.SetMinStakeResponse
+	161, // 233: pb.NodeService.GetBlockSizeSoftLimit:output_type -> pb.GetBlockSizeSoftLimitResponse
+	163, // 234: pb.NodeService.SetBlockSizeSoftLimit:output_type -> pb.SetBlockSizeSoftLimitResponse
+	165, // 235: pb.NodeService.GetTreasuryWhitelist:output_type -> pb.GetTreasuryWhitelistResponse
+	167, // 236: pb.NodeService.UpdateTreasuryWhitelist:output_type -> pb.UpdateTreasuryWhitelistResponse
+	169, // 237: pb.NodeService.ReconsiderBlock:output_type -> pb.ReconsiderBlockResponse
+	171, // 238: pb.NodeService.RecomputeChainState:output_type -> pb.RecomputeChainStateResponse
+	173, // 239: pb.NodeService.SaveMempool:output_type -> pb.SaveMempoolResponse
+	175, // 240: pb.NodeService.LoadMempool:output_type -> pb.LoadMempoolResponse
+	177, // 241: pb.NodeService.PrioritiseTransaction:output_type -> pb.PrioritiseTransactionResponse
+	152, // [152:242] is the sub-list for method output_type
+	62,  // [62:152] is the sub-list for method input_type
+	62,  // [62:62] is the sub-list for extension type_name
+	62,  // [62:62] is the sub-list for extension extendee
+	0,   // [0:62] is the sub-list for field type_name
+}
+
+func init() { file_ilxrpc_proto_init() }
+func file_ilxrpc_proto_init() {
+	if File_ilxrpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ilxrpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMempoolEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHeadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMerkleProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidatorSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccumulatorCheckpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10039,8 +14143,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccumulatorCheckpointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10051,8 +14155,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolRequest); i {
+		file_ilxrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10063,8 +14167,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMempoolResponse); i {
+		file_ilxrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10075,8 +14179,248 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeCompressedBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeFinalityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterViewKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterViewKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxoProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxoProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletSeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWalletSeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelockedAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimelockedAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ilxrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10087,8 +14431,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10099,8 +14443,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10111,8 +14455,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10123,8 +14467,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockRequest); i {
+		file_ilxrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10135,8 +14479,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponse); i {
+		file_ilxrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10147,8 +14491,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlockRequest); i {
+		file_ilxrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10159,8 +14503,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlockResponse); i {
+		file_ilxrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10171,8 +14515,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersRequest); i {
+		file_ilxrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10183,8 +14527,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHeadersResponse); i {
+		file_ilxrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10195,8 +14539,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10207,8 +14551,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompressedBlocksResponse); i {
+		file_ilxrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivateKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10219,8 +14563,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10231,8 +14575,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10243,8 +14587,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofRequest); i {
+		file_ilxrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigSpendKeypairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10255,8 +14599,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMerkleProofResponse); i {
+		file_ilxrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigSpendKeypairResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10267,8 +14611,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorRequest); i {
+		file_ilxrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigViewKeypairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10279,8 +14623,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorResponse); i {
+		file_ilxrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigViewKeypairResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10291,8 +14635,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10303,8 +14647,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultisigAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10315,8 +14659,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetRequest); i {
+		file_ilxrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultiSignatureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10327,8 +14671,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidatorSetResponse); i {
+		file_ilxrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMultiSignatureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10339,8 +14683,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccumulatorCheckpointRequest); i {
+		file_ilxrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveMultisigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10351,8 +14695,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccumulatorCheckpointResponse); i {
+		file_ilxrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveMultisigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10363,8 +14707,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletLockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10375,8 +14719,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletLockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10387,8 +14731,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletUnlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10399,8 +14743,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeCompressedBlocksRequest); i {
+		file_ilxrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletUnlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10411,8 +14755,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterViewKeyRequest); i {
+		file_ilxrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWalletPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10423,8 +14767,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterViewKeyResponse); i {
+		file_ilxrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWalletPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10435,8 +14779,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeWalletPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10447,8 +14791,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeWalletPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10459,8 +14803,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletTransactionsResponse); i {
+		file_ilxrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivateKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10471,8 +14815,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxoProofRequest); i {
+		file_ilxrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivateKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10483,8 +14827,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxoProofResponse); i {
+		file_ilxrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10495,8 +14839,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceRequest); i {
+		file_ilxrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10507,8 +14851,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_ilxrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawStakeTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10519,8 +14863,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletSeedRequest); i {
+		file_ilxrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawStakeTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10531,8 +14875,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWalletSeedResponse); i {
+		file_ilxrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRawTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10543,8 +14887,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveRawTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10555,8 +14899,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10567,8 +14911,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimelockedAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StakeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10579,8 +14923,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimelockedAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoStakeRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10591,8 +14935,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressesRequest); i {
+		file_ilxrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoStakeRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10603,8 +14947,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressesResponse); i {
+		file_ilxrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10615,8 +14959,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10627,8 +14971,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAddressInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelockCoinsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10639,8 +14983,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelockCoinsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10651,8 +14995,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepWalletRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10663,8 +15007,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepWalletResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10675,8 +15019,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsResponse); i {
+		file_ilxrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10687,8 +15031,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUtxosRequest); i {
+		file_ilxrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10699,8 +15043,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUtxosResponse); i {
+		file_ilxrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeWalletTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10711,8 +15055,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPrivateKeyRequest); i {
+		file_ilxrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeWalletSyncNotificationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10723,8 +15067,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPrivateKeyResponse); i {
+		file_ilxrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10735,8 +15079,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10747,8 +15091,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworkKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10759,8 +15103,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigSpendKeypairRequest); i {
+		file_ilxrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworkKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10771,8 +15115,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigSpendKeypairResponse); i {
+		file_ilxrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10783,8 +15127,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigViewKeypairRequest); i {
+		file_ilxrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10795,8 +15139,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigViewKeypairResponse); i {
+		file_ilxrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetTotalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10807,8 +15151,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigAddressRequest); i {
+		file_ilxrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetTotalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10819,8 +15163,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultisigAddressResponse); i {
+		file_ilxrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10831,8 +15175,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultiSignatureRequest); i {
+		file_ilxrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10843,8 +15187,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateMultiSignatureResponse); i {
+		file_ilxrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSyncStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10855,8 +15199,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveMultisigRequest); i {
+		file_ilxrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainTipsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10867,8 +15211,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveMultisigResponse); i {
+		file_ilxrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainTipsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10879,8 +15223,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletLockRequest); i {
+		file_ilxrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10891,8 +15235,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletLockResponse); i {
+		file_ilxrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetForksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10903,8 +15247,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletUnlockRequest); i {
+		file_ilxrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeForksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10915,8 +15259,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletUnlockResponse); i {
+		file_ilxrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMempoolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10927,8 +15271,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetWalletPassphraseRequest); i {
+		file_ilxrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10939,8 +15283,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetWalletPassphraseResponse); i {
+		file_ilxrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10951,8 +15295,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeWalletPassphraseRequest); i {
+		file_ilxrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10963,8 +15307,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeWalletPassphraseResponse); i {
+		file_ilxrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10975,8 +15319,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePrivateKeysRequest); i {
+		file_ilxrpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10987,8 +15331,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePrivateKeysResponse); i {
+		file_ilxrpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10999,8 +15343,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockSubnetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11011,8 +15355,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockSubnetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11023,8 +15367,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawStakeTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockSubnetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11035,8 +15379,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawStakeTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockSubnetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11047,8 +15391,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRawTransactionRequest); i {
+		file_ilxrpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBansRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11059,8 +15403,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveRawTransactionResponse); i {
+		file_ilxrpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBansResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11071,8 +15415,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakeRequest); i {
+		file_ilxrpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAllowlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11083,8 +15427,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StakeResponse); i {
+		file_ilxrpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAllowlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11095,8 +15439,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAutoStakeRewardsRequest); i {
+		file_ilxrpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAllowlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11107,8 +15451,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAutoStakeRewardsResponse); i {
+		file_ilxrpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAllowlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11119,8 +15463,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpendRequest); i {
+		file_ilxrpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowlistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11131,8 +15475,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpendResponse); i {
+		file_ilxrpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11143,8 +15487,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimelockCoinsRequest); i {
+		file_ilxrpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11155,8 +15499,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimelockCoinsResponse); i {
+		file_ilxrpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11167,8 +15511,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SweepWalletRequest); i {
+		file_ilxrpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinFeePerKilobyteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11179,8 +15523,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SweepWalletResponse); i {
+		file_ilxrpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinFeePerKilobyteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11191,8 +15535,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeWalletTransactionsRequest); i {
+		file_ilxrpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinFeePerKilobyteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11203,8 +15547,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeWalletSyncNotificationsRequest); i {
+		file_ilxrpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinFeePerKilobyteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11215,8 +15559,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHostInfoRequest); i {
+		file_ilxrpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinStakeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11227,8 +15571,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHostInfoResponse); i {
+		file_ilxrpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinStakeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11239,8 +15583,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNetworkKeyRequest); i {
+		file_ilxrpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinStakeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11251,8 +15595,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNetworkKeyResponse); i {
+		file_ilxrpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinStakeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11263,8 +15607,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeersRequest); i {
+		file_ilxrpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockSizeSoftLimitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11275,8 +15619,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeersResponse); i {
+		file_ilxrpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockSizeSoftLimitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11287,8 +15631,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerRequest); i {
+		file_ilxrpc_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBlockSizeSoftLimitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11299,8 +15643,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerResponse); i {
+		file_ilxrpc_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBlockSizeSoftLimitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11311,8 +15655,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockPeerRequest); i {
+		file_ilxrpc_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreasuryWhitelistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11323,8 +15667,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockPeerResponse); i {
+		file_ilxrpc_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreasuryWhitelistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11335,8 +15679,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockPeerRequest); i {
+		file_ilxrpc_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTreasuryWhitelistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11347,8 +15691,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockPeerResponse); i {
+		file_ilxrpc_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTreasuryWhitelistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11359,8 +15703,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLogLevelRequest); i {
+		file_ilxrpc_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconsiderBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11371,8 +15715,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLogLevelResponse); i {
+		file_ilxrpc_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconsiderBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11383,8 +15727,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinFeePerKilobyteRequest); i {
+		file_ilxrpc_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecomputeChainStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11395,8 +15739,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinFeePerKilobyteResponse); i {
+		file_ilxrpc_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecomputeChainStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11407,8 +15751,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMinFeePerKilobyteRequest); i {
+		file_ilxrpc_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMempoolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11419,8 +15763,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMinFeePerKilobyteResponse); i {
+		file_ilxrpc_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMempoolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11431,8 +15775,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinStakeRequest); i {
+		file_ilxrpc_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadMempoolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11443,8 +15787,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinStakeResponse); i {
+		file_ilxrpc_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadMempoolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11455,8 +15799,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMinStakeRequest); i {
+		file_ilxrpc_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrioritiseTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11467,8 +15811,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetMinStakeResponse); i {
+		file_ilxrpc_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrioritiseTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11479,8 +15823,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockSizeSoftLimitRequest); i {
+		file_ilxrpc_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11491,8 +15835,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockSizeSoftLimitResponse); i {
+		file_ilxrpc_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransactionNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11503,8 +15847,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBlockSizeSoftLimitRequest); i {
+		file_ilxrpc_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletSyncNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11515,8 +15859,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBlockSizeSoftLimitResponse); i {
+		file_ilxrpc_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11527,8 +15871,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTreasuryWhitelistRequest); i {
+		file_ilxrpc_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompressedBlockNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11539,8 +15883,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTreasuryWhitelistResponse); i {
+		file_ilxrpc_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalityNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11551,8 +15895,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTreasuryWhitelistRequest); i {
+		file_ilxrpc_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStatusNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11563,8 +15907,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTreasuryWhitelistResponse); i {
+		file_ilxrpc_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11575,8 +15919,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconsiderBlockRequest); i {
+		file_ilxrpc_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11587,8 +15931,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconsiderBlockResponse); i {
+		file_ilxrpc_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11599,8 +15943,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecomputeChainStateRequest); i {
+		file_ilxrpc_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11611,8 +15955,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecomputeChainStateResponse); i {
+		file_ilxrpc_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11623,8 +15967,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionNotification); i {
+		file_ilxrpc_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11635,8 +15979,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletTransactionNotification); i {
+		file_ilxrpc_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Utxo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11647,8 +15991,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletSyncNotification); i {
+		file_ilxrpc_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RawTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11659,8 +16003,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockNotification); i {
+		file_ilxrpc_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11671,8 +16015,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompressedBlockNotification); i {
+		file_ilxrpc_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11683,8 +16027,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionData); i {
+		file_ilxrpc_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxoProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11695,8 +16039,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockInfo); i {
+		file_ilxrpc_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11707,8 +16051,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Validator); i {
+		file_ilxrpc_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11719,8 +16063,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Utxo); i {
+		file_ilxrpc_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11731,8 +16075,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawTransaction); i {
+		file_ilxrpc_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainTip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11743,8 +16087,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateInput); i {
+		file_ilxrpc_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fork); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11755,8 +16099,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateOutput); i {
+		file_ilxrpc_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionRequest_Input); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11767,8 +16111,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxoProof); i {
+		file_ilxrpc_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawTransactionRequest_Output); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11779,8 +16123,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+		file_ilxrpc_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRawStakeTransactionRequest_Input); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11791,8 +16135,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletTransaction); i {
+		file_ilxrpc_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetTotalsResponse_ProtocolBandwidth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11803,8 +16147,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionRequest_Input); i {
+		file_ilxrpc_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBansResponse_Ban); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11815,8 +16159,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawTransactionRequest_Output); i {
+		file_ilxrpc_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Validator_Stake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11827,8 +16171,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRawStakeTransactionRequest_Input); i {
+		file_ilxrpc_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransaction_IO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11839,8 +16183,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Validator_Stake); i {
+		file_ilxrpc_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransaction_IO_TxIO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11851,8 +16195,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletTransaction_IO); i {
+		file_ilxrpc_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransaction_IO_Unknown); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11863,8 +16207,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletTransaction_IO_TxIO); i {
+		file_ilxrpc_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStatus_PeerBucket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11875,8 +16219,8 @@ func file_ilxrpc_proto_init() {
 				return nil
 			}
 		}
-		file_ilxrpc_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WalletTransaction_IO_Unknown); i {
+		file_ilxrpc_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fork_Tip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11888,43 +16232,51 @@ func file_ilxrpc_proto_init() {
 			}
 		}
 	}
-	file_ilxrpc_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*GetBlockInfoRequest_Block_ID)(nil),
 		(*GetBlockInfoRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[12].OneofWrappers = []interface{}{
 		(*GetBlockRequest_Block_ID)(nil),
 		(*GetBlockRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*GetCompressedBlockRequest_Block_ID)(nil),
 		(*GetCompressedBlockRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*GetAccumulatorCheckpointRequest_Height)(nil),
 		(*GetAccumulatorCheckpointRequest_Timestamp)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*GetWalletTransactionsRequest_Block_ID)(nil),
 		(*GetWalletTransactionsRequest_Height)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[67].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[74].OneofWrappers = []interface{}{
 		(*CreateMultiSignatureRequest_Tx)(nil),
 		(*CreateMultiSignatureRequest_Sighash)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[138].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[137].OneofWrappers = []interface{}{
+		(*AddAllowlistRequest_Peer_ID)(nil),
+		(*AddAllowlistRequest_Subnet)(nil),
+	}
+	file_ilxrpc_proto_msgTypes[139].OneofWrappers = []interface{}{
+		(*RemoveAllowlistRequest_Peer_ID)(nil),
+		(*RemoveAllowlistRequest_Subnet)(nil),
+	}
+	file_ilxrpc_proto_msgTypes[181].OneofWrappers = []interface{}{
 		(*TransactionData_Transaction_ID)(nil),
 		(*TransactionData_Transaction)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[148].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[194].OneofWrappers = []interface{}{
 		(*CreateRawTransactionRequest_Input_Commitment)(nil),
 		(*CreateRawTransactionRequest_Input_Input)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[150].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[196].OneofWrappers = []interface{}{
 		(*CreateRawStakeTransactionRequest_Input_Commitment)(nil),
 		(*CreateRawStakeTransactionRequest_Input_Input)(nil),
 	}
-	file_ilxrpc_proto_msgTypes[152].OneofWrappers = []interface{}{
+	file_ilxrpc_proto_msgTypes[200].OneofWrappers = []interface{}{
 		(*WalletTransaction_IO_TxIo)(nil),
 		(*WalletTransaction_IO_Unknown_)(nil),
 	}
@@ -11933,8 +16285,8 @@ func file_ilxrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ilxrpc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   155,
+			NumEnums:      7,
+			NumMessages:   205,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
@@ -26,6 +26,15 @@ type BlockchainServiceClient interface {
 	GetMempoolInfo(ctx context.Context, in *GetMempoolInfoRequest, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error)
 	// GetMempool returns all the transactions in the mempool
 	GetMempool(ctx context.Context, in *GetMempoolRequest, opts ...grpc.CallOption) (*GetMempoolResponse, error)
+	// GetMempoolEntry returns metadata about a single transaction in the mempool,
+	// including when it entered the mempool and the fee per kilobyte it pays.
+	GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error)
+	// EstimateFee returns the fee per kilobyte a transaction should pay to
+	// be confirmed within the target number of blocks. The estimate is based
+	// on how long transactions in the mempool, grouped by fee per kilobyte,
+	// have recently taken to be confirmed. It is never lower than the node's
+	// minimum fee per kilobyte.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 	// GetBlockchainInfo returns data about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error)
@@ -65,12 +74,31 @@ type BlockchainServiceClient interface {
 	// SubmitTransaction validates a transaction and submits it to the network. An error will be returned
 	// if it fails validation.
 	SubmitTransaction(ctx context.Context, in *SubmitTransactionRequest, opts ...grpc.CallOption) (*SubmitTransactionResponse, error)
+	// TestTransaction runs the full validation of a transaction, including the
+	// proof and signature checks, as if it were submitted to the mempool. The
+	// transaction is not added to the mempool or broadcast to the network.
+	//
+	// If the transaction would be rejected the response contains the reason and
+	// the rule or policy error code.
+	TestTransaction(ctx context.Context, in *TestTransactionRequest, opts ...grpc.CallOption) (*TestTransactionResponse, error)
 	// SubscribeBlocks returns a stream of notifications when new blocks are finalized and
 	// connected to the chain.
 	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeBlocksClient, error)
+	// SubscribeMempool returns a stream of notifications when transactions are added
+	// to the mempool, when they are removed, either because they were included in
+	// a block, expired, or were evicted, and when their status changes because of a
+	// conflicting spend. Notifications are sent in the order the events took place.
+	SubscribeMempool(ctx context.Context, in *SubscribeMempoolRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeMempoolClient, error)
 	// SubscribeCompressedBlocks returns a stream of CompressedBlock notifications when new
 	// blocks are finalized and connected to the chain.
 	SubscribeCompressedBlocks(ctx context.Context, in *SubscribeCompressedBlocksRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeCompressedBlocksClient, error)
+	// SubscribeFinality returns a stream of notifications tracking blocks as they
+	// move through consensus. A notification is sent when a block is first seen,
+	// as its confidence progresses, and when it is finalized or rejected.
+	//
+	// Unlike SubscribeBlocks, which notifies when a block is connected, this can
+	// be used to track a block all the way to finality.
+	SubscribeFinality(ctx context.Context, in *SubscribeFinalityRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeFinalityClient, error)
 }
 
 type blockchainServiceClient struct {
@@ -99,6 +127,24 @@ func (c *blockchainServiceClient) GetMempool(ctx context.Context, in *GetMempool
 	return out, nil
 }
 
+func (c *blockchainServiceClient) GetMempoolEntry(ctx context.Context, in *GetMempoolEntryRequest, opts ...grpc.CallOption) (*GetMempoolEntryResponse, error) {
+	out := new(GetMempoolEntryResponse)
+	err := c.cc.Invoke(ctx, "/pb.BlockchainService/GetMempoolEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockchainServiceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.BlockchainService/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainServiceClient) GetBlockchainInfo(ctx context.Context, in *GetBlockchainInfoRequest, opts ...grpc.CallOption) (*GetBlockchainInfoResponse, error) {
 	out := new(GetBlockchainInfoResponse)
 	err := c.cc.Invoke(ctx, "/pb.BlockchainService/GetBlockchainInfo", in, out, opts...)
@@ -216,6 +262,15 @@ func (c *blockchainServiceClient) SubmitTransaction(ctx context.Context, in *Sub
 	return out, nil
 }
 
+func (c *blockchainServiceClient) TestTransaction(ctx context.Context, in *TestTransactionRequest, opts ...grpc.CallOption) (*TestTransactionResponse, error) {
+	out := new(TestTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.BlockchainService/TestTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blockchainServiceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeBlocksClient, error) {
 	stream, err := c.cc.NewStream(ctx, &BlockchainService_ServiceDesc.Streams[0], "/pb.BlockchainService/SubscribeBlocks", opts...)
 	if err != nil {
@@ -248,8 +303,40 @@ func (x *blockchainServiceSubscribeBlocksClient) Recv() (*BlockNotification, err
 	return m, nil
 }
 
+func (c *blockchainServiceClient) SubscribeMempool(ctx context.Context, in *SubscribeMempoolRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeMempoolClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlockchainService_ServiceDesc.Streams[1], "/pb.BlockchainService/SubscribeMempool", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockchainServiceSubscribeMempoolClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockchainService_SubscribeMempoolClient interface {
+	Recv() (*MempoolNotification, error)
+	grpc.ClientStream
+}
+
+type blockchainServiceSubscribeMempoolClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockchainServiceSubscribeMempoolClient) Recv() (*MempoolNotification, error) {
+	m := new(MempoolNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *blockchainServiceClient) SubscribeCompressedBlocks(ctx context.Context, in *SubscribeCompressedBlocksRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeCompressedBlocksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &BlockchainService_ServiceDesc.Streams[1], "/pb.BlockchainService/SubscribeCompressedBlocks", opts...)
+	stream, err := c.cc.NewStream(ctx, &BlockchainService_ServiceDesc.Streams[2], "/pb.BlockchainService/SubscribeCompressedBlocks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +367,38 @@ func (x *blockchainServiceSubscribeCompressedBlocksClient) Recv() (*CompressedBl
 	return m, nil
 }
 
+func (c *blockchainServiceClient) SubscribeFinality(ctx context.Context, in *SubscribeFinalityRequest, opts ...grpc.CallOption) (BlockchainService_SubscribeFinalityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlockchainService_ServiceDesc.Streams[3], "/pb.BlockchainService/SubscribeFinality", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockchainServiceSubscribeFinalityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockchainService_SubscribeFinalityClient interface {
+	Recv() (*FinalityNotification, error)
+	grpc.ClientStream
+}
+
+type blockchainServiceSubscribeFinalityClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockchainServiceSubscribeFinalityClient) Recv() (*FinalityNotification, error) {
+	m := new(FinalityNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BlockchainServiceServer is the server API for BlockchainService service.
 // All implementations must embed UnimplementedBlockchainServiceServer
 // for forward compatibility
@@ -288,6 +407,15 @@ type BlockchainServiceServer interface {
 	GetMempoolInfo(context.Context, *GetMempoolInfoRequest) (*GetMempoolInfoResponse, error)
 	// GetMempool returns all the transactions in the mempool
 	GetMempool(context.Context, *GetMempoolRequest) (*GetMempoolResponse, error)
+	// GetMempoolEntry returns metadata about a single transaction in the mempool,
+	// including when it entered the mempool and the fee per kilobyte it pays.
+	GetMempoolEntry(context.Context, *GetMempoolEntryRequest) (*GetMempoolEntryResponse, error)
+	// EstimateFee returns the fee per kilobyte a transaction should pay to
+	// be confirmed within the target number of blocks. The estimate is based
+	// on how long transactions in the mempool, grouped by fee per kilobyte,
+	// have recently taken to be confirmed. It is never lower than the node's
+	// minimum fee per kilobyte.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 	// GetBlockchainInfo returns data about the blockchain including the most recent
 	// block hash and height.
 	GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error)
@@ -327,12 +455,31 @@ type BlockchainServiceServer interface {
 	// SubmitTransaction validates a transaction and submits it to the network. An error will be returned
 	// if it fails validation.
 	SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error)
+	// TestTransaction runs the full validation of a transaction, including the
+	// proof and signature checks, as if it were submitted to the mempool. The
+	// transaction is not added to the mempool or broadcast to the network.
+	//
+	// If the transaction would be rejected the response contains the reason and
+	// the rule or policy error code.
+	TestTransaction(context.Context, *TestTransactionRequest) (*TestTransactionResponse, error)
 	// SubscribeBlocks returns a stream of notifications when new blocks are finalized and
 	// connected to the chain.
 	SubscribeBlocks(*SubscribeBlocksRequest, BlockchainService_SubscribeBlocksServer) error
+	// SubscribeMempool returns a stream of notifications when transactions are added
+	// to the mempool, when they are removed, either because they were included in
+	// a block, expired, or were evicted, and when their status changes because of a
+	// conflicting spend. Notifications are sent in the order the events took place.
+	SubscribeMempool(*SubscribeMempoolRequest, BlockchainService_SubscribeMempoolServer) error
 	// SubscribeCompressedBlocks returns a stream of CompressedBlock notifications when new
 	// blocks are finalized and connected to the chain.
 	SubscribeCompressedBlocks(*SubscribeCompressedBlocksRequest, BlockchainService_SubscribeCompressedBlocksServer) error
+	// SubscribeFinality returns a stream of notifications tracking blocks as they
+	// move through consensus. A notification is sent when a block is first seen,
+	// as its confidence progresses, and when it is finalized or rejected.
+	//
+	// Unlike SubscribeBlocks, which notifies when a block is connected, this can
+	// be used to track a block all the way to finality.
+	SubscribeFinality(*SubscribeFinalityRequest, BlockchainService_SubscribeFinalityServer) error
 	mustEmbedUnimplementedBlockchainServiceServer()
 }
 
@@ -346,6 +493,12 @@ func (UnimplementedBlockchainServiceServer) GetMempoolInfo(context.Context, *Get
 func (UnimplementedBlockchainServiceServer) GetMempool(context.Context, *GetMempoolRequest) (*GetMempoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMempool not implemented")
 }
+func (UnimplementedBlockchainServiceServer) GetMempoolEntry(context.Context, *GetMempoolEntryRequest) (*GetMempoolEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMempoolEntry not implemented")
+}
+func (UnimplementedBlockchainServiceServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 func (UnimplementedBlockchainServiceServer) GetBlockchainInfo(context.Context, *GetBlockchainInfoRequest) (*GetBlockchainInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockchainInfo not implemented")
 }
@@ -385,12 +538,21 @@ func (UnimplementedBlockchainServiceServer) GetAccumulatorCheckpoint(context.Con
 func (UnimplementedBlockchainServiceServer) SubmitTransaction(context.Context, *SubmitTransactionRequest) (*SubmitTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTransaction not implemented")
 }
+func (UnimplementedBlockchainServiceServer) TestTransaction(context.Context, *TestTransactionRequest) (*TestTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTransaction not implemented")
+}
 func (UnimplementedBlockchainServiceServer) SubscribeBlocks(*SubscribeBlocksRequest, BlockchainService_SubscribeBlocksServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
 }
+func (UnimplementedBlockchainServiceServer) SubscribeMempool(*SubscribeMempoolRequest, BlockchainService_SubscribeMempoolServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMempool not implemented")
+}
 func (UnimplementedBlockchainServiceServer) SubscribeCompressedBlocks(*SubscribeCompressedBlocksRequest, BlockchainService_SubscribeCompressedBlocksServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeCompressedBlocks not implemented")
 }
+func (UnimplementedBlockchainServiceServer) SubscribeFinality(*SubscribeFinalityRequest, BlockchainService_SubscribeFinalityServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeFinality not implemented")
+}
 func (UnimplementedBlockchainServiceServer) mustEmbedUnimplementedBlockchainServiceServer() {}
 
 // UnsafeBlockchainServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -440,6 +602,42 @@ func _BlockchainService_GetMempool_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainService_GetMempoolEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServiceServer).GetMempoolEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BlockchainService/GetMempoolEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServiceServer).GetMempoolEntry(ctx, req.(*GetMempoolEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockchainService_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BlockchainService/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainService_GetBlockchainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockchainInfoRequest)
 	if err := dec(in); err != nil {
@@ -674,6 +872,24 @@ func _BlockchainService_SubmitTransaction_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockchainService_TestTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockchainServiceServer).TestTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BlockchainService/TestTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockchainServiceServer).TestTransaction(ctx, req.(*TestTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BlockchainService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeBlocksRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -695,6 +911,27 @@ func (x *blockchainServiceSubscribeBlocksServer) Send(m *BlockNotification) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BlockchainService_SubscribeMempool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMempoolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainServiceServer).SubscribeMempool(m, &blockchainServiceSubscribeMempoolServer{stream})
+}
+
+type BlockchainService_SubscribeMempoolServer interface {
+	Send(*MempoolNotification) error
+	grpc.ServerStream
+}
+
+type blockchainServiceSubscribeMempoolServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockchainServiceSubscribeMempoolServer) Send(m *MempoolNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BlockchainService_SubscribeCompressedBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeCompressedBlocksRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -716,6 +953,27 @@ func (x *blockchainServiceSubscribeCompressedBlocksServer) Send(m *CompressedBlo
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BlockchainService_SubscribeFinality_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFinalityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockchainServiceServer).SubscribeFinality(m, &blockchainServiceSubscribeFinalityServer{stream})
+}
+
+type BlockchainService_SubscribeFinalityServer interface {
+	Send(*FinalityNotification) error
+	grpc.ServerStream
+}
+
+type blockchainServiceSubscribeFinalityServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockchainServiceSubscribeFinalityServer) Send(m *FinalityNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BlockchainService_ServiceDesc is the grpc.ServiceDesc for BlockchainService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -731,6 +989,14 @@ var BlockchainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMempool",
 			Handler:    _BlockchainService_GetMempool_Handler,
 		},
+		{
+			MethodName: "GetMempoolEntry",
+			Handler:    _BlockchainService_GetMempoolEntry_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _BlockchainService_EstimateFee_Handler,
+		},
 		{
 			MethodName: "GetBlockchainInfo",
 			Handler:    _BlockchainService_GetBlockchainInfo_Handler,
@@ -783,6 +1049,10 @@ var BlockchainService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitTransaction",
 			Handler:    _BlockchainService_SubmitTransaction_Handler,
 		},
+		{
+			MethodName: "TestTransaction",
+			Handler:    _BlockchainService_TestTransaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -790,11 +1060,21 @@ var BlockchainService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _BlockchainService_SubscribeBlocks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeMempool",
+			Handler:       _BlockchainService_SubscribeMempool_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeCompressedBlocks",
 			Handler:       _BlockchainService_SubscribeCompressedBlocks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeFinality",
+			Handler:       _BlockchainService_SubscribeFinality_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "ilxrpc.proto",
 }
@@ -1141,6 +1421,23 @@ type WalletServiceClient interface {
 	//
 	// **Requires wallet to be unlocked**
 	SweepWallet(ctx context.Context, in *SweepWalletRequest, opts ...grpc.CallOption) (*SweepWalletResponse, error)
+	// BumpFee replaces an unconfirmed wallet transaction in the mempool with
+	// a new transaction paying a higher fee. The replacement spends the same
+	// inputs and pays the same amounts to the same external addresses. Any
+	// remaining coins are sent to a new change output.
+	//
+	// For the replacement to be accepted it must pay a higher fee per kilobyte
+	// and a higher total fee than the original transaction, and the additional
+	// fee must pay for the replacement's own size at the mempool's minimum fee
+	// per kilobyte.
+	//
+	// The replacement and the original are voted on by the consensus engine
+	// with the replacement as the preferred spend. An error is returned if the
+	// original is already contested by another conflicting spend as the
+	// replacement could not become the preferred spend and would lose the vote.
+	//
+	// **Requires wallet to be unlocked**
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
 	// SubscribeWalletTransactions subscribes to a stream of WalletTransactionsNotifications that return
 	// whenever a transaction belonging to the wallet finalizes.
 	SubscribeWalletTransactions(ctx context.Context, in *SubscribeWalletTransactionsRequest, opts ...grpc.CallOption) (WalletService_SubscribeWalletTransactionsClient, error)
@@ -1417,6 +1714,15 @@ func (c *walletServiceClient) SweepWallet(ctx context.Context, in *SweepWalletRe
 	return out, nil
 }
 
+func (c *walletServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) SubscribeWalletTransactions(ctx context.Context, in *SubscribeWalletTransactionsRequest, opts ...grpc.CallOption) (WalletService_SubscribeWalletTransactionsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], "/pb.WalletService/SubscribeWalletTransactions", opts...)
 	if err != nil {
@@ -1579,6 +1885,23 @@ type WalletServiceServer interface {
 	//
 	// **Requires wallet to be unlocked**
 	SweepWallet(context.Context, *SweepWalletRequest) (*SweepWalletResponse, error)
+	// BumpFee replaces an unconfirmed wallet transaction in the mempool with
+	// a new transaction paying a higher fee. The replacement spends the same
+	// inputs and pays the same amounts to the same external addresses. Any
+	// remaining coins are sent to a new change output.
+	//
+	// For the replacement to be accepted it must pay a higher fee per kilobyte
+	// and a higher total fee than the original transaction, and the additional
+	// fee must pay for the replacement's own size at the mempool's minimum fee
+	// per kilobyte.
+	//
+	// The replacement and the original are voted on by the consensus engine
+	// with the replacement as the preferred spend. An error is returned if the
+	// original is already contested by another conflicting spend as the
+	// replacement could not become the preferred spend and would lose the vote.
+	//
+	// **Requires wallet to be unlocked**
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
 	// SubscribeWalletTransactions subscribes to a stream of WalletTransactionsNotifications that return
 	// whenever a transaction belonging to the wallet finalizes.
 	SubscribeWalletTransactions(*SubscribeWalletTransactionsRequest, WalletService_SubscribeWalletTransactionsServer) error
@@ -1678,6 +2001,9 @@ func (UnimplementedWalletServiceServer) TimelockCoins(context.Context, *Timelock
 func (UnimplementedWalletServiceServer) SweepWallet(context.Context, *SweepWalletRequest) (*SweepWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SweepWallet not implemented")
 }
+func (UnimplementedWalletServiceServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
 func (UnimplementedWalletServiceServer) SubscribeWalletTransactions(*SubscribeWalletTransactionsRequest, WalletService_SubscribeWalletTransactionsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeWalletTransactions not implemented")
 }
@@ -2219,6 +2545,24 @@ func _WalletService_SweepWallet_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_SubscribeWalletTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeWalletTransactionsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2384,6 +2728,10 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SweepWallet",
 			Handler:    _WalletService_SweepWallet_Handler,
 		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _WalletService_BumpFee_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2410,12 +2758,50 @@ type NodeServiceClient interface {
 	GetNetworkKey(ctx context.Context, in *GetNetworkKeyRequest, opts ...grpc.CallOption) (*GetNetworkKeyResponse, error)
 	// GetPeers returns a list of peers that this node is connected to
 	GetPeers(ctx context.Context, in *GetPeersRequest, opts ...grpc.CallOption) (*GetPeersResponse, error)
+	// GetNetTotals returns the total number of bytes sent and received by
+	// the node along with the current bandwidth rates. The totals are also
+	// broken down by protocol.
+	GetNetTotals(ctx context.Context, in *GetNetTotalsRequest, opts ...grpc.CallOption) (*GetNetTotalsResponse, error)
+	// GetSyncStatus returns the progress of the node's sync to the tip of
+	// the chain, including the estimated network height, the peers being
+	// synced from, the sync rate, and the estimated time remaining.
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
+	// SubscribeSyncStatus returns a stream of notifications with the sync
+	// status. The current status is sent immediately and then again each
+	// time it changes.
+	SubscribeSyncStatus(ctx context.Context, in *SubscribeSyncStatusRequest, opts ...grpc.CallOption) (NodeService_SubscribeSyncStatusClient, error)
+	// GetChainTips returns the tip of the node's best chain along with the
+	// tips of any competing chains seen in forks during the sync.
+	GetChainTips(ctx context.Context, in *GetChainTipsRequest, opts ...grpc.CallOption) (*GetChainTipsResponse, error)
+	// GetForks returns the forks the node has observed while syncing, most
+	// recent first. This includes the fork point, the competing tips, the
+	// peers on each side, their chain scores, and which tip was selected.
+	GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*GetForksResponse, error)
+	// SubscribeForks returns a stream of notifications when the node finds
+	// peers following competing chains.
+	SubscribeForks(ctx context.Context, in *SubscribeForksRequest, opts ...grpc.CallOption) (NodeService_SubscribeForksClient, error)
 	// AddPeer attempts to connect to the provided peer
 	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error)
 	// BlockPeer blocks the given peer for the provided time period
 	BlockPeer(ctx context.Context, in *BlockPeerRequest, opts ...grpc.CallOption) (*BlockPeerResponse, error)
 	// UnblockPeer removes a peer from the block list
 	UnblockPeer(ctx context.Context, in *UnblockPeerRequest, opts ...grpc.CallOption) (*UnblockPeerResponse, error)
+	// BlockSubnet blocks all IP addresses in the given subnet for the provided
+	// time period
+	BlockSubnet(ctx context.Context, in *BlockSubnetRequest, opts ...grpc.CallOption) (*BlockSubnetResponse, error)
+	// UnblockSubnet removes a subnet from the block list
+	UnblockSubnet(ctx context.Context, in *UnblockSubnetRequest, opts ...grpc.CallOption) (*UnblockSubnetResponse, error)
+	// ListBans returns the active peer, IP address, and subnet bans along with
+	// the reason for and expiration of each ban.
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	// AddAllowlist adds a peer ID or subnet to the allowlist. Allowlisted peers
+	// bypass all bans, are exempt from banscore, and are protected from being
+	// disconnected when the node is over its connection limits.
+	AddAllowlist(ctx context.Context, in *AddAllowlistRequest, opts ...grpc.CallOption) (*AddAllowlistResponse, error)
+	// RemoveAllowlist removes a peer ID or subnet from the allowlist
+	RemoveAllowlist(ctx context.Context, in *RemoveAllowlistRequest, opts ...grpc.CallOption) (*RemoveAllowlistResponse, error)
+	// ListAllowlist returns the allowlisted peer IDs and subnets
+	ListAllowlist(ctx context.Context, in *ListAllowlistRequest, opts ...grpc.CallOption) (*ListAllowlistResponse, error)
 	// SetLogLevel changes the logging level of the node
 	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
 	// GetMinFeePerKilobyte returns the node's current minimum transaction fee needed to relay
@@ -2446,6 +2832,23 @@ type NodeServiceClient interface {
 	// RecomputeChainState deletes the accumulator, validator set, and nullifier set and rebuilds them by
 	// loading and re-processing all blocks from genesis.
 	RecomputeChainState(ctx context.Context, in *RecomputeChainStateRequest, opts ...grpc.CallOption) (*RecomputeChainStateResponse, error)
+	// SaveMempool writes the mempool to a file in the node's data directory.
+	// The mempool is also saved automatically on shutdown unless disabled.
+	SaveMempool(ctx context.Context, in *SaveMempoolRequest, opts ...grpc.CallOption) (*SaveMempoolResponse, error)
+	// LoadMempool loads the mempool saved in the node's data directory. Each
+	// transaction is revalidated and those that are no longer valid or have
+	// expired are discarded.
+	LoadMempool(ctx context.Context, in *LoadMempoolRequest, opts ...grpc.CallOption) (*LoadMempoolResponse, error)
+	// PrioritiseTransaction adds a fee delta to a transaction. The delta is
+	// applied to the transaction's fee when it is compared against other
+	// transactions in the mempool and when selecting transactions for a block.
+	// The transaction itself is not changed and the delta is not relayed.
+	//
+	// A positive delta can be used to move a stuck transaction ahead of others
+	// and a negative delta to deprioritize one. The delta does not count toward
+	// the policy minimum fee. Deltas accumulate across calls, are persisted, and
+	// are removed once the transaction is included in a block.
+	PrioritiseTransaction(ctx context.Context, in *PrioritiseTransactionRequest, opts ...grpc.CallOption) (*PrioritiseTransactionResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -2483,90 +2886,244 @@ func (c *nodeServiceClient) GetPeers(ctx context.Context, in *GetPeersRequest, o
 	return out, nil
 }
 
-func (c *nodeServiceClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error) {
-	out := new(AddPeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/AddPeer", in, out, opts...)
+func (c *nodeServiceClient) GetNetTotals(ctx context.Context, in *GetNetTotalsRequest, opts ...grpc.CallOption) (*GetNetTotalsResponse, error) {
+	out := new(GetNetTotalsResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetNetTotals", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nodeServiceClient) BlockPeer(ctx context.Context, in *BlockPeerRequest, opts ...grpc.CallOption) (*BlockPeerResponse, error) {
-	out := new(BlockPeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/BlockPeer", in, out, opts...)
+func (c *nodeServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetSyncStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nodeServiceClient) UnblockPeer(ctx context.Context, in *UnblockPeerRequest, opts ...grpc.CallOption) (*UnblockPeerResponse, error) {
-	out := new(UnblockPeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/UnblockPeer", in, out, opts...)
+func (c *nodeServiceClient) SubscribeSyncStatus(ctx context.Context, in *SubscribeSyncStatusRequest, opts ...grpc.CallOption) (NodeService_SubscribeSyncStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeService_ServiceDesc.Streams[0], "/pb.NodeService/SubscribeSyncStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *nodeServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
-	out := new(SetLogLevelResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/SetLogLevel", in, out, opts...)
-	if err != nil {
+	x := &nodeServiceSubscribeSyncStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *nodeServiceClient) GetMinFeePerKilobyte(ctx context.Context, in *GetMinFeePerKilobyteRequest, opts ...grpc.CallOption) (*GetMinFeePerKilobyteResponse, error) {
-	out := new(GetMinFeePerKilobyteResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/GetMinFeePerKilobyte", in, out, opts...)
-	if err != nil {
+type NodeService_SubscribeSyncStatusClient interface {
+	Recv() (*SyncStatusNotification, error)
+	grpc.ClientStream
+}
+
+type nodeServiceSubscribeSyncStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeServiceSubscribeSyncStatusClient) Recv() (*SyncStatusNotification, error) {
+	m := new(SyncStatusNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *nodeServiceClient) SetMinFeePerKilobyte(ctx context.Context, in *SetMinFeePerKilobyteRequest, opts ...grpc.CallOption) (*SetMinFeePerKilobyteResponse, error) {
-	out := new(SetMinFeePerKilobyteResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/SetMinFeePerKilobyte", in, out, opts...)
+func (c *nodeServiceClient) GetChainTips(ctx context.Context, in *GetChainTipsRequest, opts ...grpc.CallOption) (*GetChainTipsResponse, error) {
+	out := new(GetChainTipsResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetChainTips", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nodeServiceClient) GetMinStake(ctx context.Context, in *GetMinStakeRequest, opts ...grpc.CallOption) (*GetMinStakeResponse, error) {
-	out := new(GetMinStakeResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/GetMinStake", in, out, opts...)
+func (c *nodeServiceClient) GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*GetForksResponse, error) {
+	out := new(GetForksResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetForks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *nodeServiceClient) SetMinStake(ctx context.Context, in *SetMinStakeRequest, opts ...grpc.CallOption) (*SetMinStakeResponse, error) {
-	out := new(SetMinStakeResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/SetMinStake", in, out, opts...)
+func (c *nodeServiceClient) SubscribeForks(ctx context.Context, in *SubscribeForksRequest, opts ...grpc.CallOption) (NodeService_SubscribeForksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeService_ServiceDesc.Streams[1], "/pb.NodeService/SubscribeForks", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &nodeServiceSubscribeForksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *nodeServiceClient) GetBlockSizeSoftLimit(ctx context.Context, in *GetBlockSizeSoftLimitRequest, opts ...grpc.CallOption) (*GetBlockSizeSoftLimitResponse, error) {
-	out := new(GetBlockSizeSoftLimitResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/GetBlockSizeSoftLimit", in, out, opts...)
-	if err != nil {
+type NodeService_SubscribeForksClient interface {
+	Recv() (*ForkNotification, error)
+	grpc.ClientStream
+}
+
+type nodeServiceSubscribeForksClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeServiceSubscribeForksClient) Recv() (*ForkNotification, error) {
+	m := new(ForkNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *nodeServiceClient) SetBlockSizeSoftLimit(ctx context.Context, in *SetBlockSizeSoftLimitRequest, opts ...grpc.CallOption) (*SetBlockSizeSoftLimitResponse, error) {
-	out := new(SetBlockSizeSoftLimitResponse)
-	err := c.cc.Invoke(ctx, "/pb.NodeService/SetBlockSizeSoftLimit", in, out, opts...)
+func (c *nodeServiceClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error) {
+	out := new(AddPeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/AddPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) BlockPeer(ctx context.Context, in *BlockPeerRequest, opts ...grpc.CallOption) (*BlockPeerResponse, error) {
+	out := new(BlockPeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/BlockPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UnblockPeer(ctx context.Context, in *UnblockPeerRequest, opts ...grpc.CallOption) (*UnblockPeerResponse, error) {
+	out := new(UnblockPeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/UnblockPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) BlockSubnet(ctx context.Context, in *BlockSubnetRequest, opts ...grpc.CallOption) (*BlockSubnetResponse, error) {
+	out := new(BlockSubnetResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/BlockSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UnblockSubnet(ctx context.Context, in *UnblockSubnetRequest, opts ...grpc.CallOption) (*UnblockSubnetResponse, error) {
+	out := new(UnblockSubnetResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/UnblockSubnet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/ListBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) AddAllowlist(ctx context.Context, in *AddAllowlistRequest, opts ...grpc.CallOption) (*AddAllowlistResponse, error) {
+	out := new(AddAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/AddAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) RemoveAllowlist(ctx context.Context, in *RemoveAllowlistRequest, opts ...grpc.CallOption) (*RemoveAllowlistResponse, error) {
+	out := new(RemoveAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/RemoveAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ListAllowlist(ctx context.Context, in *ListAllowlistRequest, opts ...grpc.CallOption) (*ListAllowlistResponse, error) {
+	out := new(ListAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/ListAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetMinFeePerKilobyte(ctx context.Context, in *GetMinFeePerKilobyteRequest, opts ...grpc.CallOption) (*GetMinFeePerKilobyteResponse, error) {
+	out := new(GetMinFeePerKilobyteResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetMinFeePerKilobyte", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SetMinFeePerKilobyte(ctx context.Context, in *SetMinFeePerKilobyteRequest, opts ...grpc.CallOption) (*SetMinFeePerKilobyteResponse, error) {
+	out := new(SetMinFeePerKilobyteResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/SetMinFeePerKilobyte", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetMinStake(ctx context.Context, in *GetMinStakeRequest, opts ...grpc.CallOption) (*GetMinStakeResponse, error) {
+	out := new(GetMinStakeResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetMinStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SetMinStake(ctx context.Context, in *SetMinStakeRequest, opts ...grpc.CallOption) (*SetMinStakeResponse, error) {
+	out := new(SetMinStakeResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/SetMinStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetBlockSizeSoftLimit(ctx context.Context, in *GetBlockSizeSoftLimitRequest, opts ...grpc.CallOption) (*GetBlockSizeSoftLimitResponse, error) {
+	out := new(GetBlockSizeSoftLimitResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/GetBlockSizeSoftLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SetBlockSizeSoftLimit(ctx context.Context, in *SetBlockSizeSoftLimitRequest, opts ...grpc.CallOption) (*SetBlockSizeSoftLimitResponse, error) {
+	out := new(SetBlockSizeSoftLimitResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/SetBlockSizeSoftLimit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2609,6 +3166,33 @@ func (c *nodeServiceClient) RecomputeChainState(ctx context.Context, in *Recompu
 	return out, nil
 }
 
+func (c *nodeServiceClient) SaveMempool(ctx context.Context, in *SaveMempoolRequest, opts ...grpc.CallOption) (*SaveMempoolResponse, error) {
+	out := new(SaveMempoolResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/SaveMempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) LoadMempool(ctx context.Context, in *LoadMempoolRequest, opts ...grpc.CallOption) (*LoadMempoolResponse, error) {
+	out := new(LoadMempoolResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/LoadMempool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) PrioritiseTransaction(ctx context.Context, in *PrioritiseTransactionRequest, opts ...grpc.CallOption) (*PrioritiseTransactionResponse, error) {
+	out := new(PrioritiseTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.NodeService/PrioritiseTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations must embed UnimplementedNodeServiceServer
 // for forward compatibility
@@ -2619,12 +3203,50 @@ type NodeServiceServer interface {
 	GetNetworkKey(context.Context, *GetNetworkKeyRequest) (*GetNetworkKeyResponse, error)
 	// GetPeers returns a list of peers that this node is connected to
 	GetPeers(context.Context, *GetPeersRequest) (*GetPeersResponse, error)
+	// GetNetTotals returns the total number of bytes sent and received by
+	// the node along with the current bandwidth rates. The totals are also
+	// broken down by protocol.
+	GetNetTotals(context.Context, *GetNetTotalsRequest) (*GetNetTotalsResponse, error)
+	// GetSyncStatus returns the progress of the node's sync to the tip of
+	// the chain, including the estimated network height, the peers being
+	// synced from, the sync rate, and the estimated time remaining.
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
+	// SubscribeSyncStatus returns a stream of notifications with the sync
+	// status. The current status is sent immediately and then again each
+	// time it changes.
+	SubscribeSyncStatus(*SubscribeSyncStatusRequest, NodeService_SubscribeSyncStatusServer) error
+	// GetChainTips returns the tip of the node's best chain along with the
+	// tips of any competing chains seen in forks during the sync.
+	GetChainTips(context.Context, *GetChainTipsRequest) (*GetChainTipsResponse, error)
+	// GetForks returns the forks the node has observed while syncing, most
+	// recent first. This includes the fork point, the competing tips, the
+	// peers on each side, their chain scores, and which tip was selected.
+	GetForks(context.Context, *GetForksRequest) (*GetForksResponse, error)
+	// SubscribeForks returns a stream of notifications when the node finds
+	// peers following competing chains.
+	SubscribeForks(*SubscribeForksRequest, NodeService_SubscribeForksServer) error
 	// AddPeer attempts to connect to the provided peer
 	AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error)
 	// BlockPeer blocks the given peer for the provided time period
 	BlockPeer(context.Context, *BlockPeerRequest) (*BlockPeerResponse, error)
 	// UnblockPeer removes a peer from the block list
 	UnblockPeer(context.Context, *UnblockPeerRequest) (*UnblockPeerResponse, error)
+	// BlockSubnet blocks all IP addresses in the given subnet for the provided
+	// time period
+	BlockSubnet(context.Context, *BlockSubnetRequest) (*BlockSubnetResponse, error)
+	// UnblockSubnet removes a subnet from the block list
+	UnblockSubnet(context.Context, *UnblockSubnetRequest) (*UnblockSubnetResponse, error)
+	// ListBans returns the active peer, IP address, and subnet bans along with
+	// the reason for and expiration of each ban.
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	// AddAllowlist adds a peer ID or subnet to the allowlist. Allowlisted peers
+	// bypass all bans, are exempt from banscore, and are protected from being
+	// disconnected when the node is over its connection limits.
+	AddAllowlist(context.Context, *AddAllowlistRequest) (*AddAllowlistResponse, error)
+	// RemoveAllowlist removes a peer ID or subnet from the allowlist
+	RemoveAllowlist(context.Context, *RemoveAllowlistRequest) (*RemoveAllowlistResponse, error)
+	// ListAllowlist returns the allowlisted peer IDs and subnets
+	ListAllowlist(context.Context, *ListAllowlistRequest) (*ListAllowlistResponse, error)
 	// SetLogLevel changes the logging level of the node
 	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
 	// GetMinFeePerKilobyte returns the node's current minimum transaction fee needed to relay
@@ -2655,6 +3277,23 @@ type NodeServiceServer interface {
 	// RecomputeChainState deletes the accumulator, validator set, and nullifier set and rebuilds them by
 	// loading and re-processing all blocks from genesis.
 	RecomputeChainState(context.Context, *RecomputeChainStateRequest) (*RecomputeChainStateResponse, error)
+	// SaveMempool writes the mempool to a file in the node's data directory.
+	// The mempool is also saved automatically on shutdown unless disabled.
+	SaveMempool(context.Context, *SaveMempoolRequest) (*SaveMempoolResponse, error)
+	// LoadMempool loads the mempool saved in the node's data directory. Each
+	// transaction is revalidated and those that are no longer valid or have
+	// expired are discarded.
+	LoadMempool(context.Context, *LoadMempoolRequest) (*LoadMempoolResponse, error)
+	// PrioritiseTransaction adds a fee delta to a transaction. The delta is
+	// applied to the transaction's fee when it is compared against other
+	// transactions in the mempool and when selecting transactions for a block.
+	// The transaction itself is not changed and the delta is not relayed.
+	//
+	// A positive delta can be used to move a stuck transaction ahead of others
+	// and a negative delta to deprioritize one. The delta does not count toward
+	// the policy minimum fee. Deltas accumulate across calls, are persisted, and
+	// are removed once the transaction is included in a block.
+	PrioritiseTransaction(context.Context, *PrioritiseTransactionRequest) (*PrioritiseTransactionResponse, error)
 	mustEmbedUnimplementedNodeServiceServer()
 }
 
@@ -2671,6 +3310,24 @@ func (UnimplementedNodeServiceServer) GetNetworkKey(context.Context, *GetNetwork
 func (UnimplementedNodeServiceServer) GetPeers(context.Context, *GetPeersRequest) (*GetPeersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
 }
+func (UnimplementedNodeServiceServer) GetNetTotals(context.Context, *GetNetTotalsRequest) (*GetNetTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetTotals not implemented")
+}
+func (UnimplementedNodeServiceServer) GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
+func (UnimplementedNodeServiceServer) SubscribeSyncStatus(*SubscribeSyncStatusRequest, NodeService_SubscribeSyncStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSyncStatus not implemented")
+}
+func (UnimplementedNodeServiceServer) GetChainTips(context.Context, *GetChainTipsRequest) (*GetChainTipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainTips not implemented")
+}
+func (UnimplementedNodeServiceServer) GetForks(context.Context, *GetForksRequest) (*GetForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForks not implemented")
+}
+func (UnimplementedNodeServiceServer) SubscribeForks(*SubscribeForksRequest, NodeService_SubscribeForksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeForks not implemented")
+}
 func (UnimplementedNodeServiceServer) AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
 }
@@ -2680,6 +3337,24 @@ func (UnimplementedNodeServiceServer) BlockPeer(context.Context, *BlockPeerReque
 func (UnimplementedNodeServiceServer) UnblockPeer(context.Context, *UnblockPeerRequest) (*UnblockPeerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnblockPeer not implemented")
 }
+func (UnimplementedNodeServiceServer) BlockSubnet(context.Context, *BlockSubnetRequest) (*BlockSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSubnet not implemented")
+}
+func (UnimplementedNodeServiceServer) UnblockSubnet(context.Context, *UnblockSubnetRequest) (*UnblockSubnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSubnet not implemented")
+}
+func (UnimplementedNodeServiceServer) ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
+func (UnimplementedNodeServiceServer) AddAllowlist(context.Context, *AddAllowlistRequest) (*AddAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowlist not implemented")
+}
+func (UnimplementedNodeServiceServer) RemoveAllowlist(context.Context, *RemoveAllowlistRequest) (*RemoveAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowlist not implemented")
+}
+func (UnimplementedNodeServiceServer) ListAllowlist(context.Context, *ListAllowlistRequest) (*ListAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowlist not implemented")
+}
 func (UnimplementedNodeServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
 }
@@ -2713,6 +3388,15 @@ func (UnimplementedNodeServiceServer) ReconsiderBlock(context.Context, *Reconsid
 func (UnimplementedNodeServiceServer) RecomputeChainState(context.Context, *RecomputeChainStateRequest) (*RecomputeChainStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecomputeChainState not implemented")
 }
+func (UnimplementedNodeServiceServer) SaveMempool(context.Context, *SaveMempoolRequest) (*SaveMempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveMempool not implemented")
+}
+func (UnimplementedNodeServiceServer) LoadMempool(context.Context, *LoadMempoolRequest) (*LoadMempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadMempool not implemented")
+}
+func (UnimplementedNodeServiceServer) PrioritiseTransaction(context.Context, *PrioritiseTransactionRequest) (*PrioritiseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrioritiseTransaction not implemented")
+}
 func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
 
 // UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2780,6 +3464,120 @@ func _NodeService_GetPeers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_GetNetTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNetTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/GetNetTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNetTotals(ctx, req.(*GetNetTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SubscribeSyncStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSyncStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServiceServer).SubscribeSyncStatus(m, &nodeServiceSubscribeSyncStatusServer{stream})
+}
+
+type NodeService_SubscribeSyncStatusServer interface {
+	Send(*SyncStatusNotification) error
+	grpc.ServerStream
+}
+
+type nodeServiceSubscribeSyncStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeServiceSubscribeSyncStatusServer) Send(m *SyncStatusNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeService_GetChainTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainTipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetChainTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/GetChainTips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetChainTips(ctx, req.(*GetChainTipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/GetForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetForks(ctx, req.(*GetForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SubscribeForks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeForksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServiceServer).SubscribeForks(m, &nodeServiceSubscribeForksServer{stream})
+}
+
+type NodeService_SubscribeForksServer interface {
+	Send(*ForkNotification) error
+	grpc.ServerStream
+}
+
+type nodeServiceSubscribeForksServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeServiceSubscribeForksServer) Send(m *ForkNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _NodeService_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPeerRequest)
 	if err := dec(in); err != nil {
@@ -2834,6 +3632,114 @@ func _NodeService_UnblockPeer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_BlockSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).BlockSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/BlockSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).BlockSubnet(ctx, req.(*BlockSubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_UnblockSubnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).UnblockSubnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/UnblockSubnet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).UnblockSubnet(ctx, req.(*UnblockSubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/ListBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_AddAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).AddAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/AddAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).AddAllowlist(ctx, req.(*AddAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_RemoveAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).RemoveAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/RemoveAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).RemoveAllowlist(ctx, req.(*RemoveAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ListAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ListAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/ListAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ListAllowlist(ctx, req.(*ListAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodeService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetLogLevelRequest)
 	if err := dec(in); err != nil {
@@ -3032,6 +3938,60 @@ func _NodeService_RecomputeChainState_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeService_SaveMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveMempoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SaveMempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/SaveMempool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SaveMempool(ctx, req.(*SaveMempoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_LoadMempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadMempoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).LoadMempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/LoadMempool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).LoadMempool(ctx, req.(*LoadMempoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_PrioritiseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrioritiseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).PrioritiseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.NodeService/PrioritiseTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).PrioritiseTransaction(ctx, req.(*PrioritiseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodeService_ServiceDesc is the grpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3051,6 +4011,22 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeers",
 			Handler:    _NodeService_GetPeers_Handler,
 		},
+		{
+			MethodName: "GetNetTotals",
+			Handler:    _NodeService_GetNetTotals_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _NodeService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetChainTips",
+			Handler:    _NodeService_GetChainTips_Handler,
+		},
+		{
+			MethodName: "GetForks",
+			Handler:    _NodeService_GetForks_Handler,
+		},
 		{
 			MethodName: "AddPeer",
 			Handler:    _NodeService_AddPeer_Handler,
@@ -3063,6 +4039,30 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnblockPeer",
 			Handler:    _NodeService_UnblockPeer_Handler,
 		},
+		{
+			MethodName: "BlockSubnet",
+			Handler:    _NodeService_BlockSubnet_Handler,
+		},
+		{
+			MethodName: "UnblockSubnet",
+			Handler:    _NodeService_UnblockSubnet_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _NodeService_ListBans_Handler,
+		},
+		{
+			MethodName: "AddAllowlist",
+			Handler:    _NodeService_AddAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveAllowlist",
+			Handler:    _NodeService_RemoveAllowlist_Handler,
+		},
+		{
+			MethodName: "ListAllowlist",
+			Handler:    _NodeService_ListAllowlist_Handler,
+		},
 		{
 			MethodName: "SetLogLevel",
 			Handler:    _NodeService_SetLogLevel_Handler,
@@ -3107,7 +4107,30 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecomputeChainState",
 			Handler:    _NodeService_RecomputeChainState_Handler,
 		},
+		{
+			MethodName: "SaveMempool",
+			Handler:    _NodeService_SaveMempool_Handler,
+		},
+		{
+			MethodName: "LoadMempool",
+			Handler:    _NodeService_LoadMempool_Handler,
+		},
+		{
+			MethodName: "PrioritiseTransaction",
+			Handler:    _NodeService_PrioritiseTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSyncStatus",
+			Handler:       _NodeService_SubscribeSyncStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeForks",
+			Handler:       _NodeService_SubscribeForks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ilxrpc.proto",
 }
@@ -10,12 +10,14 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/blockchain/indexers"
+	"github.com/project-illium/ilxd/consensus"
 	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/policy"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/rpc/pb"
+	ilxsync "github.com/project-illium/ilxd/sync"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
@@ -36,6 +38,7 @@ type GrpcServerConfig struct {
 	HTTPServer *http.Server
 
 	Chain                *blockchain.Blockchain
+	Engine               *consensus.ConsensusEngine
 	Network              *net.Network
 	Wallet               *walletlib.Wallet
 	Prover               zk.Prover
@@ -46,9 +49,14 @@ type GrpcServerConfig struct {
 	RequestBlockFunc     func(blockID types.ID, remotePeer peer.ID)
 	AutoStakeFunc        func(bool) error
 	NetworkKeyFunc       func() (crypto.PrivKey, error)
+	SyncStatusFunc       func() *ilxsync.SyncStatus
+	SyncManager          *ilxsync.SyncManager
+	SaveMempoolFunc      func() (int, error)
+	LoadMempoolFunc      func() (int, error)
 	ChainParams          *params.NetworkParams
 	Ds                   repo.Datastore
 	TxMemPool            *mempool.Mempool
+	FeeEstimator         *mempool.FeeEstimator
 	DisableNodeService   bool
 	DisableWalletService bool
 	DisableWalletServer  bool
@@ -64,6 +72,7 @@ type GrpcServer struct {
 	chainParams      *params.NetworkParams
 	ds               repo.Datastore
 	txMemPool        *mempool.Mempool
+	feeEstimator     *mempool.FeeEstimator
 	network          *net.Network
 	policy           *policy.Policy
 	wallet           *walletlib.Wallet
@@ -74,6 +83,10 @@ type GrpcServer struct {
 	requestBlockFunc func(blockID types.ID, remotePeer peer.ID)
 	autoStakeFunc    func(bool) error
 	networkKeyFunc   func() (crypto.PrivKey, error)
+	syncStatusFunc   func() *ilxsync.SyncStatus
+	syncManager      *ilxsync.SyncManager
+	saveMempoolFunc  func() (int, error)
+	loadMempoolFunc  func() (int, error)
 
 	txIndex *indexers.TxIndex
 	wsIndex *indexers.WalletServerIndex
@@ -98,6 +111,7 @@ func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 		chainParams:      cfg.ChainParams,
 		ds:               cfg.Ds,
 		txMemPool:        cfg.TxMemPool,
+		feeEstimator:     cfg.FeeEstimator,
 		network:          cfg.Network,
 		wallet:           cfg.Wallet,
 		prover:           cfg.Prover,
@@ -107,6 +121,10 @@ func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 		requestBlockFunc: cfg.RequestBlockFunc,
 		autoStakeFunc:    cfg.AutoStakeFunc,
 		networkKeyFunc:   cfg.NetworkKeyFunc,
+		syncStatusFunc:   cfg.SyncStatusFunc,
+		syncManager:      cfg.SyncManager,
+		saveMempoolFunc:  cfg.SaveMempoolFunc,
+		loadMempoolFunc:  cfg.LoadMempoolFunc,
 		txIndex:          cfg.TxIndex,
 		policy:           cfg.Policy,
 		httpServer:       cfg.HTTPServer,
@@ -128,6 +146,15 @@ func NewGrpcServer(cfg *GrpcServerConfig) *GrpcServer {
 	}
 
 	s.chain.Subscribe(s.handleBlockchainNotifications)
+	if cfg.Engine != nil {
+		cfg.Engine.Subscribe(s.handleConsensusNotifications)
+	}
+	if cfg.SyncManager != nil {
+		cfg.SyncManager.Subscribe(s.handleSyncNotifications)
+	}
+	if cfg.TxMemPool != nil {
+		cfg.TxMemPool.Subscribe(s.handleMempoolNotifications)
+	}
 
 	return s
 }
@@ -154,6 +181,33 @@ func (s *GrpcServer) handleBlockchainNotifications(n *blockchain.Notification) {
 	}
 }
 
+func (s *GrpcServer) handleConsensusNotifications(n *consensus.Notification) {
+	s.subMtx.RLock()
+	defer s.subMtx.RUnlock()
+
+	for _, sub := range s.subs {
+		sub.C <- n
+	}
+}
+
+func (s *GrpcServer) handleSyncNotifications(n *ilxsync.Notification) {
+	s.subMtx.RLock()
+	defer s.subMtx.RUnlock()
+
+	for _, sub := range s.subs {
+		sub.C <- n
+	}
+}
+
+func (s *GrpcServer) handleMempoolNotifications(n *mempool.Notification) {
+	s.subMtx.RLock()
+	defer s.subMtx.RUnlock()
+
+	for _, sub := range s.subs {
+		sub.C <- n
+	}
+}
+
 func (s *GrpcServer) subscribeEvents() *subscription {
 	sub := &subscription{
 		C:    make(chan interface{}),
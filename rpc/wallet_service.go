@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	icrypto "github.com/project-illium/ilxd/crypto"
+	"github.com/project-illium/ilxd/mempool"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/transactions"
 	"github.com/project-illium/ilxd/zk"
@@ -25,6 +26,10 @@ import (
 	pb "github.com/project-illium/ilxd/rpc/pb"
 )
 
+// defaultFeeTargetBlocks is the confirmation target used for the fee
+// estimate when a wallet RPC is called without a fee per kilobyte.
+const defaultFeeTargetBlocks = 6
+
 // GetBalance returns the combined balance of all addresses in the wallet
 func (s *GrpcServer) GetBalance(ctx context.Context, req *pb.GetBalanceRequest) (*pb.GetBalanceResponse, error) {
 	balance, err := s.wallet.Balance()
@@ -529,7 +534,7 @@ func (s *GrpcServer) CreateRawTransaction(ctx context.Context, req *pb.CreateRaw
 		outputs = append(outputs, rawOut)
 	}
 
-	rawTx, err := s.wallet.CreateRawTransaction(inputs, outputs, req.AppendChangeOutput, types.Amount(req.FeePerKilobyte))
+	rawTx, err := s.wallet.CreateRawTransaction(inputs, outputs, req.AppendChangeOutput, s.feePerKilobyte(req.FeePerKilobyte))
 	if err != nil {
 		return nil, err
 	}
@@ -937,7 +942,7 @@ func (s *GrpcServer) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.Spend
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	txid, err := s.wallet.Spend(addr, types.Amount(req.Amount), types.Amount(req.FeePerKilobyte), commitments...)
+	txid, err := s.wallet.Spend(addr, types.Amount(req.Amount), s.feePerKilobyte(req.FeePerKilobyte), commitments...)
 	if err != nil {
 		return nil, err
 	}
@@ -957,7 +962,7 @@ func (s *GrpcServer) TimelockCoins(ctx context.Context, req *pb.TimelockCoinsReq
 		commitments = append(commitments, types.NewID(c))
 	}
 
-	txid, err := s.wallet.TimelockCoins(types.Amount(req.Amount), time.Unix(req.LockUntil, 0), types.Amount(req.FeePerKilobyte), commitments...)
+	txid, err := s.wallet.TimelockCoins(types.Amount(req.Amount), time.Unix(req.LockUntil, 0), s.feePerKilobyte(req.FeePerKilobyte), commitments...)
 	if err != nil {
 		return nil, err
 	}
@@ -979,13 +984,181 @@ func (s *GrpcServer) SweepWallet(ctx context.Context, req *pb.SweepWalletRequest
 	for _, c := range req.InputCommitments {
 		commitments = append(commitments, types.NewID(c))
 	}
-	txid, err := s.wallet.SweepWallet(addr, types.Amount(req.FeePerKilobyte), commitments...)
+	txid, err := s.wallet.SweepWallet(addr, s.feePerKilobyte(req.FeePerKilobyte), commitments...)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.SweepWalletResponse{Transaction_ID: txid[:]}, nil
 }
 
+// BumpFee replaces an unconfirmed wallet transaction in the mempool with
+// a new transaction paying a higher fee. The replacement spends the same
+// inputs and pays the same amounts to the same external addresses. Any
+// remaining coins are sent to a new change output.
+//
+// For the replacement to be accepted it must pay a higher fee per kilobyte
+// and a higher total fee than the original transaction, and the additional
+// fee must pay for the replacement's own size at the mempool's minimum fee
+// per kilobyte.
+//
+// **Requires wallet to be unlocked**
+func (s *GrpcServer) BumpFee(ctx context.Context, req *pb.BumpFeeRequest) (*pb.BumpFeeResponse, error) {
+	txid := types.NewID(req.Transaction_ID)
+	tx, err := s.txMemPool.GetTransaction(txid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "transaction not found in mempool")
+	}
+	// Once the transaction is being voted on against a conflicting spend
+	// a replacement can no longer become the preferred spend and would
+	// lose the vote.
+	entry, err := s.txMemPool.GetEntry(txid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "transaction not found in mempool")
+	}
+	if entry.Status == mempool.EntryContested {
+		return nil, status.Error(codes.FailedPrecondition, "transaction is contested by a conflicting spend and can no longer be replaced")
+	}
+	if tx.GetStandardTransaction() == nil {
+		return nil, status.Error(codes.InvalidArgument, "only standard transactions can be replaced")
+	}
+
+	walletTxs, err := s.wallet.Transactions()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var (
+		txInputs  []walletlib.IO
+		txOutputs []walletlib.IO
+		found     bool
+	)
+	for _, wtx := range walletTxs {
+		if types.NewID(wtx.Txid[:]) == txid {
+			txInputs, txOutputs, found = wtx.Inputs, wtx.Outputs, true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "transaction does not belong to the wallet")
+	}
+
+	addrs, err := s.wallet.Addresses()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	walletAddrs := make(map[string]bool)
+	for _, addr := range addrs {
+		walletAddrs[addr.String()] = true
+	}
+
+	// Outputs paying the wallet's own addresses are treated as change
+	// and replaced by the new change output.
+	outputs := make([]*pb.CreateRawTransactionRequest_Output, 0, len(txOutputs))
+	for _, out := range txOutputs {
+		txio, ok := out.(*walletlib.TxIO)
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "transaction outputs are unknown to the wallet")
+		}
+		if walletAddrs[txio.Address.String()] {
+			continue
+		}
+		outputs = append(outputs, &pb.CreateRawTransactionRequest_Output{
+			Address: txio.Address.String(),
+			Amount:  uint64(txio.Amount),
+		})
+	}
+	if len(outputs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transaction does not pay any external address")
+	}
+
+	commitments := req.InputCommitments
+	if len(commitments) == 0 {
+		notes, err := s.wallet.Notes()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		used := make(map[int]bool)
+		for _, in := range txInputs {
+			txio, ok := in.(*walletlib.TxIO)
+			if !ok {
+				return nil, status.Error(codes.FailedPrecondition, "transaction inputs are unknown to the wallet")
+			}
+			matched := false
+			for i, note := range notes {
+				if !used[i] && note.Address == txio.Address.String() && note.Amount == uint64(txio.Amount) {
+					used[i] = true
+					matched = true
+					commitments = append(commitments, note.Commitment)
+					break
+				}
+			}
+			if !matched {
+				return nil, status.Error(codes.FailedPrecondition, "transaction input not found in wallet. try again with the input commitments.")
+			}
+		}
+	}
+	inputs := make([]*pb.CreateRawTransactionRequest_Input, 0, len(commitments))
+	for _, c := range commitments {
+		inputs = append(inputs, &pb.CreateRawTransactionRequest_Input{
+			CommitmentOrPrivateInput: &pb.CreateRawTransactionRequest_Input_Commitment{
+				Commitment: c,
+			},
+		})
+	}
+
+	fpkb := types.Amount(req.FeePerKilobyte)
+	origFpkb, _, err := mempool.CalcFeePerKilobyte(tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if fpkb == 0 {
+		fpkb = origFpkb + s.txMemPool.MinFeePerKilobyte()
+	}
+	if fpkb <= origFpkb {
+		return nil, status.Error(codes.InvalidArgument, "fee per kilobyte must be greater than the original transaction")
+	}
+
+	rawResp, err := s.CreateRawTransaction(ctx, &pb.CreateRawTransactionRequest{
+		Inputs:             inputs,
+		Outputs:            outputs,
+		AppendChangeOutput: true,
+		FeePerKilobyte:     uint64(fpkb),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The replacement must spend all the nullifiers of the original
+	// otherwise both transactions could end up in the chain.
+	spent := make(map[types.Nullifier]bool)
+	for _, n := range rawResp.RawTx.Tx.Nullifiers() {
+		spent[n] = true
+	}
+	for _, n := range tx.Nullifiers() {
+		if !spent[n] {
+			return nil, status.Error(codes.FailedPrecondition, "replacement does not spend the inputs of the original transaction")
+		}
+	}
+
+	proveResp, err := s.ProveRawTransaction(ctx, &pb.ProveRawTransactionRequest{
+		RawTx: rawResp.RawTx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.broadcastTxFunc(proveResp.ProvedTx); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	newID := proveResp.ProvedTx.ID()
+
+	// The replacement is voted on against the original. It only wins if
+	// it replaced the original as the preferred spend in the pool.
+	entry, err = s.txMemPool.GetEntry(newID)
+	if err == nil && entry.Status == mempool.EntryContested {
+		return nil, status.Error(codes.FailedPrecondition, "replacement was broadcast but the original transaction remains the preferred spend")
+	}
+	return &pb.BumpFeeResponse{Transaction_ID: newID[:]}, nil
+}
+
 // SubscribeWalletTransactions subscribes to a stream of WalletTransactionsNotifications that return
 // whenever a transaction belonging to the wallet finalizes.
 func (s *GrpcServer) SubscribeWalletTransactions(req *pb.SubscribeWalletTransactionsRequest, stream pb.WalletService_SubscribeWalletTransactionsServer) error {
@@ -1062,3 +1235,18 @@ func ioToPBio(ios []walletlib.IO) []*pb.WalletTransaction_IO {
 	}
 	return ret
 }
+
+// feePerKilobyte returns the requested fee per kilobyte or, if zero,
+// the fee estimate for defaultFeeTargetBlocks. If there is no fee
+// estimator zero is returned and the wallet will use its internal
+// fee policy.
+func (s *GrpcServer) feePerKilobyte(requested uint64) types.Amount {
+	if requested != 0 || s.feeEstimator == nil {
+		return types.Amount(requested)
+	}
+	fpkb, err := s.feeEstimator.EstimateFeePerKilobyte(defaultFeeTargetBlocks)
+	if err != nil {
+		return 0
+	}
+	return fpkb
+}
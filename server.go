@@ -34,6 +34,8 @@ import (
 	"github.com/project-illium/walletlib"
 	"github.com/project-illium/walletlib/client"
 	"github.com/pterm/pterm"
+	"os"
+	"path"
 	"sort"
 	stdsync "sync"
 	"time"
@@ -43,6 +45,10 @@ const (
 	maxOrphanDuration     = time.Hour
 	maxOrphans            = 100
 	orphanResyncThreshold = 5
+
+	// mempoolFilename is the name of the file in the data directory
+	// the mempool is saved to on shutdown.
+	mempoolFilename = "mempool.dat"
 )
 
 var log = logger.DisabledLogger.WithLevel(pterm.LogLevelDisabled)
@@ -64,6 +70,7 @@ type Server struct {
 	network      *net.Network
 	blockchain   *blockchain.Blockchain
 	mempool      *mempool.Mempool
+	feeEstimator *mempool.FeeEstimator
 	engine       *consensus.ConsensusEngine
 	chainService *sync.ChainService
 	syncManager  *sync.SyncManager
@@ -89,6 +96,13 @@ type Server struct {
 	coinbasesToStake map[types.ID]struct{}
 	networkKey       crypto.PrivKey
 
+	// mempoolLoad tracks the loading of the saved mempool at startup
+	// and mempoolLoaded is closed once it finishes. If Close is called
+	// before then the load is cancelled and the saved mempool is not
+	// overwritten.
+	mempoolLoad   stdsync.WaitGroup
+	mempoolLoaded chan struct{}
+
 	ready chan struct{}
 }
 
@@ -140,6 +154,16 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		netParams = &params.MainnetParams
 	}
 
+	var psk []byte
+	if config.NetworkPSK != "" {
+		var err error
+		psk, err = hex.DecodeString(config.NetworkPSK)
+		if err != nil || len(psk) != 32 {
+			return nil, errors.New("networkpsk must be a hex encoded 32 byte key")
+		}
+		netParams = netParams.PrivateNetwork(psk)
+	}
+
 	var (
 		prover   zk.Prover
 		verifier zk.Verifier
@@ -311,6 +335,13 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		listenAddrs = netParams.ListenAddrs
 	}
 
+	// Fee estimator
+	_, bestHeight, _ := chain.BestBlock()
+	feeEstimator, err := mempool.NewFeeEstimator(ds, bestHeight, s.minFeePerKilobyte)
+	if err != nil {
+		return nil, err
+	}
+
 	// Mempool
 	mempoolOpts := []mempool.Option{
 		mempool.SignatureCache(sigCache),
@@ -320,6 +351,11 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		mempool.MinStake(policy.GetMinStake()),
 		mempool.FeePerKilobyte(policy.GetMinFeePerKilobyte()),
 		mempool.Verifier(verifier),
+		mempool.ConflictHandler(s.handleConflictingTransactions),
+		mempool.TransactionTTL(config.MempoolExpiry),
+		mempool.MaxSize(int(config.MaxMempool) << 20),
+		mempool.Estimator(feeEstimator),
+		mempool.Datastore(ds),
 	}
 
 	mpool, err := mempool.NewMempool(mempoolOpts...)
@@ -337,13 +373,44 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		net.Params(netParams),
 		net.BlockValidator(s.handleIncomingBlock),
 		net.MempoolValidator(s.processMempoolTransaction),
+		net.MempoolFetcher(mpool.GetTransaction),
+		net.MempoolTxids(mpool.GetTxids),
 		net.MaxBanscore(config.MaxBanscore),
 		net.BanDuration(config.BanDuration),
 		net.MaxMessageSize(config.Policy.MaxMessageSize),
+		net.MaxPeers(config.ConnMgr.MaxPeers),
+		net.MaxInboundPeers(config.ConnMgr.MaxInbound),
+		net.MinOutboundPeers(config.ConnMgr.MinOutbound),
+		net.ConnManagerGracePeriod(config.ConnMgr.GracePeriod),
+		net.ConnManagerSilencePeriod(config.ConnMgr.SilencePeriod),
+	}
+	if config.ConnMgr.ResourceLimitsFile != "" {
+		networkOpts = append(networkOpts, net.ResourceLimitsFile(repo.CleanAndExpandPath(config.ConnMgr.ResourceLimitsFile)))
 	}
 	if config.DisableNATPortMap {
 		networkOpts = append(networkOpts, net.DisableNatPortMap())
 	}
+	if config.EnableMDNS {
+		networkOpts = append(networkOpts, net.EnableMDNS())
+	}
+	if config.EnableWebsocket {
+		networkOpts = append(networkOpts, net.EnableWebsocket())
+	}
+	if config.EnableWebTransport {
+		networkOpts = append(networkOpts, net.EnableWebTransport())
+	}
+	if config.RelayService {
+		networkOpts = append(networkOpts, net.EnableRelayService())
+	}
+	if len(config.StaticRelays) > 0 {
+		networkOpts = append(networkOpts, net.StaticRelays(config.StaticRelays))
+	}
+	if config.NoHolePunching {
+		networkOpts = append(networkOpts, net.DisableHolePunching())
+	}
+	if psk != nil {
+		networkOpts = append(networkOpts, net.PrivateNetwork(psk))
+	}
 	hostID, err := peer.IDFromPrivateKey(privKey)
 	if err != nil {
 		return nil, err
@@ -365,6 +432,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		consensus.ValidatorConnector(valConn),
 		consensus.Chooser(chain),
 		consensus.RequestBlock(s.requestBlock),
+		consensus.RequestTx(network.RequestTransaction),
 		consensus.GetBlockID(chain.GetBlockIDByHeight),
 		consensus.PeerID(network.Host().ID()),
 	}...)
@@ -372,8 +440,40 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		return nil, err
 	}
 
+	chainServiceOpts := []sync.ChainServiceOption{
+		sync.RateLimits(sync.RateLimitConfig{
+			BlockRequestsPerMinute:  config.RateLimits.BlockRequestsPerMinute,
+			QueryRequestsPerMinute:  config.RateLimits.QueryRequestsPerMinute,
+			StreamRequestsPerMinute: config.RateLimits.StreamRequestsPerMinute,
+			MaxConcurrentStreams:    config.RateLimits.MaxConcurrentStreams,
+		}),
+	}
+	if wsIndex != nil && config.WSIndexServeProofs {
+		chainServiceOpts = append(chainServiceOpts, sync.InclusionProofs(wsIndex.GetTxoProof))
+	}
+
+	chainService, err := sync.NewChainService(ctx, s.fetchBlock, chain, network, netParams, chainServiceOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	syncManager := sync.NewSyncManager(&sync.SyncManagerConfig{
+		Ctx:               ctx,
+		Chain:             chain,
+		Network:           network,
+		Params:            netParams,
+		CS:                chainService,
+		Chooser:           s.consensusChoose,
+		IsCurrentCallback: s.handleCurrentStatusChange,
+		ProofCache:        proofCache,
+		SigCache:          sigCache,
+		Verifier:          verifier,
+		Datastore:         ds,
+	})
+
 	grpcServer, err := newGrpcServer(config.RPCOpts, &rpc.GrpcServerConfig{
 		Chain:                chain,
+		Engine:               engine,
 		Network:              network,
 		Policy:               policy,
 		Wallet:               wallet,
@@ -384,9 +484,14 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		RequestBlockFunc:     s.requestBlock,
 		AutoStakeFunc:        s.setAutostake,
 		NetworkKeyFunc:       s.getNetworkKey,
+		SyncStatusFunc:       s.getSyncStatus,
+		SyncManager:          syncManager,
+		SaveMempoolFunc:      s.saveMempool,
+		LoadMempoolFunc:      s.loadMempool,
 		ChainParams:          netParams,
 		Ds:                   ds,
 		TxMemPool:            mpool,
+		FeeEstimator:         feeEstimator,
 		TxIndex:              txIndex,
 		WSIndex:              wsIndex,
 		DisableNodeService:   config.RPCOpts.DisableNodeService,
@@ -401,6 +506,7 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		gen.Blockchain(chain),
 		gen.PrivateKey(privKey),
 		gen.Mempool(mpool),
+		gen.Policy(policy),
 		gen.BroadcastFunc(network.BroadcastBlock),
 	}...)
 	if err != nil {
@@ -412,11 +518,6 @@ func BuildServer(config *repo.Config) (*Server, error) {
 		return nil, err
 	}
 
-	s.chainService, err = sync.NewChainService(ctx, s.fetchBlock, chain, network, netParams)
-	if err != nil {
-		return nil, err
-	}
-
 	s.ctx = ctx
 	s.cancelFunc = cancel
 	s.config = config
@@ -424,20 +525,11 @@ func BuildServer(config *repo.Config) (*Server, error) {
 	s.ds = ds
 	s.network = network
 	s.mempool = mpool
+	s.feeEstimator = feeEstimator
 	s.blockchain = chain
 	s.engine = engine
-	s.syncManager = sync.NewSyncManager(&sync.SyncManagerConfig{
-		Ctx:               ctx,
-		Chain:             chain,
-		Network:           network,
-		Params:            netParams,
-		CS:                s.chainService,
-		Chooser:           s.consensusChoose,
-		IsCurrentCallback: s.handleCurrentStatusChange,
-		ProofCache:        proofCache,
-		SigCache:          sigCache,
-		Verifier:          verifier,
-	})
+	s.chainService = chainService
+	s.syncManager = syncManager
 	s.orphanBlocks = make(map[types.ID]*orphanBlock)
 	s.activeInventory = make(map[types.ID]*blocks.Block)
 	s.submittedTxs = make(map[types.ID]struct{})
@@ -463,6 +555,26 @@ func BuildServer(config *repo.Config) (*Server, error) {
 
 	go s.syncManager.Start()
 
+	s.mempoolLoaded = make(chan struct{})
+	if !config.NoPersistMempool {
+		s.mempoolLoad.Add(1)
+		go func() {
+			defer s.mempoolLoad.Done()
+			n, err := s.loadMempool()
+			if errors.Is(err, context.Canceled) {
+				return
+			}
+			close(s.mempoolLoaded)
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				log.WithCaller(true).Error("Error loading saved mempool", log.Args("error", err))
+				return
+			}
+			if n > 0 {
+				log.Info("Loaded saved mempool", log.Args("transactions", n))
+			}
+		}()
+	}
+
 	// If we are the genesis validator then start generating immediately.
 	_, height, _ := chain.BestBlock()
 	if height == 0 {
@@ -493,6 +605,40 @@ func (s *Server) processMempoolTransaction(tx *transactions.Transaction) error {
 	return s.mempool.ProcessTransaction(tx)
 }
 
+func (s *Server) handleConflictingTransactions(txs []*transactions.Transaction) {
+	<-s.ready
+
+	for _, tx := range txs {
+		txid := tx.ID()
+		log.Debug("Conflicting transaction passed to consensus", log.ArgsFromMap(map[string]any{
+			"txid": txid.String(),
+		}))
+
+		callback := make(chan consensus.Status)
+		s.engine.NewTransaction(txid, tx.Nullifiers(), true, callback)
+
+		go func(id types.ID) {
+			select {
+			case status := <-callback:
+				switch status {
+				case consensus.StatusFinalized:
+					log.Debug("Conflicting transaction finalized", log.Args("txid", id.String()))
+					s.mempool.ConflictFinalized(id)
+				case consensus.StatusRejected:
+					log.Debug("Conflicting transaction rejected by consensus", log.Args("txid", id.String()))
+					s.mempool.ConflictRejected(id)
+				case consensus.StatusPreferred:
+					// The vote expired without finalizing. The preferred
+					// spend is left in the mempool until its TTL expires.
+					log.Debug("Conflicting transaction vote expired", log.Args("txid", id.String()))
+				}
+			case <-s.ctx.Done():
+				return
+			}
+		}(txid)
+	}
+}
+
 func (s *Server) submitTransaction(tx *transactions.Transaction) error {
 	<-s.ready
 
@@ -500,9 +646,8 @@ func (s *Server) submitTransaction(tx *transactions.Transaction) error {
 	s.submittedTxs[tx.ID()] = struct{}{}
 	s.submittedTxsLock.Unlock()
 
-	// Pubsub has the mempool validation handler register on it, so function
-	// will submit it to the mempool, validate it, and return an error if
-	// validation fails.
+	// The network will submit it to the mempool, validate it, and return
+	// an error if validation fails before relaying it to peers.
 	return s.network.BroadcastTransaction(tx)
 }
 
@@ -510,6 +655,36 @@ func (s *Server) getNetworkKey() (crypto.PrivKey, error) {
 	return repo.LoadNetworkKey(s.ds)
 }
 
+// saveMempool writes the mempool to the data directory and returns
+// the number of transactions saved.
+func (s *Server) saveMempool() (int, error) {
+	<-s.ready
+	return s.mempool.Save(path.Join(s.config.DataDir, mempoolFilename))
+}
+
+// loadMempool loads the mempool saved in the data directory and returns
+// the number of transactions accepted.
+func (s *Server) loadMempool() (int, error) {
+	<-s.ready
+	return s.mempool.Load(s.ctx, path.Join(s.config.DataDir, mempoolFilename))
+}
+
+// minFeePerKilobyte returns the lowest fee per kilobyte a transaction
+// can pay and still be accepted into our mempool.
+func (s *Server) minFeePerKilobyte() types.Amount {
+	<-s.ready
+	fpkb := s.policy.GetMinFeePerKilobyte()
+	if mempoolFee := s.mempool.MinFeePerKilobyte(); mempoolFee > fpkb {
+		fpkb = mempoolFee
+	}
+	return fpkb
+}
+
+func (s *Server) getSyncStatus() *sync.SyncStatus {
+	<-s.ready
+	return s.syncManager.SyncStatus()
+}
+
 func (s *Server) handleIncomingBlock(xThinnerBlk *blocks.XThinnerBlock, p peer.ID) error {
 	<-s.ready
 	_, height, _ := s.blockchain.BestBlock()
@@ -546,6 +721,7 @@ func (s *Server) handleBlockchainNotification(ntf *blockchain.Notification) {
 	case blockchain.NTBlockConnected:
 		if blk, ok := ntf.Data.(*blocks.Block); ok {
 			s.mempool.RemoveBlockTransactions(blk.Transactions)
+			s.feeEstimator.ProcessBlock(blk.Header.Height, blk.Transactions)
 
 			s.autoStakeLock.RLock()
 			toStake := make(map[types.ID]struct{})
@@ -996,9 +1172,25 @@ func (s *Server) handleCurrentStatusChange() {
 func (s *Server) Close() error {
 	<-s.ready
 	s.cancelFunc()
+	s.mempoolLoad.Wait()
 	s.generator.Close()
 	s.syncManager.Close()
 	s.engine.Close()
+	if !s.config.NoPersistMempool {
+		select {
+		case <-s.mempoolLoaded:
+			if n, err := s.saveMempool(); err != nil {
+				log.WithCaller(true).Error("Error saving mempool", log.Args("error", err))
+			} else {
+				log.Info("Saved mempool", log.Args("transactions", n))
+			}
+		default:
+			log.Info("Not saving mempool as the saved mempool was not finished loading")
+		}
+	}
+	if err := s.feeEstimator.Save(); err != nil {
+		log.WithCaller(true).Error("Error saving fee estimator", log.Args("error", err))
+	}
 	s.mempool.Close()
 	s.wallet.Close()
 	if err := s.blockchain.Close(); err != nil {
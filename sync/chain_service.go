@@ -26,16 +26,28 @@ import (
 
 const (
 	ChainServiceProtocol        = "/chainservice/"
-	ChainServiceProtocolVersion = "1.0.0"
+	ChainServiceProtocolVersion = "1.1.0"
+
+	// ChainServiceLegacyProtocolVersion is the protocol version prior to
+	// the addition of the MsgStreamResp status message at the start of each
+	// stream. It is still served and dialed so that nodes can sync from and
+	// serve peers that have not yet upgraded.
+	ChainServiceLegacyProtocolVersion = "1.0.0"
 
 	maxBatchSize = 2000
 )
 
 var ErrNotCurrent = errors.New("peer not current")
 var ErrNotFound = errors.New("not found")
+var ErrRateLimited = errors.New("rate limited by peer")
 
 type FetchBlockFunc func(blockID types.ID) (*blocks.Block, error)
 
+// InclusionProofFunc returns the inclusion proof for the commitment along
+// with the accumulator root the proof is valid for and the ID of the block
+// at which the root was computed.
+type InclusionProofFunc func(commitment types.ID) (*blockchain.InclusionProof, types.ID, types.ID, error)
+
 type ChainService struct {
 	ctx        context.Context
 	network    *net.Network
@@ -43,16 +55,46 @@ type ChainService struct {
 	fetchBlock FetchBlockFunc
 	chain      *blockchain.Blockchain
 	ms         net.MessageSender
+	limiter    *rateLimiter
+	getProof   InclusionProofFunc
 }
 
-func NewChainService(ctx context.Context, fetchBlock FetchBlockFunc, chain *blockchain.Blockchain, network *net.Network, params *params.NetworkParams) (*ChainService, error) {
+// ChainServiceOption is a configuration option for the ChainService.
+type ChainServiceOption func(cs *ChainService)
+
+// RateLimits sets the per-peer quotas for requests served by
+// the ChainService. If not set DefaultRateLimits is used.
+func RateLimits(cfg RateLimitConfig) ChainServiceOption {
+	return func(cs *ChainService) {
+		cs.limiter = newRateLimiter(cfg)
+	}
+}
+
+// InclusionProofs sets the source of the inclusion proofs served in
+// response to GetInclusionProof requests. If not set the ChainService
+// responds to all such requests with NotFound.
+//
+// Any peer can query the source for arbitrary commitments so it should
+// only be set if the node operator is OK with revealing which commitments
+// the source is tracking.
+func InclusionProofs(getProof InclusionProofFunc) ChainServiceOption {
+	return func(cs *ChainService) {
+		cs.getProof = getProof
+	}
+}
+
+func NewChainService(ctx context.Context, fetchBlock FetchBlockFunc, chain *blockchain.Blockchain, network *net.Network, params *params.NetworkParams, opts ...ChainServiceOption) (*ChainService, error) {
 	cs := &ChainService{
 		ctx:        ctx,
 		network:    network,
 		fetchBlock: fetchBlock,
 		chain:      chain,
 		params:     params,
-		ms:         net.NewMessageSender(network.Host(), params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion),
+		ms:         net.NewMessageSender(network.Host(), params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion, params.ProtocolPrefix+ChainServiceProtocol+ChainServiceLegacyProtocolVersion),
+		limiter:    newRateLimiter(DefaultRateLimits),
+	}
+	for _, opt := range opts {
+		opt(cs)
 	}
 	pruned, err := chain.IsPruned()
 	if err != nil {
@@ -60,6 +102,7 @@ func NewChainService(ctx context.Context, fetchBlock FetchBlockFunc, chain *bloc
 	}
 	if !pruned {
 		cs.network.Host().SetStreamHandler(cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion, cs.HandleNewStream)
+		cs.network.Host().SetStreamHandler(cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceLegacyProtocolVersion, cs.HandleNewStream)
 	}
 	return cs, nil
 }
@@ -73,6 +116,7 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 	contextReader := ctxio.NewReader(cs.ctx, s)
 	reader := msgio.NewVarintReaderSize(contextReader, 1<<23)
 	remotePeer := s.Conn().RemotePeer()
+	legacy := s.Protocol() == cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceLegacyProtocolVersion
 	defer reader.Close()
 	ticker := time.NewTicker(time.Minute)
 
@@ -111,6 +155,24 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 		}
 		reader.ReleaseMsg(msgBytes)
 
+		if resp, ok := cs.checkRateLimit(remotePeer, req); !ok {
+			log.Debug("Peer exceeded chain service rate limit", log.ArgsFromMap(map[string]any{
+				"peer": remotePeer,
+			}))
+			cs.network.IncreaseBanscore(remotePeer, 0, rateLimitBanscore)
+			if _, ok := resp.(*wire.MsgStreamResp); ok && legacy {
+				// Legacy peers don't expect a status message
+				// so we just reset the stream.
+				s.Reset()
+				return
+			}
+			if err := net.WriteMsg(s, resp); err != nil {
+				s.Reset()
+				return
+			}
+			continue
+		}
+
 		var resp proto.Message
 		switch m := req.Msg.(type) {
 		case *wire.MsgChainServiceRequest_GetBlockTxs:
@@ -123,8 +185,13 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 			resp, err = cs.handleGetBlockID(m.GetBlockId)
 		case *wire.MsgChainServiceRequest_GetBest:
 			resp, err = cs.handleGetBest(m.GetBest)
+		case *wire.MsgChainServiceRequest_GetAccumulatorCheckpoint:
+			resp, err = cs.handleGetAccumulatorCheckpoint(m.GetAccumulatorCheckpoint)
+		case *wire.MsgChainServiceRequest_GetInclusionProof:
+			resp, err = cs.handleGetInclusionProof(m.GetInclusionProof)
 		case *wire.MsgChainServiceRequest_GetHeadersStream:
-			err = cs.handleGetHeadersStream(m.GetHeadersStream, s)
+			err = cs.handleGetHeadersStream(m.GetHeadersStream, s, legacy)
+			cs.limiter.releaseStream(remotePeer)
 			if err != nil {
 				log.WithCaller(true).Error("Error sending header response to peer", log.ArgsFromMap(map[string]any{
 					"peer":  remotePeer,
@@ -134,7 +201,8 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 				return
 			}
 		case *wire.MsgChainServiceRequest_GetBlockTxsStream:
-			err = cs.handleGetBlockTxsStream(m.GetBlockTxsStream, s)
+			err = cs.handleGetBlockTxsStream(m.GetBlockTxsStream, s, legacy)
+			cs.limiter.releaseStream(remotePeer)
 			if err != nil {
 				log.WithCaller(true).Error("Error sending block txs response to peer", log.ArgsFromMap(map[string]any{
 					"peer":  remotePeer,
@@ -143,6 +211,17 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 				s.Reset()
 				return
 			}
+		case *wire.MsgChainServiceRequest_GetCompressedBlocksStream:
+			err = cs.handleGetCompressedBlocksStream(m.GetCompressedBlocksStream, s, legacy)
+			cs.limiter.releaseStream(remotePeer)
+			if err != nil {
+				log.WithCaller(true).Error("Error sending compressed blocks response to peer", log.ArgsFromMap(map[string]any{
+					"peer":  remotePeer,
+					"error": err,
+				}))
+				s.Reset()
+				return
+			}
 		}
 		if err != nil {
 			log.WithCaller(true).Error("Error handling chain service message", log.ArgsFromMap(map[string]any{
@@ -166,6 +245,59 @@ func (cs *ChainService) handleNewMessage(s inet.Stream) {
 	}
 }
 
+// checkRateLimit returns whether the request is within the peer's
+// quota. If it is not, the returned message is the RateLimited error
+// response to send to the peer.
+//
+// For stream requests within quota this also acquires one of the
+// peer's concurrent stream slots which must be released after the
+// stream is served.
+func (cs *ChainService) checkRateLimit(p peer.ID, req *wire.MsgChainServiceRequest) (proto.Message, bool) {
+	switch req.Msg.(type) {
+	case *wire.MsgChainServiceRequest_GetBlockTxs:
+		if !cs.limiter.allow(p, requestGetBlockTxs) {
+			return &wire.MsgBlockTxsResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetBlockTxids:
+		if !cs.limiter.allow(p, requestGetBlockTxids) {
+			return &wire.MsgBlockTxidsResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetBlock:
+		if !cs.limiter.allow(p, requestGetBlock) {
+			return &wire.MsgBlockResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetBlockId:
+		if !cs.limiter.allow(p, requestGetBlockID) {
+			return &wire.MsgGetBlockIDResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetBest:
+		if !cs.limiter.allow(p, requestGetBest) {
+			return &wire.MsgGetBestResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetHeadersStream:
+		if !cs.limiter.allow(p, requestGetHeadersStream) || !cs.limiter.acquireStream(p) {
+			return &wire.MsgStreamResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetBlockTxsStream:
+		if !cs.limiter.allow(p, requestGetBlockTxsStream) || !cs.limiter.acquireStream(p) {
+			return &wire.MsgStreamResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetCompressedBlocksStream:
+		if !cs.limiter.allow(p, requestGetCompressedBlocksStream) || !cs.limiter.acquireStream(p) {
+			return &wire.MsgStreamResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetAccumulatorCheckpoint:
+		if !cs.limiter.allow(p, requestGetAccumulatorCheckpoint) {
+			return &wire.MsgAccumulatorCheckpointResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	case *wire.MsgChainServiceRequest_GetInclusionProof:
+		if !cs.limiter.allow(p, requestGetInclusionProof) {
+			return &wire.MsgInclusionProofResp{Error: wire.ErrorResponse_RateLimited}, false
+		}
+	}
+	return nil, true
+}
+
 func (cs *ChainService) GetBlockTxs(p peer.ID, blockID types.ID, txIndexes []uint32) ([]*transactions.Transaction, error) {
 	var (
 		req = &wire.MsgChainServiceRequest{
@@ -182,6 +314,9 @@ func (cs *ChainService) GetBlockTxs(p peer.ID, blockID types.ID, txIndexes []uin
 	if err != nil {
 		return nil, err
 	}
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return nil, ErrRateLimited
+	}
 	if resp.Error != wire.ErrorResponse_None {
 		return nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
 	}
@@ -229,6 +364,9 @@ func (cs *ChainService) GetBlockTxids(p peer.ID, blockID types.ID) ([]types.ID,
 	if err != nil {
 		return nil, err
 	}
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return nil, ErrRateLimited
+	}
 	if resp.Error != wire.ErrorResponse_None {
 		return nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
 	}
@@ -275,6 +413,9 @@ func (cs *ChainService) GetBlock(p peer.ID, blockID types.ID) (*blocks.Block, er
 	if err != nil {
 		return nil, err
 	}
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return nil, ErrRateLimited
+	}
 	if resp.Error != wire.ErrorResponse_None {
 		return nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
 	}
@@ -319,6 +460,10 @@ func (cs *ChainService) GetBlockID(p peer.ID, height uint32) (types.ID, error) {
 		return types.ID{}, ErrNotFound
 	}
 
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return types.ID{}, ErrRateLimited
+	}
+
 	if resp.Error != wire.ErrorResponse_None {
 		return types.ID{}, fmt.Errorf("error response from peer: %s", resp.GetError().String())
 	}
@@ -339,6 +484,57 @@ func (cs *ChainService) handleGetBlockID(req *wire.GetBlockIDReq) (*wire.MsgGetB
 	return resp, nil
 }
 
+// writeStreamResp writes the MsgStreamResp that precedes the stream items
+// unless the stream was opened with the legacy protocol version.
+func writeStreamResp(s inet.Stream, legacy bool) error {
+	if legacy {
+		return nil
+	}
+	if err := net.WriteMsg(s, &wire.MsgStreamResp{}); err != nil {
+		s.Close()
+		return err
+	}
+	return nil
+}
+
+// openStream opens a new stream to the peer, writes the stream request,
+// and reads the server's MsgStreamResp. The returned reader should be
+// used to read the stream items.
+//
+// If the peer only supports the legacy protocol version there is no
+// MsgStreamResp to read and the items follow the request directly.
+func (cs *ChainService) openStream(p peer.ID, req *wire.MsgChainServiceRequest) (inet.Stream, msgio.ReadCloser, error) {
+	legacyProtocol := cs.params.ProtocolPrefix + ChainServiceProtocol + ChainServiceLegacyProtocolVersion
+	s, err := cs.network.Host().NewStream(context.Background(), p, cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion, legacyProtocol)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := net.WriteMsg(s, req); err != nil {
+		s.Reset()
+		return nil, nil, err
+	}
+
+	reader := msgio.NewVarintReaderSize(s, 1<<23)
+	if s.Protocol() == legacyProtocol {
+		return s, reader, nil
+	}
+	resp := new(wire.MsgStreamResp)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	if err := net.ReadMsg(ctx, reader, resp); err != nil {
+		s.Reset()
+		return nil, nil, err
+	}
+	if resp.Error != wire.ErrorResponse_None {
+		s.Close()
+		if resp.Error == wire.ErrorResponse_RateLimited {
+			return nil, nil, ErrRateLimited
+		}
+		return nil, nil, fmt.Errorf("error response from peer: %s", resp.GetError().String())
+	}
+	return s, reader, nil
+}
+
 func (cs *ChainService) GetHeadersStream(p peer.ID, startHeight uint32) (<-chan *blocks.BlockHeader, error) {
 	req := &wire.MsgChainServiceRequest{
 		Msg: &wire.MsgChainServiceRequest_GetHeadersStream{
@@ -348,11 +544,7 @@ func (cs *ChainService) GetHeadersStream(p peer.ID, startHeight uint32) (<-chan
 		},
 	}
 
-	s, err := cs.network.Host().NewStream(context.Background(), p, cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion)
-	if err != nil {
-		return nil, err
-	}
-	err = net.WriteMsg(s, req)
+	s, reader, err := cs.openStream(p, req)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +552,6 @@ func (cs *ChainService) GetHeadersStream(p peer.ID, startHeight uint32) (<-chan
 	ch := make(chan *blocks.BlockHeader)
 
 	go func() {
-		reader := msgio.NewVarintReaderSize(s, 1<<23)
 		for {
 			header := new(blocks.BlockHeader)
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
@@ -377,7 +568,11 @@ func (cs *ChainService) GetHeadersStream(p peer.ID, startHeight uint32) (<-chan
 	return ch, nil
 }
 
-func (cs *ChainService) handleGetHeadersStream(req *wire.GetHeadersStreamReq, s inet.Stream) error {
+func (cs *ChainService) handleGetHeadersStream(req *wire.GetHeadersStreamReq, s inet.Stream, legacy bool) error {
+	if err := writeStreamResp(s, legacy); err != nil {
+		return err
+	}
+
 	_, bestHeight, _ := cs.chain.BestBlock()
 
 	endHeight := req.StartHeight + maxBatchSize - 1
@@ -408,11 +603,7 @@ func (cs *ChainService) GetBlockTxsStream(p peer.ID, startHeight uint32) (<-chan
 		},
 	}
 
-	s, err := cs.network.Host().NewStream(context.Background(), p, cs.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion)
-	if err != nil {
-		return nil, err
-	}
-	err = net.WriteMsg(s, req)
+	s, reader, err := cs.openStream(p, req)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +611,6 @@ func (cs *ChainService) GetBlockTxsStream(p peer.ID, startHeight uint32) (<-chan
 	ch := make(chan *blocks.BlockTxs)
 
 	go func() {
-		reader := msgio.NewVarintReaderSize(s, 1<<23)
 		for {
 			txs := new(blocks.BlockTxs)
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
@@ -437,7 +627,11 @@ func (cs *ChainService) GetBlockTxsStream(p peer.ID, startHeight uint32) (<-chan
 	return ch, nil
 }
 
-func (cs *ChainService) handleGetBlockTxsStream(req *wire.GetBlockTxsStreamReq, s inet.Stream) error {
+func (cs *ChainService) handleGetBlockTxsStream(req *wire.GetBlockTxsStreamReq, s inet.Stream, legacy bool) error {
+	if err := writeStreamResp(s, legacy); err != nil {
+		return err
+	}
+
 	_, bestHeight, _ := cs.chain.BestBlock()
 
 	endHeight := req.StartHeight + maxBatchSize - 1
@@ -458,6 +652,230 @@ func (cs *ChainService) handleGetBlockTxsStream(req *wire.GetBlockTxsStreamReq,
 	return s.Close()
 }
 
+// GetCompressedBlocksStream returns a stream of compressed blocks starting
+// at startHeight. A compressed block contains only the txids, nullifiers,
+// and outputs of each transaction which is all a wallet needs to scan the
+// chain for its outputs and spends. At most maxBatchSize blocks are served
+// per stream.
+func (cs *ChainService) GetCompressedBlocksStream(p peer.ID, startHeight uint32) (<-chan *blocks.CompressedBlock, error) {
+	req := &wire.MsgChainServiceRequest{
+		Msg: &wire.MsgChainServiceRequest_GetCompressedBlocksStream{
+			GetCompressedBlocksStream: &wire.GetCompressedBlocksStreamReq{
+				StartHeight: startHeight,
+			},
+		},
+	}
+
+	s, reader, err := cs.openStream(p, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *blocks.CompressedBlock)
+
+	go func() {
+		for {
+			blk := new(blocks.CompressedBlock)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			if err := net.ReadMsg(ctx, reader, blk); err != nil {
+				close(ch)
+				s.Close()
+				cancel()
+				return
+			}
+			ch <- blk
+		}
+	}()
+
+	return ch, nil
+}
+
+func (cs *ChainService) handleGetCompressedBlocksStream(req *wire.GetCompressedBlocksStreamReq, s inet.Stream, legacy bool) error {
+	if err := writeStreamResp(s, legacy); err != nil {
+		return err
+	}
+
+	_, bestHeight, _ := cs.chain.BestBlock()
+
+	endHeight := req.StartHeight + maxBatchSize - 1
+	if endHeight > bestHeight {
+		endHeight = bestHeight
+	}
+
+	for i := req.StartHeight; i <= endHeight; i++ {
+		block, err := cs.chain.GetBlockByHeight(i)
+		if err != nil {
+			s.Close()
+			return err
+		}
+		if err := net.WriteMsg(s, compressBlock(block)); err != nil {
+			s.Close()
+			return err
+		}
+	}
+	return s.Close()
+}
+
+// compressBlock strips the block down to the txids, nullifiers,
+// and outputs of its transactions.
+func compressBlock(blk *blocks.Block) *blocks.CompressedBlock {
+	cb := &blocks.CompressedBlock{
+		Height: blk.Header.Height,
+		Txs:    make([]*blocks.CompressedBlock_CompressedTx, 0, len(blk.Transactions)),
+	}
+	for _, tx := range blk.Transactions {
+		nullifiers := make([][]byte, 0, len(tx.Nullifiers()))
+		for _, n := range tx.Nullifiers() {
+			nullifiers = append(nullifiers, n.Bytes())
+		}
+		cb.Txs = append(cb.Txs, &blocks.CompressedBlock_CompressedTx{
+			Txid:       tx.ID().Bytes(),
+			Nullifiers: nullifiers,
+			Outputs:    tx.Outputs(),
+		})
+	}
+	return cb
+}
+
+// GetAccumulatorCheckpointByHeight returns the peer's accumulator checkpoint
+// at or prior to the given height along with the checkpoint's height.
+func (cs *ChainService) GetAccumulatorCheckpointByHeight(p peer.ID, height uint32) (*blockchain.Accumulator, uint32, error) {
+	return cs.getAccumulatorCheckpoint(p, &wire.GetAccumulatorCheckpointReq{
+		Height: height,
+	})
+}
+
+// GetAccumulatorCheckpointByTimestamp returns the peer's accumulator checkpoint
+// at or prior to the given timestamp along with the checkpoint's height.
+func (cs *ChainService) GetAccumulatorCheckpointByTimestamp(p peer.ID, timestamp time.Time) (*blockchain.Accumulator, uint32, error) {
+	return cs.getAccumulatorCheckpoint(p, &wire.GetAccumulatorCheckpointReq{
+		Timestamp: timestamp.Unix(),
+	})
+}
+
+func (cs *ChainService) getAccumulatorCheckpoint(p peer.ID, checkpointReq *wire.GetAccumulatorCheckpointReq) (*blockchain.Accumulator, uint32, error) {
+	var (
+		req = &wire.MsgChainServiceRequest{
+			Msg: &wire.MsgChainServiceRequest_GetAccumulatorCheckpoint{
+				GetAccumulatorCheckpoint: checkpointReq,
+			},
+		}
+		resp = new(wire.MsgAccumulatorCheckpointResp)
+	)
+	err := cs.ms.SendRequest(cs.ctx, p, req, resp)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if resp.Error == wire.ErrorResponse_NotFound {
+		return nil, 0, ErrNotFound
+	}
+
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return nil, 0, ErrRateLimited
+	}
+
+	if resp.Error != wire.ErrorResponse_None {
+		return nil, 0, fmt.Errorf("error response from peer: %s", resp.GetError().String())
+	}
+
+	return blockchain.NewAccumulatorFromData(resp.Accumulator, resp.NumEntries), resp.Height, nil
+}
+
+func (cs *ChainService) handleGetAccumulatorCheckpoint(req *wire.GetAccumulatorCheckpointReq) (*wire.MsgAccumulatorCheckpointResp, error) {
+	var (
+		accumulator *blockchain.Accumulator
+		height      uint32
+		err         error
+	)
+	if req.Timestamp == 0 {
+		accumulator, height, err = cs.chain.GetAccumulatorCheckpointByHeight(req.Height)
+	} else {
+		accumulator, height, err = cs.chain.GetAccumulatorCheckpointByTimestamp(time.Unix(req.Timestamp, 0))
+	}
+	if err != nil {
+		return &wire.MsgAccumulatorCheckpointResp{Error: wire.ErrorResponse_NotFound}, nil
+	}
+
+	resp := &wire.MsgAccumulatorCheckpointResp{
+		Height:      height,
+		NumEntries:  accumulator.NumElements(),
+		Accumulator: accumulator.Hashes(),
+	}
+
+	return resp, nil
+}
+
+// GetInclusionProof returns the peer's inclusion proof for the commitment
+// along with the accumulator root the proof is valid for and the ID of the
+// block at which the root was computed. Peers only serve proofs if they
+// have opted in to doing so and only for the commitments they are tracking.
+//
+// The proof is checked against the returned root but it is up to the
+// caller to verify that the root is in the chain.
+func (cs *ChainService) GetInclusionProof(p peer.ID, commitment types.ID) (*blockchain.InclusionProof, types.ID, types.ID, error) {
+	var (
+		req = &wire.MsgChainServiceRequest{
+			Msg: &wire.MsgChainServiceRequest_GetInclusionProof{
+				GetInclusionProof: &wire.GetInclusionProofReq{
+					Commitment: commitment[:],
+				},
+			},
+		}
+		resp = new(wire.MsgInclusionProofResp)
+	)
+	err := cs.ms.SendRequest(cs.ctx, p, req, resp)
+	if err != nil {
+		return nil, types.ID{}, types.ID{}, err
+	}
+
+	if resp.Error == wire.ErrorResponse_NotFound {
+		return nil, types.ID{}, types.ID{}, ErrNotFound
+	}
+
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return nil, types.ID{}, types.ID{}, ErrRateLimited
+	}
+
+	if resp.Error != wire.ErrorResponse_None {
+		return nil, types.ID{}, types.ID{}, fmt.Errorf("error response from peer: %s", resp.GetError().String())
+	}
+
+	valid, err := blockchain.ValidateInclusionProof(commitment[:], resp.Index, resp.Hashes, resp.Flags, resp.TxoRoot)
+	if err != nil || !valid {
+		cs.network.IncreaseBanscore(p, 50, 0)
+		return nil, types.ID{}, types.ID{}, fmt.Errorf("peer %s returned invalid inclusion proof", p.String())
+	}
+
+	proof := &blockchain.InclusionProof{
+		ID:     commitment,
+		Hashes: resp.Hashes,
+		Flags:  resp.Flags,
+		Index:  resp.Index,
+	}
+	return proof, types.NewID(resp.TxoRoot), types.NewID(resp.Block_ID), nil
+}
+
+func (cs *ChainService) handleGetInclusionProof(req *wire.GetInclusionProofReq) (*wire.MsgInclusionProofResp, error) {
+	if cs.getProof == nil {
+		return &wire.MsgInclusionProofResp{Error: wire.ErrorResponse_NotFound}, nil
+	}
+	proof, root, blockID, err := cs.getProof(types.NewID(req.Commitment))
+	if err != nil {
+		return &wire.MsgInclusionProofResp{Error: wire.ErrorResponse_NotFound}, nil
+	}
+
+	resp := &wire.MsgInclusionProofResp{
+		Hashes:   proof.Hashes,
+		Flags:    proof.Flags,
+		Index:    proof.Index,
+		TxoRoot:  root[:],
+		Block_ID: blockID[:],
+	}
+
+	return resp, nil
+}
+
 func (cs *ChainService) GetBest(p peer.ID) (types.ID, uint32, error) {
 	var (
 		req = &wire.MsgChainServiceRequest{
@@ -476,6 +894,10 @@ func (cs *ChainService) GetBest(p peer.ID) (types.ID, uint32, error) {
 		return types.ID{}, 0, ErrNotCurrent
 	}
 
+	if resp.Error == wire.ErrorResponse_RateLimited {
+		return types.ID{}, 0, ErrRateLimited
+	}
+
 	if resp.Error != wire.ErrorResponse_None {
 		return types.ID{}, 0, fmt.Errorf("error response from peer: %s", resp.GetError().String())
 	}
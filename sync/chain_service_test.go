@@ -6,16 +6,21 @@ package sync
 
 import (
 	"context"
+	"crypto/rand"
 	"github.com/go-test/deep"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/libp2p/go-msgio"
+	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/blockchain/harness"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
 	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
+	"github.com/project-illium/ilxd/types/wire"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -47,7 +52,24 @@ func TestChainService(t *testing.T) {
 	err = testHarness1.GenerateBlocks(10)
 	assert.NoError(t, err)
 
-	service1, err := NewChainService(context.Background(), testHarness1.Blockchain().GetBlockByID, testHarness1.Blockchain(), network1, testHarness1.Blockchain().Params())
+	acc := blockchain.NewAccumulator()
+	commitments := make([]types.ID, 0, 5)
+	for i := 0; i < 5; i++ {
+		var commitment types.ID
+		rand.Read(commitment[:])
+		acc.Insert(commitment[:], true)
+		commitments = append(commitments, commitment)
+	}
+	proofBlockID, _, _ := testHarness1.Blockchain().BestBlock()
+	getProof := func(commitment types.ID) (*blockchain.InclusionProof, types.ID, types.ID, error) {
+		proof, err := acc.GetProof(commitment[:])
+		if err != nil {
+			return nil, types.ID{}, types.ID{}, err
+		}
+		return proof, acc.Root(), proofBlockID, nil
+	}
+
+	service1, err := NewChainService(context.Background(), testHarness1.Blockchain().GetBlockByID, testHarness1.Blockchain(), network1, testHarness1.Blockchain().Params(), InclusionProofs(getProof))
 	assert.NoError(t, err)
 
 	host2, err := mn.GenPeer()
@@ -139,4 +161,201 @@ func TestChainService(t *testing.T) {
 		i++
 	}
 	assert.Equal(t, uint32(11), i)
+
+	stream3, err := service1.GetCompressedBlocksStream(host2.ID(), 0)
+	assert.NoError(t, err)
+	i = 0
+	for cb := range stream3 {
+		blk, err := testHarness2.Blockchain().GetBlockByHeight(i)
+		assert.NoError(t, err)
+		assert.Equal(t, i, cb.Height)
+		assert.Len(t, cb.Txs, len(blk.Transactions))
+		for x, tx := range blk.Transactions {
+			assert.Equal(t, tx.ID().Bytes(), cb.Txs[x].Txid)
+			assert.Empty(t, deep.Equal(tx.Outputs(), cb.Txs[x].Outputs))
+		}
+		i++
+	}
+	assert.Equal(t, uint32(11), i)
+
+	// The chain is too short to have any checkpoints.
+	_, _, err = service1.GetAccumulatorCheckpointByHeight(host2.ID(), 10)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Service2 is not configured to serve inclusion proofs.
+	_, _, _, err = service1.GetInclusionProof(host2.ID(), commitments[2])
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	proof, root, blockID, err := service2.GetInclusionProof(host1.ID(), commitments[2])
+	assert.NoError(t, err)
+	assert.Equal(t, commitments[2], proof.ID)
+	assert.Equal(t, acc.Root(), root)
+	assert.Equal(t, proofBlockID, blockID)
+	valid, err := blockchain.ValidateInclusionProof(commitments[2][:], proof.Index, proof.Hashes, proof.Flags, root[:])
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	_, _, _, err = service2.GetInclusionProof(host1.ID(), types.ID{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestChainServiceRateLimited(t *testing.T) {
+	mn := mocknet.New()
+
+	ds := mock.NewMapDatastore()
+
+	host1, err := mn.GenPeer()
+	assert.NoError(t, err)
+	network1, err := net.NewNetwork(context.Background(), []net.Option{
+		net.WithHost(host1),
+		net.Params(&params.RegestParams),
+		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+			return nil
+		}),
+		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+			return nil
+		}),
+		net.Datastore(ds),
+		net.MaxMessageSize(repo.DefaultMaxMessageSize),
+	}...)
+	assert.NoError(t, err)
+
+	testHarness, err := harness.NewTestHarness(harness.DefaultOptions())
+	assert.NoError(t, err)
+
+	err = testHarness.GenerateBlocks(10)
+	assert.NoError(t, err)
+
+	_, err = NewChainService(context.Background(), testHarness.Blockchain().GetBlockByID, testHarness.Blockchain(), network1, testHarness.Blockchain().Params(), RateLimits(RateLimitConfig{
+		BlockRequestsPerMinute:  1,
+		QueryRequestsPerMinute:  1,
+		StreamRequestsPerMinute: 1,
+		MaxConcurrentStreams:    1,
+	}))
+	assert.NoError(t, err)
+
+	host2, err := mn.GenPeer()
+	assert.NoError(t, err)
+	network2, err := net.NewNetwork(context.Background(), []net.Option{
+		net.WithHost(host2),
+		net.Params(&params.RegestParams),
+		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+			return nil
+		}),
+		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+			return nil
+		}),
+		net.Datastore(ds),
+		net.MaxMessageSize(repo.DefaultMaxMessageSize),
+	}...)
+	assert.NoError(t, err)
+
+	service2, err := NewChainService(context.Background(), testHarness.Blockchain().GetBlockByID, testHarness.Blockchain(), network2, testHarness.Blockchain().Params())
+	assert.NoError(t, err)
+
+	assert.NoError(t, mn.LinkAll())
+	assert.NoError(t, mn.ConnectAllButSelf())
+
+	_, _, err = service2.GetBest(host1.ID())
+	assert.NoError(t, err)
+	_, _, err = service2.GetBest(host1.ID())
+	assert.ErrorIs(t, err, ErrRateLimited)
+
+	stream, err := service2.GetHeadersStream(host1.ID(), 0)
+	assert.NoError(t, err)
+	for range stream {
+	}
+	_, err = service2.GetHeadersStream(host1.ID(), 0)
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
+
+func TestChainServiceLegacyProtocol(t *testing.T) {
+	mn := mocknet.New()
+
+	ds := mock.NewMapDatastore()
+
+	host1, err := mn.GenPeer()
+	assert.NoError(t, err)
+	network1, err := net.NewNetwork(context.Background(), []net.Option{
+		net.WithHost(host1),
+		net.Params(&params.RegestParams),
+		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+			return nil
+		}),
+		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+			return nil
+		}),
+		net.Datastore(ds),
+		net.MaxMessageSize(repo.DefaultMaxMessageSize),
+	}...)
+	assert.NoError(t, err)
+
+	testHarness, err := harness.NewTestHarness(harness.DefaultOptions())
+	assert.NoError(t, err)
+
+	err = testHarness.GenerateBlocks(10)
+	assert.NoError(t, err)
+
+	_, err = NewChainService(context.Background(), testHarness.Blockchain().GetBlockByID, testHarness.Blockchain(), network1, testHarness.Blockchain().Params())
+	assert.NoError(t, err)
+
+	// Make host1 look like a peer that has not upgraded.
+	prefix := testHarness.Blockchain().Params().ProtocolPrefix
+	host1.RemoveStreamHandler(prefix + ChainServiceProtocol + ChainServiceProtocolVersion)
+
+	host2, err := mn.GenPeer()
+	assert.NoError(t, err)
+	network2, err := net.NewNetwork(context.Background(), []net.Option{
+		net.WithHost(host2),
+		net.Params(&params.RegestParams),
+		net.BlockValidator(func(*blocks.XThinnerBlock, peer.ID) error {
+			return nil
+		}),
+		net.MempoolValidator(func(transaction *transactions.Transaction) error {
+			return nil
+		}),
+		net.Datastore(ds),
+		net.MaxMessageSize(repo.DefaultMaxMessageSize),
+	}...)
+	assert.NoError(t, err)
+
+	service2, err := NewChainService(context.Background(), testHarness.Blockchain().GetBlockByID, testHarness.Blockchain(), network2, testHarness.Blockchain().Params())
+	assert.NoError(t, err)
+
+	assert.NoError(t, mn.LinkAll())
+	assert.NoError(t, mn.ConnectAllButSelf())
+
+	// Unary requests and streams from an upgraded node fall back
+	// to the legacy protocol.
+	_, height, err := service2.GetBest(host1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), height)
+
+	stream, err := service2.GetHeadersStream(host1.ID(), 1)
+	assert.NoError(t, err)
+	var n uint32
+	for header := range stream {
+		n++
+		assert.Equal(t, n, header.Height)
+	}
+	assert.Equal(t, uint32(10), n)
+
+	// A legacy client receives the stream items without the
+	// MsgStreamResp status message.
+	s, err := host1.NewStream(context.Background(), host2.ID(), prefix+ChainServiceProtocol+ChainServiceLegacyProtocolVersion)
+	assert.NoError(t, err)
+	err = net.WriteMsg(s, &wire.MsgChainServiceRequest{
+		Msg: &wire.MsgChainServiceRequest_GetHeadersStream{
+			GetHeadersStream: &wire.GetHeadersStreamReq{
+				StartHeight: 1,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	reader := msgio.NewVarintReaderSize(s, 1<<23)
+	for i := uint32(1); i <= 10; i++ {
+		header := new(blocks.BlockHeader)
+		assert.NoError(t, net.ReadMsg(context.Background(), reader, header))
+		assert.Equal(t, i, header.Height)
+	}
 }
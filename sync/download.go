@@ -0,0 +1,402 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"errors"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/types"
+	"github.com/project-illium/ilxd/types/blocks"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// maxDownloadPeers is the maximum number of peers we download
+	// block txs from in parallel.
+	maxDownloadPeers = 8
+
+	// downloadRangeSize is the number of blocks in each range that
+	// is assigned to a download peer.
+	downloadRangeSize = 500
+
+	// maxBlocksAhead is the maximum number of blocks we will download
+	// ahead of the next block to be connected. This bounds the memory
+	// used by blocks waiting to be reassembled.
+	maxBlocksAhead = lookaheadSize
+
+	// stallTimeoutMultiplier is how many times longer than expected,
+	// based on the peer's measured throughput, a range may take to
+	// download before the peer is considered stalled.
+	stallTimeoutMultiplier = 4
+
+	// throughputAlpha is the weight given to the newest sample when
+	// updating a peer's throughput estimate.
+	throughputAlpha = 0.3
+
+	// maxRateLimitRetries is the number of consecutive times a peer
+	// may respond with RateLimited before we stop downloading from it.
+	maxRateLimitRetries = 3
+)
+
+// minStallTimeout is the minimum amount of time we give a peer to
+// serve a range before we consider it stalled and reassign the
+// range to another peer.
+var minStallTimeout = time.Second * 30
+
+// stallCheckInterval is how often we check the inflight ranges
+// for stalled peers.
+var stallCheckInterval = time.Second
+
+// blockRange is an inclusive range of block heights.
+type blockRange struct {
+	start uint32
+	end   uint32
+}
+
+func (r blockRange) size() int {
+	return int(r.end-r.start) + 1
+}
+
+// splitRange splits the range into consecutive ranges of at most size
+// blocks each.
+func splitRange(start, end uint32, size uint32) []blockRange {
+	ranges := make([]blockRange, 0, (end-start)/size+1)
+	for start <= end {
+		stop := start + size - 1
+		if stop > end || stop < start {
+			stop = end
+		}
+		ranges = append(ranges, blockRange{start: start, end: stop})
+		if stop == end {
+			break
+		}
+		start = stop + 1
+	}
+	return ranges
+}
+
+// throughputTracker tracks the rate at which each peer has served
+// us blocks. It is used to compute how long we should wait for a
+// peer to serve a range before reassigning it.
+type throughputTracker struct {
+	peers map[peer.ID]float64
+	mtx   sync.RWMutex
+}
+
+func newThroughputTracker() *throughputTracker {
+	return &throughputTracker{
+		peers: make(map[peer.ID]float64),
+		mtx:   sync.RWMutex{},
+	}
+}
+
+// update records that the peer served numBlocks in the elapsed time.
+func (t *throughputTracker) update(p peer.ID, numBlocks int, elapsed time.Duration) {
+	if elapsed <= 0 {
+		elapsed = time.Millisecond
+	}
+	rate := float64(numBlocks) / elapsed.Seconds()
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	prev, ok := t.peers[p]
+	if !ok {
+		t.peers[p] = rate
+		return
+	}
+	t.peers[p] = throughputAlpha*rate + (1-throughputAlpha)*prev
+}
+
+// blocksPerSecond returns the peer's estimated throughput and whether
+// we have any measurements for the peer.
+func (t *throughputTracker) blocksPerSecond(p peer.ID) (float64, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	rate, ok := t.peers[p]
+	return rate, ok
+}
+
+// stallTimeout returns how long we should wait for the peer to serve
+// numBlocks before we consider it stalled.
+func (t *throughputTracker) stallTimeout(p peer.ID, numBlocks int) time.Duration {
+	rate, ok := t.blocksPerSecond(p)
+	if !ok || rate <= 0 {
+		return minStallTimeout
+	}
+	expected := time.Duration(float64(numBlocks) / rate * float64(time.Second))
+	if timeout := expected * stallTimeoutMultiplier; timeout > minStallTimeout {
+		return timeout
+	}
+	return minStallTimeout
+}
+
+func (t *throughputTracker) remove(p peer.ID) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	delete(t.peers, p)
+}
+
+// downloadPeers returns the peers we will download block txs from. The
+// first peer is always p. The others are selected from p's bucket and
+// only included if they agree with p on the ID of the block at toHeight.
+// If p is not in a bucket, for example while syncing to checkpoints,
+// any sync peer may be selected.
+func (sm *SyncManager) downloadPeers(p peer.ID, toHeight uint32, expectedID types.ID) []peer.ID {
+	var candidates []peer.ID
+	sm.bucketMtx.RLock()
+	for _, bucket := range sm.buckets {
+		for _, pid := range bucket {
+			if pid == p {
+				candidates = append(candidates, bucket...)
+				break
+			}
+		}
+	}
+	sm.bucketMtx.RUnlock()
+	if len(candidates) == 0 {
+		candidates = sm.syncPeers()
+	}
+
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	toQuery := make([]peer.ID, 0, maxDownloadPeers-1)
+	for _, pid := range candidates {
+		if pid == p {
+			continue
+		}
+		toQuery = append(toQuery, pid)
+		if len(toQuery) == maxDownloadPeers-1 {
+			break
+		}
+	}
+
+	var (
+		ret = []peer.ID{p}
+		mtx sync.Mutex
+		wg  sync.WaitGroup
+	)
+	wg.Add(len(toQuery))
+	for _, pid := range toQuery {
+		go func(pid peer.ID) {
+			defer wg.Done()
+			id, err := sm.chainService.GetBlockID(pid, toHeight)
+			if err != nil || id != expectedID {
+				return
+			}
+			mtx.Lock()
+			ret = append(ret, pid)
+			mtx.Unlock()
+		}(pid)
+	}
+	wg.Wait()
+	return ret
+}
+
+type rangeResult struct {
+	p       peer.ID
+	r       blockRange
+	txs     []*blocks.BlockTxs
+	elapsed time.Duration
+	err     error
+}
+
+type inflightRange struct {
+	r        blockRange
+	deadline time.Time
+}
+
+// parallelDownload downloads the block txs for the given ranges from the
+// peers in parallel using the download function. Each peer is assigned
+// one range at a time so faster peers end up serving more ranges. Ranges
+// that fail or stall are put back in the queue and reassigned to another
+// peer. The peer that failed or stalled is not used again. A peer that
+// responds with RateLimited has its range requeued and is put back in the
+// idle set once the backoff period expires, unless it has done so
+// maxRateLimitRetries times in a row in which case it is dropped.
+//
+// The ranges are passed to handleRange in ascending order. If handleRange
+// returns an error the download is aborted and the error returned. If it
+// returns a banned peer along with the error the range is requeued instead.
+// The time spent in handleRange is not counted against the peers' stall
+// deadlines.
+func (sm *SyncManager) parallelDownload(peers []peer.ID, ranges []blockRange,
+	download func(p peer.ID, startHeight, endHeight uint32) ([]*blocks.BlockTxs, error),
+	handleRange func(p peer.ID, r blockRange, txs []*blocks.BlockTxs) (peer.ID, error)) error {
+
+	var (
+		pending   = append([]blockRange{}, ranges...)
+		completed = make(map[uint32]*rangeResult)
+		inflight  = make(map[peer.ID]*inflightRange)
+		idle      = append([]peer.ID{}, peers...)
+		resultCh  = make(chan *rangeResult, len(peers))
+		readyCh   = make(chan peer.ID, len(peers))
+		backoff   = make(map[peer.ID]bool)
+		limited   = make(map[peer.ID]int)
+		next      = ranges[0].start
+		end       = ranges[len(ranges)-1].end
+		ticker    = time.NewTicker(stallCheckInterval)
+	)
+	defer ticker.Stop()
+
+	requeue := func(r blockRange) {
+		pending = append(pending, r)
+		sort.Slice(pending, func(i, j int) bool {
+			return pending[i].start < pending[j].start
+		})
+	}
+
+	dropPeer := func(p peer.ID) {
+		delete(inflight, p)
+		sm.throughput.remove(p)
+		sm.removeActivePeer(p)
+	}
+
+	for {
+		// Assign pending ranges to idle peers.
+		for len(idle) > 0 && len(pending) > 0 && pending[0].start < next+maxBlocksAhead {
+			p := idle[0]
+			idle = idle[1:]
+			r := pending[0]
+			pending = pending[1:]
+
+			inflight[p] = &inflightRange{
+				r:        r,
+				deadline: time.Now().Add(sm.throughput.stallTimeout(p, r.size())),
+			}
+			go func(p peer.ID, r blockRange) {
+				start := time.Now()
+				txs, err := download(p, r.start, r.end)
+				if err == nil && len(txs) != r.size() {
+					err = errors.New("peer returned incomplete range")
+				}
+				resultCh <- &rangeResult{p: p, r: r, txs: txs, elapsed: time.Since(start), err: err}
+			}(p, r)
+		}
+
+		if len(inflight) == 0 && len(backoff) == 0 {
+			if len(pending) > 0 {
+				return errors.New("no download peers remaining")
+			}
+			if next > end {
+				return nil
+			}
+		}
+
+		select {
+		case <-sm.quit:
+			return errors.New("sync manager quit")
+		case res := <-resultCh:
+			ifr, ok := inflight[res.p]
+			if !ok || ifr.r != res.r {
+				// This peer was already considered stalled and its
+				// range reassigned.
+				continue
+			}
+			delete(inflight, res.p)
+			if errors.Is(res.err, ErrRateLimited) {
+				log.Debug("Sync peer rate limited block range request", log.ArgsFromMap(map[string]any{
+					"peer":  res.p,
+					"start": res.r.start,
+					"end":   res.r.end,
+				}))
+				requeue(res.r)
+				limited[res.p]++
+				if limited[res.p] >= maxRateLimitRetries {
+					dropPeer(res.p)
+					continue
+				}
+				backoff[res.p] = true
+				p := res.p
+				time.AfterFunc(rateLimitBackoff, func() {
+					readyCh <- p
+				})
+				continue
+			}
+			if res.err != nil {
+				log.Debug("Sync peer failed to serve block range", log.ArgsFromMap(map[string]any{
+					"peer":  res.p,
+					"start": res.r.start,
+					"end":   res.r.end,
+					"error": res.err,
+				}))
+				sm.network.IncreaseBanscore(res.p, 0, 20)
+				dropPeer(res.p)
+				requeue(res.r)
+				continue
+			}
+			sm.throughput.update(res.p, res.r.size(), res.elapsed)
+			delete(limited, res.p)
+			idle = append(idle, res.p)
+			completed[res.r.start] = res
+
+			// Reassemble the ranges in order. The peers downloading
+			// the inflight ranges are not held responsible for the
+			// time this takes so their deadlines are pushed back.
+			handleStart := time.Now()
+			for {
+				res, ok := completed[next]
+				if !ok {
+					break
+				}
+				delete(completed, next)
+				banned, err := handleRange(res.p, res.r, res.txs)
+				if err != nil {
+					if banned == "" {
+						return err
+					}
+					for i, p := range idle {
+						if p == banned {
+							idle = append(idle[:i], idle[i+1:]...)
+							break
+						}
+					}
+					if ifr, ok := inflight[banned]; ok {
+						requeue(ifr.r)
+					}
+					delete(backoff, banned)
+					dropPeer(banned)
+					requeue(res.r)
+					break
+				}
+				next = res.r.end + 1
+			}
+			handleTime := time.Since(handleStart)
+			for _, ifr := range inflight {
+				ifr.deadline = ifr.deadline.Add(handleTime)
+			}
+		case p := <-readyCh:
+			if backoff[p] {
+				delete(backoff, p)
+				idle = append(idle, p)
+			}
+		case <-ticker.C:
+			if len(resultCh) > 0 {
+				// Handle the delivered ranges before checking
+				// for stalls.
+				continue
+			}
+			now := time.Now()
+			for p, ifr := range inflight {
+				if now.After(ifr.deadline) {
+					log.Debug("Sync peer stalled serving block range", log.ArgsFromMap(map[string]any{
+						"peer":  p,
+						"start": ifr.r.start,
+						"end":   ifr.r.end,
+					}))
+					dropPeer(p)
+					requeue(ifr.r)
+				}
+			}
+		}
+	}
+}
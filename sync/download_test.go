@@ -0,0 +1,247 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"errors"
+	"github.com/libp2p/go-libp2p/core/peer"
+	pt "github.com/libp2p/go-libp2p/core/test"
+	"github.com/project-illium/ilxd/types/blocks"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSplitRange(t *testing.T) {
+	ranges := splitRange(1, 1200, 500)
+	assert.Equal(t, []blockRange{
+		{start: 1, end: 500},
+		{start: 501, end: 1000},
+		{start: 1001, end: 1200},
+	}, ranges)
+
+	ranges = splitRange(10, 10, 500)
+	assert.Equal(t, []blockRange{{start: 10, end: 10}}, ranges)
+
+	ranges = splitRange(1, 1000, 500)
+	assert.Len(t, ranges, 2)
+	assert.Equal(t, 500, ranges[1].size())
+}
+
+func TestThroughputTracker(t *testing.T) {
+	tracker := newThroughputTracker()
+	p, err := pt.RandPeerID()
+	assert.NoError(t, err)
+
+	// With no measurements we use the minimum timeout.
+	assert.Equal(t, minStallTimeout, tracker.stallTimeout(p, 500))
+
+	tracker.update(p, 100, time.Second)
+	rate, ok := tracker.blocksPerSecond(p)
+	assert.True(t, ok)
+	assert.Equal(t, float64(100), rate)
+
+	// 500 blocks at 100 blocks per second is expected to
+	// take five seconds. This is below the minimum.
+	assert.Equal(t, minStallTimeout, tracker.stallTimeout(p, 500))
+
+	// A slower sample is blended into the estimate.
+	tracker.update(p, 10, time.Second)
+	rate, _ = tracker.blocksPerSecond(p)
+	assert.InDelta(t, throughputAlpha*10+(1-throughputAlpha)*100, rate, 0.0001)
+
+	tracker.peers[p] = 1
+	assert.Equal(t, time.Second*500*stallTimeoutMultiplier, tracker.stallTimeout(p, 500))
+
+	tracker.remove(p)
+	_, ok = tracker.blocksPerSecond(p)
+	assert.False(t, ok)
+}
+
+func TestParallelDownload(t *testing.T) {
+	prevStallTimeout, prevBackoff := minStallTimeout, rateLimitBackoff
+	minStallTimeout, rateLimitBackoff = time.Millisecond*100, time.Millisecond*50
+	defer func() {
+		minStallTimeout, rateLimitBackoff = prevStallTimeout, prevBackoff
+	}()
+
+	peers := make([]peer.ID, 4)
+	for i := range peers {
+		p, err := pt.RandPeerID()
+		assert.NoError(t, err)
+		peers[i] = p
+	}
+	var (
+		good     = peers[0]
+		stalling = peers[1]
+		bad      = peers[2]
+		limited  = peers[3]
+
+		release = make(chan struct{})
+		calls   = make(map[peer.ID]int)
+		mtx     sync.Mutex
+	)
+	defer close(release)
+
+	// The stalling peer never responds, the bad peer serves txs that
+	// don't match the merkle root, and the limited peer responds with
+	// RateLimited to its first request.
+	download := func(p peer.ID, startHeight, endHeight uint32) ([]*blocks.BlockTxs, error) {
+		mtx.Lock()
+		calls[p]++
+		n := calls[p]
+		mtx.Unlock()
+
+		switch p {
+		case stalling:
+			<-release
+			return nil, errors.New("stream closed")
+		case limited:
+			if n == 1 {
+				return nil, ErrRateLimited
+			}
+		}
+		txs := make([]*blocks.BlockTxs, 0, endHeight-startHeight+1)
+		for h := startHeight; h <= endHeight; h++ {
+			txs = append(txs, &blocks.BlockTxs{})
+		}
+		return txs, nil
+	}
+
+	var (
+		ranges  = splitRange(1, 60, 10)
+		handled []blockRange
+		from    = make(map[peer.ID]int)
+	)
+	handleRange := func(p peer.ID, r blockRange, txs []*blocks.BlockTxs) (peer.ID, error) {
+		assert.Len(t, txs, r.size())
+		if p == bad {
+			return p, errors.New("invalid block download merkle root")
+		}
+		handled = append(handled, r)
+		from[p]++
+		return "", nil
+	}
+
+	sm := &SyncManager{
+		throughput: newThroughputTracker(),
+		quit:       make(chan struct{}),
+	}
+	assert.NoError(t, sm.parallelDownload(peers, ranges, download, handleRange))
+
+	// Every range is handled exactly once and in order even though
+	// they were downloaded out of order.
+	assert.Equal(t, ranges, handled)
+
+	// The stalled and bad peers' ranges were reassigned.
+	assert.Zero(t, from[stalling])
+	assert.Zero(t, from[bad])
+	assert.Positive(t, from[good])
+	assert.Positive(t, from[limited])
+
+	// Neither was used again after being dropped.
+	mtx.Lock()
+	assert.Equal(t, 1, calls[stalling])
+	mtx.Unlock()
+	_, ok := sm.throughput.blocksPerSecond(bad)
+	assert.False(t, ok)
+}
+
+func TestParallelDownloadRateLimitedPeer(t *testing.T) {
+	prevBackoff := rateLimitBackoff
+	rateLimitBackoff = time.Millisecond * 10
+	defer func() {
+		rateLimitBackoff = prevBackoff
+	}()
+
+	peers := make([]peer.ID, 2)
+	for i := range peers {
+		p, err := pt.RandPeerID()
+		assert.NoError(t, err)
+		peers[i] = p
+	}
+	var (
+		good    = peers[0]
+		limited = peers[1]
+
+		calls = make(map[peer.ID]int)
+		mtx   sync.Mutex
+	)
+
+	// The limited peer responds with RateLimited to every request.
+	download := func(p peer.ID, startHeight, endHeight uint32) ([]*blocks.BlockTxs, error) {
+		mtx.Lock()
+		calls[p]++
+		mtx.Unlock()
+
+		if p == limited {
+			return nil, ErrRateLimited
+		}
+		time.Sleep(time.Millisecond * 5)
+		return make([]*blocks.BlockTxs, endHeight-startHeight+1), nil
+	}
+
+	from := make(map[peer.ID]int)
+	handleRange := func(p peer.ID, r blockRange, txs []*blocks.BlockTxs) (peer.ID, error) {
+		from[p]++
+		return "", nil
+	}
+
+	sm := &SyncManager{
+		throughput: newThroughputTracker(),
+		quit:       make(chan struct{}),
+	}
+	assert.NoError(t, sm.parallelDownload(peers, splitRange(1, 1000, 10), download, handleRange))
+
+	// The limited peer stopped being retried and the
+	// good peer served all the ranges.
+	assert.Equal(t, 100, from[good])
+	mtx.Lock()
+	assert.Equal(t, maxRateLimitRetries, calls[limited])
+	mtx.Unlock()
+}
+
+func TestParallelDownloadSlowHandleRange(t *testing.T) {
+	prevStallTimeout, prevInterval := minStallTimeout, stallCheckInterval
+	minStallTimeout, stallCheckInterval = time.Millisecond*100, time.Millisecond*10
+	defer func() {
+		minStallTimeout, stallCheckInterval = prevStallTimeout, prevInterval
+	}()
+
+	peers := make([]peer.ID, 2)
+	for i := range peers {
+		p, err := pt.RandPeerID()
+		assert.NoError(t, err)
+		peers[i] = p
+	}
+
+	// Both peers deliver well within the stall timeout.
+	download := func(p peer.ID, startHeight, endHeight uint32) ([]*blocks.BlockTxs, error) {
+		time.Sleep(time.Millisecond * 50)
+		return make([]*blocks.BlockTxs, endHeight-startHeight+1), nil
+	}
+
+	// Validating and connecting each range takes much longer than
+	// the stall timeout. The peers must not be dropped for it.
+	from := make(map[peer.ID]int)
+	handleRange := func(p peer.ID, r blockRange, txs []*blocks.BlockTxs) (peer.ID, error) {
+		time.Sleep(time.Millisecond * 200)
+		from[p]++
+		return "", nil
+	}
+
+	sm := &SyncManager{
+		throughput: newThroughputTracker(),
+		quit:       make(chan struct{}),
+	}
+	assert.NoError(t, sm.parallelDownload(peers, splitRange(1, 60, 10), download, handleRange))
+
+	for _, p := range peers {
+		assert.Positive(t, from[p])
+		_, ok := sm.throughput.blocksPerSecond(p)
+		assert.True(t, ok)
+	}
+}
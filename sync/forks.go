@@ -0,0 +1,285 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"context"
+	"fmt"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/blockchain"
+	"github.com/project-illium/ilxd/repo"
+	"github.com/project-illium/ilxd/sync/pb"
+	"github.com/project-illium/ilxd/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+// Fork is a record of peers being found on competing chains
+// during the sync.
+type Fork struct {
+	// ForkBlock is the last block the chains have in common.
+	ForkBlock types.ID
+
+	// ForkHeight is the height of the fork block.
+	ForkHeight uint32
+
+	// Tips are the competing sides of the fork.
+	Tips []*ForkTip
+
+	// Winner is the BlockID of the tip that was selected.
+	Winner types.ID
+
+	// ChosenByConsensus is true if the fork was near the tip
+	// of the chain and the winner was selected by the consensus
+	// engine rather than by chain score.
+	ChosenByConsensus bool
+
+	// Timestamp is when the fork was observed.
+	Timestamp time.Time
+}
+
+// ForkTip is one side of a fork.
+type ForkTip struct {
+	// BlockID is the ID of the last block downloaded on this
+	// side of the fork.
+	BlockID types.ID
+
+	// Height is the height of the block.
+	Height uint32
+
+	// Peers are the peers following this side of the fork.
+	Peers []peer.ID
+
+	// Score is the chain score of the evaluation window.
+	// Lower is better.
+	Score blockchain.ChainScore
+}
+
+// maxForkRecords is the maximum number of forks kept in the
+// datastore. The oldest are deleted once it is exceeded.
+const maxForkRecords = 100
+
+// ChainTipStatus describes the state of a chain tip.
+type ChainTipStatus int
+
+const (
+	// ChainTipActive is the tip of our best chain.
+	ChainTipActive ChainTipStatus = iota
+
+	// ChainTipSelected is the tip of a chain that won a fork
+	// but has not yet been connected.
+	ChainTipSelected
+
+	// ChainTipRejected is the tip of a chain that lost a fork.
+	ChainTipRejected
+)
+
+// String returns the ChainTipStatus in human-readable form.
+func (s ChainTipStatus) String() string {
+	switch s {
+	case ChainTipActive:
+		return "active"
+	case ChainTipSelected:
+		return "selected"
+	case ChainTipRejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(s))
+	}
+}
+
+// ChainTip is the tip of a chain we know about.
+type ChainTip struct {
+	BlockID types.ID
+	Height  uint32
+
+	// BranchLength is the number of blocks between the tip and
+	// the point where it forks from our best chain. It is zero
+	// for the active tip.
+	BranchLength uint32
+
+	Status ChainTipStatus
+}
+
+// Forks returns the forks that have been observed, most recent
+// first. Only the most recent maxForkRecords forks are kept.
+func (sm *SyncManager) Forks() ([]*Fork, error) {
+	if sm.ds == nil {
+		return nil, nil
+	}
+	results, err := sm.ds.Query(context.Background(), query.Query{
+		Prefix: repo.ForkDatastoreKeyPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+
+	var forks []*Fork
+	for r := range results.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var dbFork pb.DBFork
+		if err := proto.Unmarshal(r.Value, &dbFork); err != nil {
+			return nil, err
+		}
+		forks = append(forks, forkFromDB(&dbFork))
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		return forks[i].Timestamp.After(forks[j].Timestamp)
+	})
+	return forks, nil
+}
+
+// ChainTips returns the tip of our best chain along with the tips
+// of any chains we've seen in forks that are not part of our best
+// chain.
+func (sm *SyncManager) ChainTips() ([]*ChainTip, error) {
+	bestID, height, _ := sm.chain.BestBlock()
+	tips := []*ChainTip{
+		{
+			BlockID: bestID,
+			Height:  height,
+			Status:  ChainTipActive,
+		},
+	}
+
+	forks, err := sm.Forks()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[types.ID]bool{bestID: true}
+	for _, fork := range forks {
+		for _, tip := range fork.Tips {
+			if seen[tip.BlockID] || sm.chain.HasBlock(tip.BlockID) {
+				continue
+			}
+			seen[tip.BlockID] = true
+
+			status := ChainTipRejected
+			if tip.BlockID == fork.Winner {
+				status = ChainTipSelected
+			}
+			tips = append(tips, &ChainTip{
+				BlockID:      tip.BlockID,
+				Height:       tip.Height,
+				BranchLength: tip.Height - fork.ForkHeight,
+				Status:       status,
+			})
+		}
+	}
+	return tips, nil
+}
+
+// bucketPeers returns the peers in the same bucket as p. If p
+// is not in a bucket only p is returned.
+func (sm *SyncManager) bucketPeers(p peer.ID) []peer.ID {
+	sm.bucketMtx.RLock()
+	defer sm.bucketMtx.RUnlock()
+
+	for _, bucket := range sm.buckets {
+		for _, pid := range bucket {
+			if pid == p {
+				return append([]peer.ID{}, bucket...)
+			}
+		}
+	}
+	return []peer.ID{p}
+}
+
+// recordFork persists the fork and notifies subscribers. If more
+// than maxForkRecords forks have been persisted the oldest are deleted.
+func (sm *SyncManager) recordFork(fork *Fork) {
+	log.Warn("Peers found on competing chains", log.ArgsFromMap(map[string]any{
+		"forkHeight": fork.ForkHeight,
+		"forkBlock":  fork.ForkBlock.String(),
+		"tips":       len(fork.Tips),
+		"winner":     fork.Winner.String(),
+	}))
+
+	if sm.ds != nil {
+		ser, err := proto.Marshal(forkToDB(fork))
+		if err != nil {
+			log.WithCaller(true).Error("Error serializing fork", log.Args("error", err))
+		} else if err := sm.ds.Put(context.Background(), datastore.NewKey(repo.ForkDatastoreKeyPrefix+fork.ForkBlock.String()), ser); err != nil {
+			log.WithCaller(true).Error("Error saving fork to datastore", log.Args("error", err))
+		} else if err := sm.pruneForks(); err != nil {
+			log.WithCaller(true).Error("Error pruning forks from datastore", log.Args("error", err))
+		}
+	}
+
+	sm.sendNotification(NTForkDetected, fork)
+}
+
+// pruneForks deletes the oldest forks from the datastore so that
+// no more than maxForkRecords are kept.
+func (sm *SyncManager) pruneForks() error {
+	forks, err := sm.Forks()
+	if err != nil {
+		return err
+	}
+	if len(forks) <= maxForkRecords {
+		return nil
+	}
+	for _, fork := range forks[maxForkRecords:] {
+		if err := sm.ds.Delete(context.Background(), datastore.NewKey(repo.ForkDatastoreKeyPrefix+fork.ForkBlock.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func forkToDB(fork *Fork) *pb.DBFork {
+	dbFork := &pb.DBFork{
+		ForkBlock_ID:      fork.ForkBlock.Bytes(),
+		ForkHeight:        fork.ForkHeight,
+		Tips:              make([]*pb.DBForkTip, 0, len(fork.Tips)),
+		Winner:            fork.Winner.Bytes(),
+		ChosenByConsensus: fork.ChosenByConsensus,
+		Timestamp:         timestamppb.New(fork.Timestamp),
+	}
+	for _, tip := range fork.Tips {
+		peers := make([][]byte, 0, len(tip.Peers))
+		for _, p := range tip.Peers {
+			peers = append(peers, []byte(p))
+		}
+		dbFork.Tips = append(dbFork.Tips, &pb.DBForkTip{
+			Block_ID: tip.BlockID.Bytes(),
+			Height:   tip.Height,
+			Peers:    peers,
+			Score:    float64(tip.Score),
+		})
+	}
+	return dbFork
+}
+
+func forkFromDB(dbFork *pb.DBFork) *Fork {
+	fork := &Fork{
+		ForkBlock:         types.NewID(dbFork.ForkBlock_ID),
+		ForkHeight:        dbFork.ForkHeight,
+		Tips:              make([]*ForkTip, 0, len(dbFork.Tips)),
+		Winner:            types.NewID(dbFork.Winner),
+		ChosenByConsensus: dbFork.ChosenByConsensus,
+		Timestamp:         dbFork.Timestamp.AsTime(),
+	}
+	for _, dbTip := range dbFork.Tips {
+		peers := make([]peer.ID, 0, len(dbTip.Peers))
+		for _, p := range dbTip.Peers {
+			peers = append(peers, peer.ID(p))
+		}
+		fork.Tips = append(fork.Tips, &ForkTip{
+			BlockID: types.NewID(dbTip.Block_ID),
+			Height:  dbTip.Height,
+			Peers:   peers,
+			Score:   blockchain.ChainScore(dbTip.Score),
+		})
+	}
+	return fork
+}
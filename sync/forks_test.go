@@ -0,0 +1,95 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	pt "github.com/libp2p/go-libp2p/core/test"
+	"github.com/project-illium/ilxd/repo/mock"
+	"github.com/project-illium/ilxd/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRecordFork(t *testing.T) {
+	sm := &SyncManager{ds: mock.NewMapDatastore()}
+
+	ch := make(chan *Notification)
+	sm.Subscribe(func(n *Notification) {
+		ch <- n
+	})
+
+	p1, err := pt.RandPeerID()
+	assert.NoError(t, err)
+	p2, err := pt.RandPeerID()
+	assert.NoError(t, err)
+
+	fork1 := &Fork{
+		ForkBlock:  types.NewID([]byte{0x01}),
+		ForkHeight: 100,
+		Tips: []*ForkTip{
+			{
+				BlockID: types.NewID([]byte{0x02}),
+				Height:  5100,
+				Peers:   []peer.ID{p1},
+				Score:   10,
+			},
+			{
+				BlockID: types.NewID([]byte{0x03}),
+				Height:  5100,
+				Peers:   []peer.ID{p2},
+				Score:   20,
+			},
+		},
+		Winner:    types.NewID([]byte{0x02}),
+		Timestamp: time.Unix(1000, 0).UTC(),
+	}
+	fork2 := &Fork{
+		ForkBlock:         types.NewID([]byte{0x04}),
+		ForkHeight:        200,
+		Winner:            types.NewID([]byte{0x05}),
+		ChosenByConsensus: true,
+		Tips:              []*ForkTip{},
+		Timestamp:         time.Unix(2000, 0).UTC(),
+	}
+
+	sm.recordFork(fork1)
+	select {
+	case n := <-ch:
+		assert.Equal(t, NTForkDetected, n.Type)
+		assert.Equal(t, fork1, n.Data)
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	sm.recordFork(fork2)
+	<-ch
+
+	forks, err := sm.Forks()
+	assert.NoError(t, err)
+	assert.Equal(t, []*Fork{fork2, fork1}, forks)
+}
+
+func TestRecordForkPrune(t *testing.T) {
+	sm := &SyncManager{ds: mock.NewMapDatastore()}
+
+	for i := 0; i < maxForkRecords+10; i++ {
+		sm.recordFork(&Fork{
+			ForkBlock:  types.NewID([]byte{byte(i), byte(i >> 8)}),
+			ForkHeight: uint32(i),
+			Tips:       []*ForkTip{},
+			Timestamp:  time.Unix(int64(i+1)*1000, 0).UTC(),
+		})
+	}
+
+	forks, err := sm.Forks()
+	assert.NoError(t, err)
+	assert.Len(t, forks, maxForkRecords)
+
+	// The oldest forks were deleted.
+	assert.Equal(t, uint32(maxForkRecords+9), forks[0].ForkHeight)
+	assert.Equal(t, uint32(10), forks[len(forks)-1].ForkHeight)
+}
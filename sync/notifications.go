@@ -0,0 +1,66 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"fmt"
+)
+
+// NotificationType represents the type of a notification message.
+type NotificationType int
+
+// NotificationCallback is used for a caller to provide a callback for
+// notifications about sync events.
+type NotificationCallback func(*Notification)
+
+// Constants for the type of notification message
+const (
+	// NTForkDetected indicates peers were found following
+	// competing chains and one of them was selected.
+	NTForkDetected NotificationType = iota
+)
+
+// notificationTypeStrings is a map of notification types back to their constant
+// names for pretty printing.
+var notificationTypeStrings = map[NotificationType]string{
+	NTForkDetected: "NTForkDetected",
+}
+
+// String returns the NotificationType in human-readable form.
+func (n NotificationType) String() string {
+	if s, ok := notificationTypeStrings[n]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown Notification Type (%d)", int(n))
+}
+
+// Notification defines a notification that is sent to the subscribers
+// and consists of a notification type as well as associated data that
+// depends on the type as follows:
+//   - NTForkDetected: *Fork
+type Notification struct {
+	Type NotificationType
+	Data interface{}
+}
+
+// Subscribe to sync notifications. Registers a callback to be executed
+// when various events take place. See the documentation on Notification and
+// NotificationType for details on the types and contents of notifications.
+func (sm *SyncManager) Subscribe(callback NotificationCallback) {
+	sm.notificationsLock.Lock()
+	sm.notifications = append(sm.notifications, callback)
+	sm.notificationsLock.Unlock()
+}
+
+// sendNotification sends a notification with the passed type and data to
+// each subscriber.
+func (sm *SyncManager) sendNotification(typ NotificationType, data interface{}) {
+	n := Notification{Type: typ, Data: data}
+	sm.notificationsLock.RLock()
+	for _, callback := range sm.notifications {
+		go callback(&n)
+	}
+	sm.notificationsLock.RUnlock()
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: db_sync_models.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DBFork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForkBlock_ID      []byte                 `protobuf:"bytes,1,opt,name=fork_block_ID,json=forkBlockID,proto3" json:"fork_block_ID,omitempty"`
+	ForkHeight        uint32                 `protobuf:"varint,2,opt,name=fork_height,json=forkHeight,proto3" json:"fork_height,omitempty"`
+	Tips              []*DBForkTip           `protobuf:"bytes,3,rep,name=tips,proto3" json:"tips,omitempty"`
+	Winner            []byte                 `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+	ChosenByConsensus bool                   `protobuf:"varint,5,opt,name=chosen_by_consensus,json=chosenByConsensus,proto3" json:"chosen_by_consensus,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *DBFork) Reset() {
+	*x = DBFork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_sync_models_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBFork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBFork) ProtoMessage() {}
+
+func (x *DBFork) ProtoReflect() protoreflect.Message {
+	mi := &file_db_sync_models_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBFork.ProtoReflect.Descriptor instead.
+func (*DBFork) Descriptor() ([]byte, []int) {
+	return file_db_sync_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DBFork) GetForkBlock_ID() []byte {
+	if x != nil {
+		return x.ForkBlock_ID
+	}
+	return nil
+}
+
+func (x *DBFork) GetForkHeight() uint32 {
+	if x != nil {
+		return x.ForkHeight
+	}
+	return 0
+}
+
+func (x *DBFork) GetTips() []*DBForkTip {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+func (x *DBFork) GetWinner() []byte {
+	if x != nil {
+		return x.Winner
+	}
+	return nil
+}
+
+func (x *DBFork) GetChosenByConsensus() bool {
+	if x != nil {
+		return x.ChosenByConsensus
+	}
+	return false
+}
+
+func (x *DBFork) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type DBForkTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block_ID []byte   `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	Height   uint32   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Peers    [][]byte `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	Score    float64  `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *DBForkTip) Reset() {
+	*x = DBForkTip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_db_sync_models_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBForkTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBForkTip) ProtoMessage() {}
+
+func (x *DBForkTip) ProtoReflect() protoreflect.Message {
+	mi := &file_db_sync_models_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBForkTip.ProtoReflect.Descriptor instead.
+func (*DBForkTip) Descriptor() ([]byte, []int) {
+	return file_db_sync_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DBForkTip) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *DBForkTip) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DBForkTip) GetPeers() [][]byte {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *DBForkTip) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_db_sync_models_proto protoreflect.FileDescriptor
+
+var file_db_sync_models_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x64, 0x62, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x01, 0x0a, 0x06, 0x44, 0x42, 0x46, 0x6f,
+	0x72, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x6f, 0x72,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x44, 0x42, 0x46, 0x6f, 0x72, 0x6b, 0x54, 0x69,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x68,
+	0x6f, 0x73, 0x65, 0x6e, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6a, 0x0a, 0x09, 0x44, 0x42, 0x46,
+	0x6f, 0x72, 0x6b, 0x54, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_db_sync_models_proto_rawDescOnce sync.Once
+	file_db_sync_models_proto_rawDescData = file_db_sync_models_proto_rawDesc
+)
+
+func file_db_sync_models_proto_rawDescGZIP() []byte {
+	file_db_sync_models_proto_rawDescOnce.Do(func() {
+		file_db_sync_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_db_sync_models_proto_rawDescData)
+	})
+	return file_db_sync_models_proto_rawDescData
+}
+
+var file_db_sync_models_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_db_sync_models_proto_goTypes = []interface{}{
+	(*DBFork)(nil),                // 0: DBFork
+	(*DBForkTip)(nil),             // 1: DBForkTip
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_db_sync_models_proto_depIdxs = []int32{
+	1, // 0: DBFork.tips:type_name -> DBForkTip
+	2, // 1: DBFork.timestamp:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_db_sync_models_proto_init() }
+func file_db_sync_models_proto_init() {
+	if File_db_sync_models_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_db_sync_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBFork); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_db_sync_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBForkTip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_db_sync_models_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_db_sync_models_proto_goTypes,
+		DependencyIndexes: file_db_sync_models_proto_depIdxs,
+		MessageInfos:      file_db_sync_models_proto_msgTypes,
+	}.Build()
+	File_db_sync_models_proto = out.File
+	file_db_sync_models_proto_rawDesc = nil
+	file_db_sync_models_proto_goTypes = nil
+	file_db_sync_models_proto_depIdxs = nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2024 Project Illium
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitBanscore is the transient banscore increase a peer
+	// receives each time it exceeds its quota.
+	rateLimitBanscore = 10
+
+	// rateLimitPruneInterval is how often idle peers are removed
+	// from the rate limiter.
+	rateLimitPruneInterval = time.Minute * 5
+)
+
+// rateLimitBackoff is how long the sync manager waits before sending
+// more requests to a peer that responded with RateLimited.
+var rateLimitBackoff = time.Second * 5
+
+// RateLimitConfig holds the per-peer quotas for chain service requests.
+// Each request type gets its own token bucket per peer which refills at
+// the configured rate and holds up to one minute's worth of tokens.
+type RateLimitConfig struct {
+	// BlockRequestsPerMinute is the quota for each of the GetBlock,
+	// GetBlockTxs, GetBlockTxids, and GetAccumulatorCheckpoint requests.
+	BlockRequestsPerMinute uint32
+	// QueryRequestsPerMinute is the quota for each of the GetBlockID,
+	// GetBest, and GetInclusionProof requests.
+	QueryRequestsPerMinute uint32
+	// StreamRequestsPerMinute is the quota for each of the
+	// GetHeadersStream, GetBlockTxsStream, and GetCompressedBlocksStream
+	// requests.
+	StreamRequestsPerMinute uint32
+	// MaxConcurrentStreams is the maximum number of headers, block
+	// txs, and compressed blocks streams a peer may have open at once.
+	MaxConcurrentStreams uint32
+}
+
+// DefaultRateLimits are the rate limits used by the ChainService
+// if none are provided.
+var DefaultRateLimits = RateLimitConfig{
+	BlockRequestsPerMinute:  600,
+	QueryRequestsPerMinute:  600,
+	StreamRequestsPerMinute: 60,
+	MaxConcurrentStreams:    2,
+}
+
+type requestType uint8
+
+const (
+	requestGetBlock requestType = iota
+	requestGetBlockTxs
+	requestGetBlockTxids
+	requestGetBlockID
+	requestGetBest
+	requestGetHeadersStream
+	requestGetBlockTxsStream
+	requestGetCompressedBlocksStream
+	requestGetAccumulatorCheckpoint
+	requestGetInclusionProof
+)
+
+type tokenBucket struct {
+	tokens   float64
+	capacity float64
+	rate     float64
+	last     time.Time
+}
+
+func newTokenBucket(perMinute uint32, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		tokens:   float64(perMinute),
+		capacity: float64(perMinute),
+		rate:     float64(perMinute) / 60,
+		last:     now,
+	}
+}
+
+func (b *tokenBucket) take(now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+type peerQuota struct {
+	buckets  map[requestType]*tokenBucket
+	streams  uint32
+	lastSeen time.Time
+}
+
+// rateLimiter tracks request quotas and open streams for each peer.
+type rateLimiter struct {
+	cfg       RateLimitConfig
+	peers     map[peer.ID]*peerQuota
+	lastPrune time.Time
+	mtx       sync.Mutex
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		cfg:       cfg,
+		peers:     make(map[peer.ID]*peerQuota),
+		lastPrune: time.Now(),
+		mtx:       sync.Mutex{},
+	}
+}
+
+// allow returns whether the peer is within its quota for the
+// request type and consumes a token if so.
+func (rl *rateLimiter) allow(p peer.ID, rt requestType) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := time.Now()
+	rl.prune(now)
+
+	quota := rl.quota(p, now)
+	bucket, ok := quota.buckets[rt]
+	if !ok {
+		bucket = newTokenBucket(rl.perMinute(rt), now)
+		quota.buckets[rt] = bucket
+	}
+	return bucket.take(now)
+}
+
+// acquireStream returns whether the peer may open another stream
+// and if so increments its count of open streams. Each successful
+// call must be followed by a call to releaseStream.
+func (rl *rateLimiter) acquireStream(p peer.ID) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	quota := rl.quota(p, time.Now())
+	if quota.streams >= rl.cfg.MaxConcurrentStreams {
+		return false
+	}
+	quota.streams++
+	return true
+}
+
+// releaseStream decrements the peer's count of open streams.
+func (rl *rateLimiter) releaseStream(p peer.ID) {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	if quota, ok := rl.peers[p]; ok && quota.streams > 0 {
+		quota.streams--
+	}
+}
+
+func (rl *rateLimiter) quota(p peer.ID, now time.Time) *peerQuota {
+	quota, ok := rl.peers[p]
+	if !ok {
+		quota = &peerQuota{buckets: make(map[requestType]*tokenBucket)}
+		rl.peers[p] = quota
+	}
+	quota.lastSeen = now
+	return quota
+}
+
+func (rl *rateLimiter) perMinute(rt requestType) uint32 {
+	switch rt {
+	case requestGetBlock, requestGetBlockTxs, requestGetBlockTxids, requestGetAccumulatorCheckpoint:
+		return rl.cfg.BlockRequestsPerMinute
+	case requestGetHeadersStream, requestGetBlockTxsStream, requestGetCompressedBlocksStream:
+		return rl.cfg.StreamRequestsPerMinute
+	default:
+		return rl.cfg.QueryRequestsPerMinute
+	}
+}
+
+// prune removes peers that haven't made a request in over a minute
+// and have no open streams. By then all of their buckets are full
+// so there is no need to keep them around.
+func (rl *rateLimiter) prune(now time.Time) {
+	if now.Sub(rl.lastPrune) < rateLimitPruneInterval {
+		return
+	}
+	for p, quota := range rl.peers {
+		if quota.streams == 0 && now.Sub(quota.lastSeen) > time.Minute {
+			delete(rl.peers, p)
+		}
+	}
+	rl.lastPrune = now
+}
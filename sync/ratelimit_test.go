@@ -0,0 +1,92 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	pt "github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(60, now)
+	for i := 0; i < 60; i++ {
+		assert.True(t, bucket.take(now))
+	}
+	assert.False(t, bucket.take(now))
+
+	// Refills at one token per second.
+	now = now.Add(time.Second)
+	assert.True(t, bucket.take(now))
+	assert.False(t, bucket.take(now))
+
+	// Never refills past capacity.
+	now = now.Add(time.Hour)
+	for i := 0; i < 60; i++ {
+		assert.True(t, bucket.take(now))
+	}
+	assert.False(t, bucket.take(now))
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(RateLimitConfig{
+		BlockRequestsPerMinute:  5,
+		QueryRequestsPerMinute:  3,
+		StreamRequestsPerMinute: 1,
+		MaxConcurrentStreams:    2,
+	})
+
+	p1, err := pt.RandPeerID()
+	assert.NoError(t, err)
+	p2, err := pt.RandPeerID()
+	assert.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		assert.True(t, rl.allow(p1, requestGetBlock))
+	}
+	assert.False(t, rl.allow(p1, requestGetBlock))
+
+	// Each request type has its own bucket.
+	for i := 0; i < 5; i++ {
+		assert.True(t, rl.allow(p1, requestGetBlockTxs))
+	}
+	for i := 0; i < 3; i++ {
+		assert.True(t, rl.allow(p1, requestGetBest))
+	}
+	assert.False(t, rl.allow(p1, requestGetBest))
+
+	// And each peer has its own quota.
+	assert.True(t, rl.allow(p2, requestGetBlock))
+
+	assert.True(t, rl.allow(p1, requestGetHeadersStream))
+	assert.False(t, rl.allow(p1, requestGetHeadersStream))
+
+	assert.True(t, rl.acquireStream(p1))
+	assert.True(t, rl.acquireStream(p1))
+	assert.False(t, rl.acquireStream(p1))
+	assert.True(t, rl.acquireStream(p2))
+	rl.releaseStream(p1)
+	assert.True(t, rl.acquireStream(p1))
+
+	// Idle peers without open streams are pruned.
+	rl.releaseStream(p1)
+	rl.releaseStream(p1)
+	rl.peers[p1].lastSeen = time.Now().Add(-time.Minute * 2)
+	rl.peers[p2].lastSeen = time.Now().Add(-time.Minute * 2)
+	rl.lastPrune = time.Now().Add(-rateLimitPruneInterval)
+	assert.True(t, rl.allow(p1, requestGetBlockID))
+	assert.Len(t, rl.peers, 2)
+	_, ok := rl.peers[p2]
+	assert.True(t, ok)
+
+	rl.releaseStream(p2)
+	rl.peers[p1].lastSeen = time.Now().Add(-time.Minute * 2)
+	rl.peers[p2].lastSeen = time.Now().Add(-time.Minute * 2)
+	rl.lastPrune = time.Now().Add(-rateLimitPruneInterval)
+	rl.prune(time.Now())
+	assert.Len(t, rl.peers, 0)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/project-illium/ilxd/types"
+	"sort"
+	"time"
+)
+
+// progressAlpha is the weight given to the newest sample when
+// updating the blocks per second estimate.
+const progressAlpha = 0.3
+
+// PeerBucket is a group of peers which reported the same best
+// block when the sync started. More than one bucket may indicate
+// a fork, though it is more often just peers that were at slightly
+// different heights.
+type PeerBucket struct {
+	BestID types.ID
+	Height uint32
+	Peers  []peer.ID
+}
+
+// SyncStatus describes the progress of the sync.
+type SyncStatus struct {
+	// Current is whether the sync manager believes it is
+	// synced to the tip of the chain.
+	Current bool
+
+	// Height is the height of our best block.
+	Height uint32
+
+	// NetworkHeight is our estimate of the height of the
+	// tip of the chain based on what our peers report.
+	NetworkHeight uint32
+
+	// SyncPeers are the peers we are currently downloading
+	// blocks from.
+	SyncPeers []peer.ID
+
+	// Buckets are the peer buckets found when the sync started.
+	Buckets []PeerBucket
+
+	// BlocksPerSecond is the recent rate at which we are
+	// connecting blocks.
+	BlocksPerSecond float64
+
+	// ETA is the estimated time remaining until we are synced.
+	ETA time.Duration
+}
+
+// SyncStatus returns the current progress of the sync.
+func (sm *SyncManager) SyncStatus() *SyncStatus {
+	_, height, _ := sm.chain.BestBlock()
+
+	sm.currentMtx.RLock()
+	current := sm.current
+	sm.currentMtx.RUnlock()
+
+	sm.bucketMtx.RLock()
+	buckets := make([]PeerBucket, 0, len(sm.buckets))
+	for blockID, bucket := range sm.buckets {
+		buckets = append(buckets, PeerBucket{
+			BestID: blockID,
+			Height: sm.bucketHeights[blockID],
+			Peers:  append([]peer.ID{}, bucket...),
+		})
+	}
+	sm.bucketMtx.RUnlock()
+
+	sm.statusMtx.RLock()
+	defer sm.statusMtx.RUnlock()
+
+	status := &SyncStatus{
+		Current:       current,
+		Height:        height,
+		NetworkHeight: sm.networkHeight,
+		Buckets:       buckets,
+	}
+	if status.NetworkHeight < height {
+		status.NetworkHeight = height
+	}
+	if !current {
+		status.BlocksPerSecond = sm.blocksPerSecond
+		status.SyncPeers = append([]peer.ID{}, sm.activePeers...)
+		if sm.blocksPerSecond > 0 {
+			remaining := float64(status.NetworkHeight - height)
+			status.ETA = time.Duration(remaining / sm.blocksPerSecond * float64(time.Second))
+		}
+	}
+	return status
+}
+
+// setNetworkHeight sets our estimate of the height of the tip of the
+// chain to the median of the best heights reported by the peers queried
+// when populating the peer buckets. The heights are not verified so the
+// median is used, rather than the highest, so that a few peers cannot
+// hold the estimate at a height they don't have.
+func (sm *SyncManager) setNetworkHeight(heights []uint32) {
+	if len(heights) == 0 {
+		return
+	}
+	sorted := append([]uint32{}, heights...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	sm.statusMtx.Lock()
+	defer sm.statusMtx.Unlock()
+
+	sm.networkHeight = sorted[(len(sorted)-1)/2]
+}
+
+// setActivePeers sets the peers we are currently downloading from
+// and resets the progress timer.
+func (sm *SyncManager) setActivePeers(peers []peer.ID) {
+	sm.statusMtx.Lock()
+	defer sm.statusMtx.Unlock()
+
+	sm.activePeers = append([]peer.ID{}, peers...)
+	sm.lastProgress = time.Now()
+}
+
+func (sm *SyncManager) removeActivePeer(p peer.ID) {
+	sm.statusMtx.Lock()
+	defer sm.statusMtx.Unlock()
+
+	for i := len(sm.activePeers) - 1; i >= 0; i-- {
+		if sm.activePeers[i] == p {
+			sm.activePeers = append(sm.activePeers[:i], sm.activePeers[i+1:]...)
+		}
+	}
+}
+
+// recordProgress updates the blocks per second estimate after
+// connecting numBlocks.
+func (sm *SyncManager) recordProgress(numBlocks int) {
+	sm.statusMtx.Lock()
+	defer sm.statusMtx.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(sm.lastProgress)
+	sm.lastProgress = now
+	if elapsed <= 0 {
+		return
+	}
+	rate := float64(numBlocks) / elapsed.Seconds()
+	if sm.blocksPerSecond == 0 {
+		sm.blocksPerSecond = rate
+		return
+	}
+	sm.blocksPerSecond = progressAlpha*rate + (1-progressAlpha)*sm.blocksPerSecond
+}
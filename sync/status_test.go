@@ -0,0 +1,45 @@
+// Copyright (c) 2024 The illium developers
+// Use of this source code is governed by an MIT
+// license that can be found in the LICENSE file.
+
+package sync
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	pt "github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSyncProgress(t *testing.T) {
+	sm := &SyncManager{}
+
+	// One peer reporting a height far above the others does not
+	// move the estimate and it is replaced on the next query.
+	sm.setNetworkHeight([]uint32{100, 1000000, 99, 100, 101})
+	assert.Equal(t, uint32(100), sm.networkHeight)
+	sm.setNetworkHeight([]uint32{50, 60})
+	assert.Equal(t, uint32(50), sm.networkHeight)
+	sm.setNetworkHeight(nil)
+	assert.Equal(t, uint32(50), sm.networkHeight)
+
+	p1, err := pt.RandPeerID()
+	assert.NoError(t, err)
+	p2, err := pt.RandPeerID()
+	assert.NoError(t, err)
+
+	sm.setActivePeers([]peer.ID{p1, p2})
+	sm.removeActivePeer(p1)
+	assert.Equal(t, []peer.ID{p2}, sm.activePeers)
+
+	// The first sample sets the rate.
+	sm.lastProgress = time.Now().Add(-time.Second * 10)
+	sm.recordProgress(500)
+	assert.InDelta(t, 50, sm.blocksPerSecond, 1)
+
+	// Later samples are blended in.
+	sm.lastProgress = time.Now().Add(-time.Second * 10)
+	sm.recordProgress(1000)
+	assert.InDelta(t, progressAlpha*100+(1-progressAlpha)*50, sm.blocksPerSecond, 1)
+}
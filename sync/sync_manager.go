@@ -14,6 +14,7 @@ import (
 	"github.com/project-illium/ilxd/blockchain"
 	"github.com/project-illium/ilxd/net"
 	"github.com/project-illium/ilxd/params"
+	"github.com/project-illium/ilxd/repo"
 	"github.com/project-illium/ilxd/types"
 	"github.com/project-illium/ilxd/types/blocks"
 	"github.com/project-illium/ilxd/types/transactions"
@@ -41,6 +42,7 @@ type SyncManager struct {
 	chain           *blockchain.Blockchain
 	consensuChooser ConsensusChooser
 	buckets         map[types.ID][]peer.ID
+	bucketHeights   map[types.ID]uint32
 	bucketMtx       sync.RWMutex
 	currentMtx      sync.RWMutex
 	current         bool
@@ -49,8 +51,19 @@ type SyncManager struct {
 	proofCache      *blockchain.ProofCache
 	sigCache        *blockchain.SigCache
 	verifier        zk.Verifier
+	throughput      *throughputTracker
+	networkHeight   uint32
+	activePeers     []peer.ID
+	blocksPerSecond float64
+	lastProgress    time.Time
+	statusMtx       sync.RWMutex
+	ds              repo.Datastore
 	callback        func()
-	quit            chan struct{}
+
+	notifications     []NotificationCallback
+	notificationsLock sync.RWMutex
+
+	quit chan struct{}
 }
 
 // ConsensusChooser is an interface function which polls the consensus engine
@@ -69,6 +82,10 @@ type SyncManagerConfig struct {
 	SigCache          *blockchain.SigCache
 	Verifier          zk.Verifier
 	IsCurrentCallback func()
+
+	// Datastore is used to persist the forks observed during
+	// the sync. If nil the forks are not persisted.
+	Datastore repo.Datastore
 }
 
 // NewSyncManager returns a new initialized SyncManager
@@ -84,9 +101,13 @@ func NewSyncManager(cfg *SyncManagerConfig) *SyncManager {
 		sigCache:        cfg.SigCache,
 		verifier:        cfg.Verifier,
 		buckets:         make(map[types.ID][]peer.ID),
+		bucketHeights:   make(map[types.ID]uint32),
+		throughput:      newThroughputTracker(),
 		syncMtx:         sync.Mutex{},
 		bucketMtx:       sync.RWMutex{},
 		currentMtx:      sync.RWMutex{},
+		statusMtx:       sync.RWMutex{},
+		ds:              cfg.Datastore,
 		callback:        cfg.IsCurrentCallback,
 		behavorFlag:     blockchain.BFNone,
 		quit:            make(chan struct{}),
@@ -189,6 +210,9 @@ syncLoop:
 						"sync to height": height + lookaheadSize,
 						"error":          err,
 					}))
+					if errors.Is(err, ErrRateLimited) {
+						time.Sleep(rateLimitBackoff)
+					}
 				}
 				break
 			}
@@ -201,6 +225,9 @@ syncLoop:
 			forkBlock, forkHeight, err := sm.findForkPoint(height, height+lookaheadSize, blockMap)
 			if err != nil {
 				log.Debug("Error find fork point", log.Args("error", err))
+				if errors.Is(err, ErrRateLimited) {
+					time.Sleep(rateLimitBackoff)
+				}
 				continue
 			}
 			log.WithCaller(true).Trace("Query peers not in agreement", log.ArgsFromMap(map[string]any{
@@ -219,6 +246,9 @@ syncLoop:
 							"fork height":    forkHeight,
 							"error":          err,
 						}))
+						if errors.Is(err, ErrRateLimited) {
+							time.Sleep(rateLimitBackoff)
+						}
 						continue syncLoop
 					}
 					break
@@ -239,7 +269,10 @@ syncLoop:
 					continue
 				}
 				blks, err := sm.downloadEvalWindow(p, forkHeight+1)
-				if err != nil {
+				if errors.Is(err, ErrRateLimited) {
+					time.Sleep(rateLimitBackoff)
+					continue syncLoop
+				} else if err != nil {
 					log.Debug("Sync peer failed to serve evaluation window", log.Args("peer", p))
 					sm.network.IncreaseBanscore(p, 101, 0)
 					continue syncLoop
@@ -288,6 +321,29 @@ syncLoop:
 				}
 			}
 			log.WithCaller(true).Trace("Selected best chain", log.Args("bestID", bestID.String()))
+			// Record the fork so that operators can see the network split.
+			if winner, ok := syncTo[bestID]; ok && len(firstBlocks) > 1 {
+				fork := &Fork{
+					ForkBlock:         forkBlock,
+					ForkHeight:        forkHeight,
+					Winner:            winner.ID(),
+					ChosenByConsensus: tipOfChain,
+					Timestamp:         time.Now(),
+				}
+				for blockID, p := range blockMap {
+					tip, ok := syncTo[blockID]
+					if !ok {
+						continue
+					}
+					fork.Tips = append(fork.Tips, &ForkTip{
+						BlockID: tip.ID(),
+						Height:  tip.Header.Height,
+						Peers:   sm.bucketPeers(p),
+						Score:   scores[blockID],
+					})
+				}
+				sm.recordFork(fork)
+			}
 			// And ban the nodes on bad fork
 			if len(firstBlocks) > 1 {
 				for blockID, p := range blockMap {
@@ -311,6 +367,7 @@ syncLoop:
 							}
 						}
 						delete(sm.buckets, banBucket)
+						delete(sm.bucketHeights, banBucket)
 						sm.bucketMtx.Unlock()
 					}
 				}
@@ -367,6 +424,8 @@ func (sm *SyncManager) SetCurrent() {
 }
 
 func (sm *SyncManager) bucketPeerDisconnected(_ inet.Network, conn inet.Conn) {
+	sm.throughput.remove(conn.RemotePeer())
+
 	sm.bucketMtx.Lock()
 	defer sm.bucketMtx.Unlock()
 
@@ -378,6 +437,7 @@ func (sm *SyncManager) bucketPeerDisconnected(_ inet.Network, conn inet.Conn) {
 		}
 		if len(sm.buckets[blockID]) == 0 {
 			delete(sm.buckets, blockID)
+			delete(sm.bucketHeights, blockID)
 		}
 	}
 }
@@ -437,7 +497,9 @@ bucketLoop:
 				if errors.Is(err, ErrNotFound) {
 					id, h, err = sm.chainService.GetBest(pid)
 				}
-				if err != nil {
+				if errors.Is(err, ErrRateLimited) {
+					return
+				} else if err != nil {
 					sm.network.IncreaseBanscore(pid, 0, 20)
 					return
 				}
@@ -494,7 +556,11 @@ func (sm *SyncManager) populatePeerBuckets() error {
 		toQuery[p] = true
 	}
 
-	buckets := make(map[types.ID][]peer.ID)
+	var (
+		buckets     = make(map[types.ID][]peer.ID)
+		heights     = make(map[types.ID]uint32)
+		peerHeights = make([]uint32, 0, len(toQuery))
+	)
 
 	type resp struct {
 		p       peer.ID
@@ -510,7 +576,7 @@ func (sm *SyncManager) populatePeerBuckets() error {
 			go func(pid peer.ID, w *sync.WaitGroup) {
 				defer w.Done()
 				id, height, err := sm.chainService.GetBest(pid)
-				if errors.Is(err, ErrNotCurrent) {
+				if errors.Is(err, ErrNotCurrent) || errors.Is(err, ErrRateLimited) {
 					return
 				} else if err != nil {
 					sm.network.IncreaseBanscore(pid, 0, 20)
@@ -533,12 +599,18 @@ func (sm *SyncManager) populatePeerBuckets() error {
 			buckets[r.blockID] = make([]peer.ID, 0)
 		}
 		buckets[r.blockID] = append(buckets[r.blockID], r.p)
+		heights[r.blockID] = r.height
+		peerHeights = append(peerHeights, r.height)
 	}
 	// If enough peers failed, return error.
 	if count < size/2 {
 		return errors.New("less than half of peers returned height query response")
 	}
+	sm.bucketMtx.Lock()
 	sm.buckets = buckets
+	sm.bucketHeights = heights
+	sm.bucketMtx.Unlock()
+	sm.setNetworkHeight(peerHeights)
 	return nil
 }
 
@@ -576,14 +648,18 @@ func (sm *SyncManager) syncToCheckpoints(currentHeight uint32) {
 func (sm *SyncManager) downloadEvalWindow(p peer.ID, fromHeight uint32) ([]*blocks.Block, error) {
 	headers, err := sm.downloadHeaders(p, fromHeight, fromHeight+evaluationWindow-1)
 	if err != nil {
-		sm.network.IncreaseBanscore(p, 0, 20)
+		if !errors.Is(err, ErrRateLimited) {
+			sm.network.IncreaseBanscore(p, 0, 20)
+		}
 		return nil, err
 	}
 	blks := make([]*blocks.Block, 0, len(headers))
 	txs, err := sm.downloadBlockTxs(p, fromHeight, fromHeight+evaluationWindow-1)
 	if err != nil {
-		sm.network.IncreaseBanscore(p, 0, 20)
-		return nil, fmt.Errorf("peer %s block download error %s", p, err)
+		if !errors.Is(err, ErrRateLimited) {
+			sm.network.IncreaseBanscore(p, 0, 20)
+		}
+		return nil, fmt.Errorf("peer %s block download error: %w", p, err)
 	}
 	for i, tx := range txs {
 		blks = append(blks, &blocks.Block{
@@ -597,7 +673,9 @@ func (sm *SyncManager) downloadEvalWindow(p peer.ID, fromHeight uint32) ([]*bloc
 func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent, expectedID types.ID, flags blockchain.BehaviorFlags) error {
 	headers, err := sm.downloadHeaders(p, fromHeight, toHeight)
 	if err != nil {
-		sm.network.IncreaseBanscore(p, 0, 20)
+		if !errors.Is(err, ErrRateLimited) {
+			sm.network.IncreaseBanscore(p, 0, 20)
+		}
 		return err
 	}
 	if headers[len(headers)-1].ID().Compare(expectedID) != 0 {
@@ -616,40 +694,39 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 		}
 	}
 
+	// Split the block txs download across all the peers that agree
+	// with p on the expected block. Each range is checked against the
+	// header merkle roots, so a peer serving bad txs is caught and the
+	// range is reassigned.
 	var (
-		blks      []*blocks.Block
-		start     = headers[0].Height
-		endHeight = headers[len(headers)-1].Height
-		headerIdx = 0
+		startHeight = headers[0].Height
+		endHeight   = headers[len(headers)-1].Height
+		ranges      = splitRange(startHeight, endHeight, downloadRangeSize)
+		peers       = sm.downloadPeers(p, endHeight, expectedID)
 	)
+	log.WithCaller(true).Trace("Downloading blocks", log.ArgsFromMap(map[string]any{
+		"start height": startHeight,
+		"end height":   endHeight,
+		"peers":        len(peers),
+	}))
+	sm.setActivePeers(peers)
+	defer sm.setActivePeers(nil)
 
-	for {
-		blks = make([]*blocks.Block, 0, len(headers))
-
-		stop := start + maxBatchSize
-		if stop > endHeight {
-			stop = endHeight
-		}
-		txs, err := sm.downloadBlockTxs(p, start, stop)
-		if err != nil {
-			sm.network.IncreaseBanscore(p, 0, 20)
-			return fmt.Errorf("peer %s block download error %s", p, err)
-		}
-		x := 0
-		for i := headerIdx; i < headerIdx+len(txs); i++ {
+	return sm.parallelDownload(peers, ranges, sm.downloadBlockTxs, func(rp peer.ID, r blockRange, txs []*blocks.BlockTxs) (peer.ID, error) {
+		blks := make([]*blocks.Block, 0, len(txs))
+		for i, blockTxs := range txs {
+			header := headers[r.start-startHeight+uint32(i)]
 			blk := &blocks.Block{
-				Header:       headers[i],
-				Transactions: txs[x].Transactions,
+				Header:       header,
+				Transactions: blockTxs.Transactions,
 			}
 			merkleRoot := blockchain.TransactionsMerkleRoot(blk.Transactions)
-			if !bytes.Equal(merkleRoot[:], headers[i].TxRoot) {
-				sm.network.IncreaseBanscore(p, 101, 0)
-				return fmt.Errorf("peer %s invalid block download merkle root", p.String())
+			if !bytes.Equal(merkleRoot[:], header.TxRoot) {
+				sm.network.IncreaseBanscore(rp, 101, 0)
+				return rp, fmt.Errorf("peer %s invalid block download merkle root", rp.String())
 			}
 			blks = append(blks, blk)
-			x++
 		}
-		headerIdx += len(txs)
 
 		// Here we are going to extracts all the transactions for the entire batch
 		// and validate the proofs and signatures for the whole batch concurrently.
@@ -676,26 +753,23 @@ func (sm *SyncManager) syncBlocks(p peer.ID, fromHeight, toHeight uint32, parent
 			go func() {
 				sigChan <- blockchain.NewSigValidator(sm.sigCache).Validate(toValidate)
 			}()
-			err = <-proofChan
-			if err != nil {
-				return fmt.Errorf("error committing block from peer %s: invalid proof in batch", p)
+			proofErr := <-proofChan
+			sigErr := <-sigChan
+			if proofErr != nil {
+				return "", fmt.Errorf("error committing block from peer %s: invalid proof in batch", p)
 			}
-			err = <-sigChan
-			if err != nil {
-				return fmt.Errorf("error committing block from peer %s: invalid signature in batch", p)
+			if sigErr != nil {
+				return "", fmt.Errorf("error committing block from peer %s: invalid signature in batch", p)
 			}
 		}
 		for _, blk := range blks {
 			if err := sm.chain.ConnectBlock(blk, flags); err != nil {
-				return fmt.Errorf("error committing block from peer %s. Height: %d, Err: %s", p, blk.Header.Height, err)
+				return "", fmt.Errorf("error committing block from peer %s. Height: %d, Err: %s", p, blk.Header.Height, err)
 			}
 		}
-		start = stop + 1
-		if stop == endHeight {
-			break
-		}
-	}
-	return nil
+		sm.recordProgress(len(blks))
+		return "", nil
+	})
 }
 
 func (sm *SyncManager) findForkPoint(currentHeight, toHeight uint32, blockMap map[types.ID]peer.ID) (types.ID, uint32, error) {
@@ -846,7 +920,8 @@ func (sm *SyncManager) syncPeers() []peer.ID {
 			continue
 		}
 		for _, proto := range protocols {
-			if proto == sm.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion {
+			if proto == sm.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceProtocolVersion ||
+				proto == sm.params.ProtocolPrefix+ChainServiceProtocol+ChainServiceLegacyProtocolVersion {
 				peers = append(peers, p)
 				break
 			}
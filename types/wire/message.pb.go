@@ -29,10 +29,11 @@ const (
 type ErrorResponse int32
 
 const (
-	ErrorResponse_None       ErrorResponse = 0
-	ErrorResponse_NotFound   ErrorResponse = 1
-	ErrorResponse_BadRequest ErrorResponse = 2
-	ErrorResponse_NotCurrent ErrorResponse = 3
+	ErrorResponse_None        ErrorResponse = 0
+	ErrorResponse_NotFound    ErrorResponse = 1
+	ErrorResponse_BadRequest  ErrorResponse = 2
+	ErrorResponse_NotCurrent  ErrorResponse = 3
+	ErrorResponse_RateLimited ErrorResponse = 4
 )
 
 // Enum value maps for ErrorResponse.
@@ -42,12 +43,14 @@ var (
 		1: "NotFound",
 		2: "BadRequest",
 		3: "NotCurrent",
+		4: "RateLimited",
 	}
 	ErrorResponse_value = map[string]int32{
-		"None":       0,
-		"NotFound":   1,
-		"BadRequest": 2,
-		"NotCurrent": 3,
+		"None":        0,
+		"NotFound":    1,
+		"BadRequest":  2,
+		"NotCurrent":  3,
+		"RateLimited": 4,
 	}
 )
 
@@ -85,6 +88,7 @@ type MsgAvaRequest struct {
 
 	Request_ID uint32   `protobuf:"varint,1,opt,name=request_ID,json=requestID,proto3" json:"request_ID,omitempty"`
 	Heights    []uint32 `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	Nullifiers [][]byte `protobuf:"bytes,3,rep,name=nullifiers,proto3" json:"nullifiers,omitempty"`
 }
 
 func (x *MsgAvaRequest) Reset() {
@@ -133,6 +137,13 @@ func (x *MsgAvaRequest) GetHeights() []uint32 {
 	return nil
 }
 
+func (x *MsgAvaRequest) GetNullifiers() [][]byte {
+	if x != nil {
+		return x.Nullifiers
+	}
+	return nil
+}
+
 type MsgAvaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,6 +151,7 @@ type MsgAvaResponse struct {
 
 	Request_ID uint32   `protobuf:"varint,1,opt,name=request_ID,json=requestID,proto3" json:"request_ID,omitempty"`
 	Votes      [][]byte `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	TxVotes    [][]byte `protobuf:"bytes,3,rep,name=tx_votes,json=txVotes,proto3" json:"tx_votes,omitempty"`
 }
 
 func (x *MsgAvaResponse) Reset() {
@@ -188,6 +200,13 @@ func (x *MsgAvaResponse) GetVotes() [][]byte {
 	return nil
 }
 
+func (x *MsgAvaResponse) GetTxVotes() [][]byte {
+	if x != nil {
+		return x.TxVotes
+	}
+	return nil
+}
+
 type MsgChainServiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -202,6 +221,9 @@ type MsgChainServiceRequest struct {
 	//	*MsgChainServiceRequest_GetHeadersStream
 	//	*MsgChainServiceRequest_GetBlockTxsStream
 	//	*MsgChainServiceRequest_GetBest
+	//	*MsgChainServiceRequest_GetCompressedBlocksStream
+	//	*MsgChainServiceRequest_GetAccumulatorCheckpoint
+	//	*MsgChainServiceRequest_GetInclusionProof
 	Msg isMsgChainServiceRequest_Msg `protobuf_oneof:"msg"`
 }
 
@@ -293,6 +315,27 @@ func (x *MsgChainServiceRequest) GetGetBest() *GetBestReq {
 	return nil
 }
 
+func (x *MsgChainServiceRequest) GetGetCompressedBlocksStream() *GetCompressedBlocksStreamReq {
+	if x, ok := x.GetMsg().(*MsgChainServiceRequest_GetCompressedBlocksStream); ok {
+		return x.GetCompressedBlocksStream
+	}
+	return nil
+}
+
+func (x *MsgChainServiceRequest) GetGetAccumulatorCheckpoint() *GetAccumulatorCheckpointReq {
+	if x, ok := x.GetMsg().(*MsgChainServiceRequest_GetAccumulatorCheckpoint); ok {
+		return x.GetAccumulatorCheckpoint
+	}
+	return nil
+}
+
+func (x *MsgChainServiceRequest) GetGetInclusionProof() *GetInclusionProofReq {
+	if x, ok := x.GetMsg().(*MsgChainServiceRequest_GetInclusionProof); ok {
+		return x.GetInclusionProof
+	}
+	return nil
+}
+
 type isMsgChainServiceRequest_Msg interface {
 	isMsgChainServiceRequest_Msg()
 }
@@ -325,6 +368,18 @@ type MsgChainServiceRequest_GetBest struct {
 	GetBest *GetBestReq `protobuf:"bytes,7,opt,name=get_best,json=getBest,proto3,oneof"`
 }
 
+type MsgChainServiceRequest_GetCompressedBlocksStream struct {
+	GetCompressedBlocksStream *GetCompressedBlocksStreamReq `protobuf:"bytes,8,opt,name=get_compressed_blocks_stream,json=getCompressedBlocksStream,proto3,oneof"`
+}
+
+type MsgChainServiceRequest_GetAccumulatorCheckpoint struct {
+	GetAccumulatorCheckpoint *GetAccumulatorCheckpointReq `protobuf:"bytes,9,opt,name=get_accumulator_checkpoint,json=getAccumulatorCheckpoint,proto3,oneof"`
+}
+
+type MsgChainServiceRequest_GetInclusionProof struct {
+	GetInclusionProof *GetInclusionProofReq `protobuf:"bytes,10,opt,name=get_inclusion_proof,json=getInclusionProof,proto3,oneof"`
+}
+
 func (*MsgChainServiceRequest_GetBlockTxs) isMsgChainServiceRequest_Msg() {}
 
 func (*MsgChainServiceRequest_GetBlockTxids) isMsgChainServiceRequest_Msg() {}
@@ -339,6 +394,12 @@ func (*MsgChainServiceRequest_GetBlockTxsStream) isMsgChainServiceRequest_Msg()
 
 func (*MsgChainServiceRequest_GetBest) isMsgChainServiceRequest_Msg() {}
 
+func (*MsgChainServiceRequest_GetCompressedBlocksStream) isMsgChainServiceRequest_Msg() {}
+
+func (*MsgChainServiceRequest_GetAccumulatorCheckpoint) isMsgChainServiceRequest_Msg() {}
+
+func (*MsgChainServiceRequest_GetInclusionProof) isMsgChainServiceRequest_Msg() {}
+
 type GetBlockTxsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -802,6 +863,56 @@ func (x *GetHeadersStreamReq) GetStartHeight() uint32 {
 	return 0
 }
 
+// MsgStreamResp is the first message written in response to each of
+// the stream requests. If error is set the server closes the stream
+// without sending any items.
+type MsgStreamResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error ErrorResponse `protobuf:"varint,1,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgStreamResp) Reset() {
+	*x = MsgStreamResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgStreamResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgStreamResp) ProtoMessage() {}
+
+func (x *MsgStreamResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgStreamResp.ProtoReflect.Descriptor instead.
+func (*MsgStreamResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgStreamResp) GetError() ErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return ErrorResponse_None
+}
+
 type GetBlockTxsStreamReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -813,7 +924,7 @@ type GetBlockTxsStreamReq struct {
 func (x *GetBlockTxsStreamReq) Reset() {
 	*x = GetBlockTxsStreamReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_proto_msgTypes[12]
+		mi := &file_message_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -826,7 +937,7 @@ func (x *GetBlockTxsStreamReq) String() string {
 func (*GetBlockTxsStreamReq) ProtoMessage() {}
 
 func (x *GetBlockTxsStreamReq) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[12]
+	mi := &file_message_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,7 +950,7 @@ func (x *GetBlockTxsStreamReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockTxsStreamReq.ProtoReflect.Descriptor instead.
 func (*GetBlockTxsStreamReq) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{12}
+	return file_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBlockTxsStreamReq) GetStartHeight() uint32 {
@@ -858,7 +969,7 @@ type GetBestReq struct {
 func (x *GetBestReq) Reset() {
 	*x = GetBestReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_proto_msgTypes[13]
+		mi := &file_message_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -871,7 +982,7 @@ func (x *GetBestReq) String() string {
 func (*GetBestReq) ProtoMessage() {}
 
 func (x *GetBestReq) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[13]
+	mi := &file_message_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,36 +995,34 @@ func (x *GetBestReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBestReq.ProtoReflect.Descriptor instead.
 func (*GetBestReq) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{13}
+	return file_message_proto_rawDescGZIP(), []int{14}
 }
 
-type MsgGetBestResp struct {
+type GetCompressedBlocksStreamReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Block_ID []byte        `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
-	Height   uint32        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Error    ErrorResponse `protobuf:"varint,3,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
 }
 
-func (x *MsgGetBestResp) Reset() {
-	*x = MsgGetBestResp{}
+func (x *GetCompressedBlocksStreamReq) Reset() {
+	*x = GetCompressedBlocksStreamReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_message_proto_msgTypes[14]
+		mi := &file_message_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgGetBestResp) String() string {
+func (x *GetCompressedBlocksStreamReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgGetBestResp) ProtoMessage() {}
+func (*GetCompressedBlocksStreamReq) ProtoMessage() {}
 
-func (x *MsgGetBestResp) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[14]
+func (x *GetCompressedBlocksStreamReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,214 +1033,1017 @@ func (x *MsgGetBestResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgGetBestResp.ProtoReflect.Descriptor instead.
-func (*MsgGetBestResp) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetCompressedBlocksStreamReq.ProtoReflect.Descriptor instead.
+func (*GetCompressedBlocksStreamReq) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MsgGetBestResp) GetBlock_ID() []byte {
+func (x *GetCompressedBlocksStreamReq) GetStartHeight() uint32 {
 	if x != nil {
-		return x.Block_ID
+		return x.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgGetBestResp) GetHeight() uint32 {
+type GetAccumulatorCheckpointReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height returns the checkpoint at or prior to this height.
+	// Ignored if timestamp is set.
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp returns the checkpoint at or prior to this
+	// unix timestamp.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetAccumulatorCheckpointReq) Reset() {
+	*x = GetAccumulatorCheckpointReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccumulatorCheckpointReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccumulatorCheckpointReq) ProtoMessage() {}
+
+func (x *GetAccumulatorCheckpointReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccumulatorCheckpointReq.ProtoReflect.Descriptor instead.
+func (*GetAccumulatorCheckpointReq) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAccumulatorCheckpointReq) GetHeight() uint32 {
 	if x != nil {
 		return x.Height
 	}
 	return 0
 }
 
-func (x *MsgGetBestResp) GetError() ErrorResponse {
+func (x *GetAccumulatorCheckpointReq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type MsgAccumulatorCheckpointResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      uint32        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	NumEntries  uint64        `protobuf:"varint,2,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+	Accumulator [][]byte      `protobuf:"bytes,3,rep,name=accumulator,proto3" json:"accumulator,omitempty"`
+	Error       ErrorResponse `protobuf:"varint,4,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgAccumulatorCheckpointResp) Reset() {
+	*x = MsgAccumulatorCheckpointResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAccumulatorCheckpointResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAccumulatorCheckpointResp) ProtoMessage() {}
+
+func (x *MsgAccumulatorCheckpointResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgAccumulatorCheckpointResp.ProtoReflect.Descriptor instead.
+func (*MsgAccumulatorCheckpointResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MsgAccumulatorCheckpointResp) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MsgAccumulatorCheckpointResp) GetNumEntries() uint64 {
+	if x != nil {
+		return x.NumEntries
+	}
+	return 0
+}
+
+func (x *MsgAccumulatorCheckpointResp) GetAccumulator() [][]byte {
+	if x != nil {
+		return x.Accumulator
+	}
+	return nil
+}
+
+func (x *MsgAccumulatorCheckpointResp) GetError() ErrorResponse {
 	if x != nil {
 		return x.Error
 	}
 	return ErrorResponse_None
 }
 
-var File_message_proto protoreflect.FileDescriptor
+type GetInclusionProofReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_message_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x48, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x76, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x0e, 0x4d,
-	0x73, 0x67, 0x41, 0x76, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74,
-	0x65, 0x73, 0x22, 0xae, 0x03, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x0d, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x78, 0x73, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x78, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x48, 0x00, 0x52, 0x0d, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64,
-	0x73, 0x12, 0x2b, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x32,
-	0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x49, 0x64, 0x12, 0x44, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x48, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x78, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52,
-	0x11, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x42, 0x05, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x22, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x78, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22,
-	0x69, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2d, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19,
-	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x73, 0x67,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74,
-	0x78, 0x69, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x54, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x44, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x21,
-	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x0c, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x22, 0x69, 0x0a, 0x0e, 0x4d, 0x73,
-	0x67, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x47, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x02, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x42, 0x09,
-	0x5a, 0x07, 0x2e, 0x2e, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-var (
-	file_message_proto_rawDescOnce sync.Once
-	file_message_proto_rawDescData = file_message_proto_rawDesc
-)
+func (x *GetInclusionProofReq) Reset() {
+	*x = GetInclusionProofReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_message_proto_rawDescGZIP() []byte {
-	file_message_proto_rawDescOnce.Do(func() {
-		file_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_message_proto_rawDescData)
-	})
-	return file_message_proto_rawDescData
+func (x *GetInclusionProofReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_message_proto_goTypes = []interface{}{
-	(ErrorResponse)(0),               // 0: ErrorResponse
-	(*MsgAvaRequest)(nil),            // 1: MsgAvaRequest
-	(*MsgAvaResponse)(nil),           // 2: MsgAvaResponse
-	(*MsgChainServiceRequest)(nil),   // 3: MsgChainServiceRequest
-	(*GetBlockTxsReq)(nil),           // 4: GetBlockTxsReq
-	(*MsgBlockTxsResp)(nil),          // 5: MsgBlockTxsResp
-	(*GetBlockTxidsReq)(nil),         // 6: GetBlockTxidsReq
-	(*MsgBlockTxidsResp)(nil),        // 7: MsgBlockTxidsResp
-	(*GetBlockReq)(nil),              // 8: GetBlockReq
-	(*MsgBlockResp)(nil),             // 9: MsgBlockResp
-	(*GetBlockIDReq)(nil),            // 10: GetBlockIDReq
-	(*MsgGetBlockIDResp)(nil),        // 11: MsgGetBlockIDResp
-	(*GetHeadersStreamReq)(nil),      // 12: GetHeadersStreamReq
-	(*GetBlockTxsStreamReq)(nil),     // 13: GetBlockTxsStreamReq
-	(*GetBestReq)(nil),               // 14: GetBestReq
-	(*MsgGetBestResp)(nil),           // 15: MsgGetBestResp
-	(*transactions.Transaction)(nil), // 16: Transaction
-	(*blocks.Block)(nil),             // 17: Block
+func (*GetInclusionProofReq) ProtoMessage() {}
+
+func (x *GetInclusionProofReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_message_proto_depIdxs = []int32{
-	4,  // 0: MsgChainServiceRequest.get_block_txs:type_name -> GetBlockTxsReq
-	6,  // 1: MsgChainServiceRequest.get_block_txids:type_name -> GetBlockTxidsReq
-	8,  // 2: MsgChainServiceRequest.get_block:type_name -> GetBlockReq
-	10, // 3: MsgChainServiceRequest.get_block_id:type_name -> GetBlockIDReq
-	12, // 4: MsgChainServiceRequest.get_headers_stream:type_name -> GetHeadersStreamReq
-	13, // 5: MsgChainServiceRequest.get_block_txs_stream:type_name -> GetBlockTxsStreamReq
-	14, // 6: MsgChainServiceRequest.get_best:type_name -> GetBestReq
-	16, // 7: MsgBlockTxsResp.transactions:type_name -> Transaction
-	0,  // 8: MsgBlockTxsResp.error:type_name -> ErrorResponse
-	0,  // 9: MsgBlockTxidsResp.error:type_name -> ErrorResponse
-	17, // 10: MsgBlockResp.block:type_name -> Block
-	0,  // 11: MsgBlockResp.error:type_name -> ErrorResponse
-	0,  // 12: MsgGetBlockIDResp.error:type_name -> ErrorResponse
-	0,  // 13: MsgGetBestResp.error:type_name -> ErrorResponse
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+// Deprecated: Use GetInclusionProofReq.ProtoReflect.Descriptor instead.
+func (*GetInclusionProofReq) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{18}
 }
 
-func init() { file_message_proto_init() }
-func file_message_proto_init() {
-	if File_message_proto != nil {
-		return
+func (x *GetInclusionProofReq) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAvaRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAvaResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+	return nil
+}
+
+type MsgInclusionProofResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	Flags  uint64   `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Index  uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// txo_root is the accumulator root the proof is valid for.
+	TxoRoot []byte `protobuf:"bytes,4,opt,name=txo_root,json=txoRoot,proto3" json:"txo_root,omitempty"`
+	// block_ID is the block at which txo_root was computed.
+	Block_ID []byte        `protobuf:"bytes,5,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	Error    ErrorResponse `protobuf:"varint,6,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgInclusionProofResp) Reset() {
+	*x = MsgInclusionProofResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgInclusionProofResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgInclusionProofResp) ProtoMessage() {}
+
+func (x *MsgInclusionProofResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgInclusionProofResp.ProtoReflect.Descriptor instead.
+func (*MsgInclusionProofResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgInclusionProofResp) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+func (x *MsgInclusionProofResp) GetFlags() uint64 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *MsgInclusionProofResp) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *MsgInclusionProofResp) GetTxoRoot() []byte {
+	if x != nil {
+		return x.TxoRoot
+	}
+	return nil
+}
+
+func (x *MsgInclusionProofResp) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *MsgInclusionProofResp) GetError() ErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return ErrorResponse_None
+}
+
+type MsgGetBestResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block_ID []byte        `protobuf:"bytes,1,opt,name=block_ID,json=blockID,proto3" json:"block_ID,omitempty"`
+	Height   uint32        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Error    ErrorResponse `protobuf:"varint,3,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgGetBestResp) Reset() {
+	*x = MsgGetBestResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgGetBestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgGetBestResp) ProtoMessage() {}
+
+func (x *MsgGetBestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgGetBestResp.ProtoReflect.Descriptor instead.
+func (*MsgGetBestResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgGetBestResp) GetBlock_ID() []byte {
+	if x != nil {
+		return x.Block_ID
+	}
+	return nil
+}
+
+func (x *MsgGetBestResp) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MsgGetBestResp) GetError() ErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return ErrorResponse_None
+}
+
+type MsgTxRelay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//
+	//	*MsgTxRelay_Inv
+	//	*MsgTxRelay_GetTxs
+	Msg isMsgTxRelay_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *MsgTxRelay) Reset() {
+	*x = MsgTxRelay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTxRelay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTxRelay) ProtoMessage() {}
+
+func (x *MsgTxRelay) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgTxRelay.ProtoReflect.Descriptor instead.
+func (*MsgTxRelay) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *MsgTxRelay) GetMsg() isMsgTxRelay_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *MsgTxRelay) GetInv() *MsgTxInv {
+	if x, ok := x.GetMsg().(*MsgTxRelay_Inv); ok {
+		return x.Inv
+	}
+	return nil
+}
+
+func (x *MsgTxRelay) GetGetTxs() *GetTxsReq {
+	if x, ok := x.GetMsg().(*MsgTxRelay_GetTxs); ok {
+		return x.GetTxs
+	}
+	return nil
+}
+
+type isMsgTxRelay_Msg interface {
+	isMsgTxRelay_Msg()
+}
+
+type MsgTxRelay_Inv struct {
+	Inv *MsgTxInv `protobuf:"bytes,1,opt,name=inv,proto3,oneof"`
+}
+
+type MsgTxRelay_GetTxs struct {
+	GetTxs *GetTxsReq `protobuf:"bytes,2,opt,name=get_txs,json=getTxs,proto3,oneof"`
+}
+
+func (*MsgTxRelay_Inv) isMsgTxRelay_Msg() {}
+
+func (*MsgTxRelay_GetTxs) isMsgTxRelay_Msg() {}
+
+type MsgTxInv struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
+}
+
+func (x *MsgTxInv) Reset() {
+	*x = MsgTxInv{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTxInv) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTxInv) ProtoMessage() {}
+
+func (x *MsgTxInv) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgTxInv.ProtoReflect.Descriptor instead.
+func (*MsgTxInv) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgTxInv) GetTxids() [][]byte {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+type GetTxsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txids [][]byte `protobuf:"bytes,1,rep,name=txids,proto3" json:"txids,omitempty"`
+}
+
+func (x *GetTxsReq) Reset() {
+	*x = GetTxsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTxsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTxsReq) ProtoMessage() {}
+
+func (x *GetTxsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTxsReq.ProtoReflect.Descriptor instead.
+func (*GetTxsReq) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTxsReq) GetTxids() [][]byte {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+type MsgTxsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*transactions.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Error        ErrorResponse               `protobuf:"varint,2,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgTxsResp) Reset() {
+	*x = MsgTxsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTxsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTxsResp) ProtoMessage() {}
+
+func (x *MsgTxsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgTxsResp.ProtoReflect.Descriptor instead.
+func (*MsgTxsResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MsgTxsResp) GetTransactions() []*transactions.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *MsgTxsResp) GetError() ErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return ErrorResponse_None
+}
+
+type MsgReconcileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// salt keys the IBLT cell positions for this session.
+	Salt []byte `protobuf:"bytes,1,opt,name=salt,proto3" json:"salt,omitempty"`
+	// iblt holds the serialized cells of the requester's mempool IBLT.
+	Iblt []byte `protobuf:"bytes,2,opt,name=iblt,proto3" json:"iblt,omitempty"`
+	// send_all_on_failure asks the responder to return all of its
+	// txids if the IBLT difference cannot be decoded.
+	SendAllOnFailure bool `protobuf:"varint,3,opt,name=send_all_on_failure,json=sendAllOnFailure,proto3" json:"send_all_on_failure,omitempty"`
+}
+
+func (x *MsgReconcileReq) Reset() {
+	*x = MsgReconcileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReconcileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReconcileReq) ProtoMessage() {}
+
+func (x *MsgReconcileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReconcileReq.ProtoReflect.Descriptor instead.
+func (*MsgReconcileReq) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MsgReconcileReq) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *MsgReconcileReq) GetIblt() []byte {
+	if x != nil {
+		return x.Iblt
+	}
+	return nil
+}
+
+func (x *MsgReconcileReq) GetSendAllOnFailure() bool {
+	if x != nil {
+		return x.SendAllOnFailure
+	}
+	return false
+}
+
+type MsgReconcileResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// decoded is true if the IBLT difference was decoded.
+	Decoded bool `protobuf:"varint,1,opt,name=decoded,proto3" json:"decoded,omitempty"`
+	// txids are the txids the responder has which the requester
+	// does not. If decoded is false and send_all_on_failure was set
+	// this is the responder's full set of txids.
+	Txids [][]byte `protobuf:"bytes,2,rep,name=txids,proto3" json:"txids,omitempty"`
+	// diff_size is the total number of differences found. This
+	// can be used to size the IBLT for the next round.
+	DiffSize uint32        `protobuf:"varint,3,opt,name=diff_size,json=diffSize,proto3" json:"diff_size,omitempty"`
+	Error    ErrorResponse `protobuf:"varint,4,opt,name=error,proto3,enum=ErrorResponse" json:"error,omitempty"`
+}
+
+func (x *MsgReconcileResp) Reset() {
+	*x = MsgReconcileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_message_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReconcileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReconcileResp) ProtoMessage() {}
+
+func (x *MsgReconcileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgReconcileResp.ProtoReflect.Descriptor instead.
+func (*MsgReconcileResp) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MsgReconcileResp) GetDecoded() bool {
+	if x != nil {
+		return x.Decoded
+	}
+	return false
+}
+
+func (x *MsgReconcileResp) GetTxids() [][]byte {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+func (x *MsgReconcileResp) GetDiffSize() uint32 {
+	if x != nil {
+		return x.DiffSize
+	}
+	return 0
+}
+
+func (x *MsgReconcileResp) GetError() ErrorResponse {
+	if x != nil {
+		return x.Error
+	}
+	return ErrorResponse_None
+}
+
+var File_message_proto protoreflect.FileDescriptor
+
+var file_message_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x68, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x76, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x60, 0x0a, 0x0e, 0x4d,
+	0x73, 0x67, 0x41, 0x76, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xb7, 0x05,
+	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71,
+	0x48, 0x00, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x12,
+	0x3b, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x78, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0d, 0x67,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x09,
+	0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52,
+	0x08, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x0c, 0x67, 0x65, 0x74,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x48,
+	0x00, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x44, 0x0a,
+	0x12, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x48,
+	0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x48, 0x0a, 0x14, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x74, 0x78, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x28, 0x0a,
+	0x08, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x07,
+	0x67, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1c, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x19,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x5c, 0x0a, 0x1a, 0x67, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x18, 0x67,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x11, 0x67,
+	0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2d,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x4f, 0x0a,
+	0x11, 0x4d, 0x73, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x69, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x28,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x54, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x35, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x78, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x0c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x53, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x9f, 0x01,
+	0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x36, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x6f, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x6f, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x69, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x47, 0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x54, 0x78, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x6e,
+	0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x78, 0x49,
+	0x6e, 0x76, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x76, 0x12, 0x25, 0x0a, 0x07, 0x67, 0x65, 0x74,
+	0x5f, 0x74, 0x78, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x06, 0x67, 0x65, 0x74, 0x54, 0x78, 0x73,
+	0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x20, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x54, 0x78,
+	0x49, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64, 0x73, 0x22, 0x64, 0x0a, 0x0a,
+	0x4d, 0x73, 0x67, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x68, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x62, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x69, 0x62, 0x6c, 0x74, 0x12, 0x2d, 0x0a,
+	0x13, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x4f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a,
+	0x10, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x78, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x78, 0x69, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x69, 0x66, 0x66, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x2a, 0x58, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x01, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4e, 0x6f, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x10, 0x04, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x2e, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_message_proto_rawDescOnce sync.Once
+	file_message_proto_rawDescData = file_message_proto_rawDesc
+)
+
+func file_message_proto_rawDescGZIP() []byte {
+	file_message_proto_rawDescOnce.Do(func() {
+		file_message_proto_rawDescData = protoimpl.X.CompressGZIP(file_message_proto_rawDescData)
+	})
+	return file_message_proto_rawDescData
+}
+
+var file_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_message_proto_goTypes = []interface{}{
+	(ErrorResponse)(0),                   // 0: ErrorResponse
+	(*MsgAvaRequest)(nil),                // 1: MsgAvaRequest
+	(*MsgAvaResponse)(nil),               // 2: MsgAvaResponse
+	(*MsgChainServiceRequest)(nil),       // 3: MsgChainServiceRequest
+	(*GetBlockTxsReq)(nil),               // 4: GetBlockTxsReq
+	(*MsgBlockTxsResp)(nil),              // 5: MsgBlockTxsResp
+	(*GetBlockTxidsReq)(nil),             // 6: GetBlockTxidsReq
+	(*MsgBlockTxidsResp)(nil),            // 7: MsgBlockTxidsResp
+	(*GetBlockReq)(nil),                  // 8: GetBlockReq
+	(*MsgBlockResp)(nil),                 // 9: MsgBlockResp
+	(*GetBlockIDReq)(nil),                // 10: GetBlockIDReq
+	(*MsgGetBlockIDResp)(nil),            // 11: MsgGetBlockIDResp
+	(*GetHeadersStreamReq)(nil),          // 12: GetHeadersStreamReq
+	(*MsgStreamResp)(nil),                // 13: MsgStreamResp
+	(*GetBlockTxsStreamReq)(nil),         // 14: GetBlockTxsStreamReq
+	(*GetBestReq)(nil),                   // 15: GetBestReq
+	(*GetCompressedBlocksStreamReq)(nil), // 16: GetCompressedBlocksStreamReq
+	(*GetAccumulatorCheckpointReq)(nil),  // 17: GetAccumulatorCheckpointReq
+	(*MsgAccumulatorCheckpointResp)(nil), // 18: MsgAccumulatorCheckpointResp
+	(*GetInclusionProofReq)(nil),         // 19: GetInclusionProofReq
+	(*MsgInclusionProofResp)(nil),        // 20: MsgInclusionProofResp
+	(*MsgGetBestResp)(nil),               // 21: MsgGetBestResp
+	(*MsgTxRelay)(nil),                   // 22: MsgTxRelay
+	(*MsgTxInv)(nil),                     // 23: MsgTxInv
+	(*GetTxsReq)(nil),                    // 24: GetTxsReq
+	(*MsgTxsResp)(nil),                   // 25: MsgTxsResp
+	(*MsgReconcileReq)(nil),              // 26: MsgReconcileReq
+	(*MsgReconcileResp)(nil),             // 27: MsgReconcileResp
+	(*transactions.Transaction)(nil),     // 28: Transaction
+	(*blocks.Block)(nil),                 // 29: Block
+}
+var file_message_proto_depIdxs = []int32{
+	4,  // 0: MsgChainServiceRequest.get_block_txs:type_name -> GetBlockTxsReq
+	6,  // 1: MsgChainServiceRequest.get_block_txids:type_name -> GetBlockTxidsReq
+	8,  // 2: MsgChainServiceRequest.get_block:type_name -> GetBlockReq
+	10, // 3: MsgChainServiceRequest.get_block_id:type_name -> GetBlockIDReq
+	12, // 4: MsgChainServiceRequest.get_headers_stream:type_name -> GetHeadersStreamReq
+	14, // 5: MsgChainServiceRequest.get_block_txs_stream:type_name -> GetBlockTxsStreamReq
+	15, // 6: MsgChainServiceRequest.get_best:type_name -> GetBestReq
+	16, // 7: MsgChainServiceRequest.get_compressed_blocks_stream:type_name -> GetCompressedBlocksStreamReq
+	17, // 8: MsgChainServiceRequest.get_accumulator_checkpoint:type_name -> GetAccumulatorCheckpointReq
+	19, // 9: MsgChainServiceRequest.get_inclusion_proof:type_name -> GetInclusionProofReq
+	28, // 10: MsgBlockTxsResp.transactions:type_name -> Transaction
+	0,  // 11: MsgBlockTxsResp.error:type_name -> ErrorResponse
+	0,  // 12: MsgBlockTxidsResp.error:type_name -> ErrorResponse
+	29, // 13: MsgBlockResp.block:type_name -> Block
+	0,  // 14: MsgBlockResp.error:type_name -> ErrorResponse
+	0,  // 15: MsgGetBlockIDResp.error:type_name -> ErrorResponse
+	0,  // 16: MsgStreamResp.error:type_name -> ErrorResponse
+	0,  // 17: MsgAccumulatorCheckpointResp.error:type_name -> ErrorResponse
+	0,  // 18: MsgInclusionProofResp.error:type_name -> ErrorResponse
+	0,  // 19: MsgGetBestResp.error:type_name -> ErrorResponse
+	23, // 20: MsgTxRelay.inv:type_name -> MsgTxInv
+	24, // 21: MsgTxRelay.get_txs:type_name -> GetTxsReq
+	28, // 22: MsgTxsResp.transactions:type_name -> Transaction
+	0,  // 23: MsgTxsResp.error:type_name -> ErrorResponse
+	0,  // 24: MsgReconcileResp.error:type_name -> ErrorResponse
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_message_proto_init() }
+func file_message_proto_init() {
+	if File_message_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_message_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAvaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAvaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
@@ -1258,7 +2170,7 @@ func file_message_proto_init() {
 			}
 		}
 		file_message_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockTxsStreamReq); i {
+			switch v := v.(*MsgStreamResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1270,7 +2182,7 @@ func file_message_proto_init() {
 			}
 		}
 		file_message_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBestReq); i {
+			switch v := v.(*GetBlockTxsStreamReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1282,6 +2194,78 @@ func file_message_proto_init() {
 			}
 		}
 		file_message_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompressedBlocksStreamReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccumulatorCheckpointReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAccumulatorCheckpointResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInclusionProofReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgInclusionProofResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgGetBestResp); i {
 			case 0:
 				return &v.state
@@ -1293,6 +2277,78 @@ func file_message_proto_init() {
 				return nil
 			}
 		}
+		file_message_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTxRelay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTxInv); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTxsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTxsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReconcileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_message_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReconcileResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_message_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*MsgChainServiceRequest_GetBlockTxs)(nil),
@@ -1302,6 +2358,13 @@ func file_message_proto_init() {
 		(*MsgChainServiceRequest_GetHeadersStream)(nil),
 		(*MsgChainServiceRequest_GetBlockTxsStream)(nil),
 		(*MsgChainServiceRequest_GetBest)(nil),
+		(*MsgChainServiceRequest_GetCompressedBlocksStream)(nil),
+		(*MsgChainServiceRequest_GetAccumulatorCheckpoint)(nil),
+		(*MsgChainServiceRequest_GetInclusionProof)(nil),
+	}
+	file_message_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*MsgTxRelay_Inv)(nil),
+		(*MsgTxRelay_GetTxs)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1309,7 +2372,7 @@ func file_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_message_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},